@@ -25,16 +25,18 @@ type PushActor interface {
 }
 
 type PushActorImpl struct {
-	appBitsRepo applicationbits.ApplicationBitsRepository
-	appfiles    appfiles.AppFiles
-	zipper      appfiles.Zipper
+	appBitsRepo   applicationbits.ApplicationBitsRepository
+	appfiles      appfiles.AppFiles
+	zipper        appfiles.Zipper
+	resourceCache *appfiles.ResourceCache
 }
 
-func NewPushActor(appBitsRepo applicationbits.ApplicationBitsRepository, zipper appfiles.Zipper, appfiles appfiles.AppFiles) PushActor {
+func NewPushActor(appBitsRepo applicationbits.ApplicationBitsRepository, zipper appfiles.Zipper, appfiles appfiles.AppFiles, resourceCache *appfiles.ResourceCache) PushActor {
 	return PushActorImpl{
-		appBitsRepo: appBitsRepo,
-		appfiles:    appfiles,
-		zipper:      zipper,
+		appBitsRepo:   appBitsRepo,
+		appfiles:      appfiles,
+		zipper:        zipper,
+		resourceCache: resourceCache,
 	}
 }
 
@@ -78,6 +80,7 @@ func (actor PushActorImpl) ProcessPath(dirOrZipFile string, f func(string)) erro
 	if err != nil {
 		return err
 	}
+	actor.resourceCache.SkipDir(tempDir)
 
 	f(tempDir)
 
@@ -89,20 +92,39 @@ func (actor PushActorImpl) ProcessPath(dirOrZipFile string, f func(string)) erro
 	return nil
 }
 
+// GatherFiles asks the Cloud Controller which of the local files it already
+// has and copies the others to uploadDir. Files that the resource cache
+// knows the Cloud Controller has are not asked about again.
 func (actor PushActorImpl) GatherFiles(localFiles []models.AppFileFields, appDir string, uploadDir string) ([]resources.AppFileResource, bool, error) {
 	appFileResource := []resources.AppFileResource{}
+	cachedFiles := []resources.AppFileResource{}
 	for _, file := range localFiles {
-		appFileResource = append(appFileResource, resources.AppFileResource{
+		resource := resources.AppFileResource{
 			Path: file.Path,
 			Sha1: file.Sha1,
 			Size: file.Size,
-		})
+		}
+
+		if actor.resourceCache.IsMatched(file.Sha1, file.Size) {
+			cachedFiles = append(cachedFiles, resource)
+		} else {
+			appFileResource = append(appFileResource, resource)
+		}
 	}
 
-	remoteFiles, err := actor.appBitsRepo.GetApplicationFiles(appFileResource)
-	if err != nil {
-		return []resources.AppFileResource{}, false, err
+	remoteFiles := []resources.AppFileResource{}
+	if len(appFileResource) > 0 {
+		matchedFiles, err := actor.appBitsRepo.GetApplicationFiles(appFileResource)
+		if err != nil {
+			return []resources.AppFileResource{}, false, err
+		}
+
+		for _, file := range matchedFiles {
+			actor.resourceCache.SetMatched(file.Sha1, file.Size)
+		}
+		remoteFiles = matchedFiles
 	}
+	remoteFiles = append(remoteFiles, cachedFiles...)
 
 	filesToUpload := make([]models.AppFileFields, len(localFiles), len(localFiles))
 	copy(filesToUpload, localFiles)
@@ -115,7 +137,7 @@ func (actor PushActorImpl) GatherFiles(localFiles []models.AppFileFields, appDir
 		}
 	}
 
-	err = actor.appfiles.CopyFiles(filesToUpload, appDir, uploadDir)
+	err := actor.appfiles.CopyFiles(filesToUpload, appDir, uploadDir)
 	if err != nil {
 		return []resources.AppFileResource{}, false, err
 	}
@@ -34,7 +34,7 @@ var _ = Describe("Push Actor", func() {
 		appBitsRepo = new(applicationbitsfakes.FakeApplicationBitsRepository)
 		appFiles = new(appfilesfakes.FakeAppFiles)
 		fakezipper = new(appfilesfakes.FakeZipper)
-		actor = actors.NewPushActor(appBitsRepo, fakezipper, appFiles)
+		actor = actors.NewPushActor(appBitsRepo, fakezipper, appFiles, nil)
 		fixturesDir = filepath.Join("..", "..", "fixtures", "applications")
 		allFiles = []models.AppFileFields{
 			{Path: "example-app/.cfignore"},
@@ -259,6 +259,68 @@ var _ = Describe("Push Actor", func() {
 				Expect(uploadDir).To(Equal(tmpDir))
 			})
 		})
+		Context("when the resource cache knows some of the files are present", func() {
+			var cacheDir string
+
+			BeforeEach(func() {
+				var err error
+				cacheDir, err = ioutil.TempDir("", "resource-cache")
+				Expect(err).NotTo(HaveOccurred())
+
+				resourceCache := appfiles.NewResourceCache(filepath.Join(cacheDir, "resource_cache.json"))
+				Expect(resourceCache.Load("https://api.example.com")).To(Succeed())
+				resourceCache.SetMatched("cached-sha", 10)
+
+				actor = actors.NewPushActor(appBitsRepo, fakezipper, appFiles, resourceCache)
+
+				allFiles = []models.AppFileFields{
+					{Path: "example-app/app.rb", Sha1: "cached-sha", Size: 10},
+					{Path: "example-app/config.ru", Sha1: "other-sha", Size: 20},
+					{Path: "example-app/Gemfile", Sha1: "new-sha", Size: 30},
+				}
+				appBitsRepo.GetApplicationFilesReturns([]resources.AppFileResource{
+					{Path: "example-app/config.ru", Sha1: "other-sha", Size: 20},
+				}, nil)
+			})
+
+			AfterEach(func() {
+				os.RemoveAll(cacheDir)
+			})
+
+			It("only asks the cc about the files it does not know", func() {
+				_, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(appBitsRepo.GetApplicationFilesCallCount()).To(Equal(1))
+				Expect(appBitsRepo.GetApplicationFilesArgsForCall(0)).To(Equal([]resources.AppFileResource{
+					{Path: "example-app/config.ru", Sha1: "other-sha", Size: 20},
+					{Path: "example-app/Gemfile", Sha1: "new-sha", Size: 30},
+				}))
+			})
+
+			It("copies only the files that are not present", func() {
+				remoteFiles, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir)
+				Expect(err).NotTo(HaveOccurred())
+
+				remotePaths := []string{}
+				for _, file := range remoteFiles {
+					remotePaths = append(remotePaths, file.Path)
+				}
+				Expect(remotePaths).To(ConsistOf("example-app/app.rb", "example-app/config.ru"))
+
+				filesToUpload, _, _ := appFiles.CopyFilesArgsForCall(0)
+				Expect(filesToUpload).To(Equal([]models.AppFileFields{
+					{Path: "example-app/Gemfile", Sha1: "new-sha", Size: 30},
+				}))
+			})
+
+			It("does not ask the cc when it knows all the files", func() {
+				_, hasFileToUpload, err := actor.GatherFiles(allFiles[:1], fixturesDir, tmpDir)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(hasFileToUpload).To(BeFalse())
+				Expect(appBitsRepo.GetApplicationFilesCallCount()).To(BeZero())
+			})
+		})
 	})
 
 	Describe(".UploadApp", func() {
@@ -273,7 +335,7 @@ var _ = Describe("Push Actor", func() {
 
 		BeforeEach(func() {
 			zipper := &appfiles.ApplicationZipper{}
-			actor = actors.NewPushActor(appBitsRepo, zipper, appFiles)
+			actor = actors.NewPushActor(appBitsRepo, zipper, appFiles, nil)
 		})
 
 		Context("when given a zip file", func() {
@@ -317,11 +379,32 @@ var _ = Describe("Push Actor", func() {
 				Expect(err).To(HaveOccurred())
 			})
 
+			It("keeps the files it extracted out of the resource cache", func() {
+				cacheDir, err := ioutil.TempDir("", "resource-cache")
+				Expect(err).NotTo(HaveOccurred())
+				defer os.RemoveAll(cacheDir)
+
+				resourceCache := appfiles.NewResourceCache(filepath.Join(cacheDir, "resource_cache.json"))
+				Expect(resourceCache.Load("https://api.example.com")).To(Succeed())
+				actor = actors.NewPushActor(appBitsRepo, &appfiles.ApplicationZipper{}, appFiles, resourceCache)
+
+				f := func(tempDir string) {
+					fileInfo, err := os.Lstat(filepath.Join(tempDir, "example-app", "app.rb"))
+					Expect(err).NotTo(HaveOccurred())
+
+					resourceCache.SetSha1(tempDir, "example-app/app.rb", fileInfo, "some-sha")
+					_, ok := resourceCache.Sha1(tempDir, "example-app/app.rb", fileInfo)
+					Expect(ok).To(BeFalse())
+				}
+				err = actor.ProcessPath(zipFile, f)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
 			It("returns an error if the unzipping fails", func() {
 				e := errors.New("some-error")
 				fakezipper.UnzipReturns(e)
 				fakezipper.IsZipFileReturns(true)
-				actor = actors.NewPushActor(appBitsRepo, fakezipper, appFiles)
+				actor = actors.NewPushActor(appBitsRepo, fakezipper, appFiles, nil)
 
 				f := func(tempDir string) {}
 				err := actor.ProcessPath(zipFile, f)
@@ -22,7 +22,7 @@ func (routeActor RouteActor) CreateRandomTCPRoute(domain models.DomainFields) mo
 		"Domain": terminal.EntityNameColor(domain.Name),
 	}) + "...")
 
-	route, err := routeActor.routeRepo.Create("", domain, "", true)
+	route, err := routeActor.routeRepo.Create("", domain, "", 0, true)
 	if err != nil {
 		routeActor.ui.Failed(err.Error())
 		return models.Route{}
@@ -31,8 +31,7 @@ func (routeActor RouteActor) CreateRandomTCPRoute(domain models.DomainFields) mo
 	return route
 }
 
-func (routeActor RouteActor) FindOrCreateRoute(hostname string, domain models.DomainFields, path string, useRandomPort bool) (route models.Route) {
-	var port int
+func (routeActor RouteActor) FindOrCreateRoute(hostname string, domain models.DomainFields, path string, port int, useRandomPort bool) (route models.Route) {
 	route, apiErr := routeActor.routeRepo.Find(hostname, domain, path, port)
 
 	switch apiErr.(type) {
@@ -44,7 +43,7 @@ func (routeActor RouteActor) FindOrCreateRoute(hostname string, domain models.Do
 		} else {
 			routeActor.ui.Say(T("Creating route {{.Hostname}}...", map[string]interface{}{"Hostname": terminal.EntityNameColor(domain.URLForHostAndPath(hostname, path, port))}))
 
-			route, apiErr = routeActor.routeRepo.Create(hostname, domain, path, useRandomPort)
+			route, apiErr = routeActor.routeRepo.Create(hostname, domain, path, port, useRandomPort)
 			if apiErr != nil {
 				routeActor.ui.Failed(apiErr.Error())
 			}
@@ -49,7 +49,7 @@ var _ = Describe("Routes", func() {
 		It("calls Create on the route repo", func() {
 			routeActor.CreateRandomTCPRoute(domain)
 
-			host, d, path, randomPort := fakeRouteRepository.CreateArgsForCall(0)
+			host, d, path, _, randomPort := fakeRouteRepository.CreateArgsForCall(0)
 			Expect(host).To(BeEmpty())
 			Expect(d).To(Equal(domain))
 			Expect(path).To(BeEmpty())
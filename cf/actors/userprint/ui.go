@@ -8,6 +8,36 @@ import (
 	"github.com/cloudfoundry/cli/cf/terminal"
 )
 
+// roleRecord is the machine readable form of the users holding a role,
+// printed by the org-users and space-users commands.
+type roleRecord struct {
+	Role  string       `json:"role" yaml:"role"`
+	Users []userRecord `json:"users" yaml:"users"`
+}
+
+type userRecord struct {
+	Username string `json:"username" yaml:"username"`
+	GUID     string `json:"guid" yaml:"guid"`
+}
+
+var roleRecordNames = map[models.Role]string{
+	models.RoleOrgUser:        "org_user",
+	models.RoleOrgManager:     "org_manager",
+	models.RoleBillingManager: "billing_manager",
+	models.RoleOrgAuditor:     "org_auditor",
+	models.RoleSpaceManager:   "space_manager",
+	models.RoleSpaceDeveloper: "space_developer",
+	models.RoleSpaceAuditor:   "space_auditor",
+}
+
+func newRoleRecord(role models.Role, users []models.UserFields) roleRecord {
+	record := roleRecord{Role: roleRecordNames[role], Users: []userRecord{}}
+	for _, user := range users {
+		record.Users = append(record.Users, userRecord{Username: user.Username, GUID: user.GUID})
+	}
+	return record
+}
+
 type SpaceUsersUIPrinter struct {
 	UI               terminal.UI
 	UserLister       func(spaceGUID string, role models.Role) ([]models.UserFields, error)
@@ -23,6 +53,7 @@ type OrgUsersUIPrinter struct {
 }
 
 func (p *OrgUsersUIPrinter) PrintUsers(guid string, username string) {
+	records := []roleRecord{}
 	for _, role := range p.Roles {
 		displayName := p.RoleDisplayNames[role]
 		users, err := p.UserLister(guid, role)
@@ -34,6 +65,12 @@ func (p *OrgUsersUIPrinter) PrintUsers(guid string, username string) {
 				}))
 			return
 		}
+
+		if p.UI.OutputFormat().IsStructured() {
+			records = append(records, newRoleRecord(role, users))
+			continue
+		}
+
 		p.UI.Say("")
 		p.UI.Say("%s", terminal.HeaderColor(displayName))
 
@@ -45,9 +82,12 @@ func (p *OrgUsersUIPrinter) PrintUsers(guid string, username string) {
 			}
 		}
 	}
+
+	p.UI.PrintStructured(records)
 }
 
 func (p *SpaceUsersUIPrinter) PrintUsers(guid string, username string) {
+	records := []roleRecord{}
 	for _, role := range p.Roles {
 		displayName := p.RoleDisplayNames[role]
 		users, err := p.UserLister(guid, role)
@@ -59,6 +99,12 @@ func (p *SpaceUsersUIPrinter) PrintUsers(guid string, username string) {
 				}))
 			return
 		}
+
+		if p.UI.OutputFormat().IsStructured() {
+			records = append(records, newRoleRecord(role, users))
+			continue
+		}
+
 		p.UI.Say("")
 		p.UI.Say("%s", terminal.HeaderColor(displayName))
 
@@ -70,4 +116,6 @@ func (p *SpaceUsersUIPrinter) PrintUsers(guid string, username string) {
 			}
 		}
 	}
+
+	p.UI.PrintStructured(records)
 }
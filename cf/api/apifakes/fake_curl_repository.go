@@ -2,6 +2,7 @@
 package apifakes
 
 import (
+	"net/http"
 	"sync"
 
 	"github.com/cloudfoundry/cli/cf/api"
@@ -21,6 +22,20 @@ type FakeCurlRepository struct {
 		result2 string
 		result3 error
 	}
+	RequestForResponseStub        func(method, path string, header http.Header, body string) (statusCode int, resHeader http.Header, resBody string, apiErr error)
+	requestForResponseMutex       sync.RWMutex
+	requestForResponseArgsForCall []struct {
+		method string
+		path   string
+		header http.Header
+		body   string
+	}
+	requestForResponseReturns struct {
+		result1 int
+		result2 http.Header
+		result3 string
+		result4 error
+	}
 }
 
 func (fake *FakeCurlRepository) Request(method string, path string, header string, body string) (resHeaders string, resBody string, apiErr error) {
@@ -60,4 +75,42 @@ func (fake *FakeCurlRepository) RequestReturns(result1 string, result2 string, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCurlRepository) RequestForResponse(method string, path string, header http.Header, body string) (statusCode int, resHeader http.Header, resBody string, apiErr error) {
+	fake.requestForResponseMutex.Lock()
+	fake.requestForResponseArgsForCall = append(fake.requestForResponseArgsForCall, struct {
+		method string
+		path   string
+		header http.Header
+		body   string
+	}{method, path, header, body})
+	fake.requestForResponseMutex.Unlock()
+	if fake.RequestForResponseStub != nil {
+		return fake.RequestForResponseStub(method, path, header, body)
+	} else {
+		return fake.requestForResponseReturns.result1, fake.requestForResponseReturns.result2, fake.requestForResponseReturns.result3, fake.requestForResponseReturns.result4
+	}
+}
+
+func (fake *FakeCurlRepository) RequestForResponseCallCount() int {
+	fake.requestForResponseMutex.RLock()
+	defer fake.requestForResponseMutex.RUnlock()
+	return len(fake.requestForResponseArgsForCall)
+}
+
+func (fake *FakeCurlRepository) RequestForResponseArgsForCall(i int) (string, string, http.Header, string) {
+	fake.requestForResponseMutex.RLock()
+	defer fake.requestForResponseMutex.RUnlock()
+	return fake.requestForResponseArgsForCall[i].method, fake.requestForResponseArgsForCall[i].path, fake.requestForResponseArgsForCall[i].header, fake.requestForResponseArgsForCall[i].body
+}
+
+func (fake *FakeCurlRepository) RequestForResponseReturns(result1 int, result2 http.Header, result3 string, result4 error) {
+	fake.RequestForResponseStub = nil
+	fake.requestForResponseReturns = struct {
+		result1 int
+		result2 http.Header
+		result3 string
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 var _ api.CurlRepository = new(FakeCurlRepository)
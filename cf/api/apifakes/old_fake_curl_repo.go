@@ -1,5 +1,7 @@
 package apifakes
 
+import "net/http"
+
 type OldFakeCurlRepository struct {
 	Method         string
 	Path           string
@@ -21,3 +23,13 @@ func (repo *OldFakeCurlRepository) Request(method, path, header, body string) (r
 	apiErr = repo.Error
 	return
 }
+
+func (repo *OldFakeCurlRepository) RequestForResponse(method, path string, header http.Header, body string) (statusCode int, resHeader http.Header, resBody string, apiErr error) {
+	repo.Method = method
+	repo.Path = path
+	repo.Body = body
+
+	resBody = repo.ResponseBody
+	apiErr = repo.Error
+	return
+}
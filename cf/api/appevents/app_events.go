@@ -4,6 +4,8 @@ import (
 	"github.com/cloudfoundry/cli/cf/api/resources"
 	"github.com/cloudfoundry/cli/cf/api/strategy"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/net"
 )
@@ -12,8 +14,12 @@ import (
 
 type AppEventsRepository interface {
 	RecentEvents(appGUID string, limit int64) ([]models.EventFields, error)
+	ListEvents(filter models.EventFilter, limit int64) ([]models.EventFields, error)
 }
 
+// maxEventsPerPage is the largest page size the cloud controller accepts.
+const maxEventsPerPage = 100
+
 type CloudControllerAppEventsRepository struct {
 	config   coreconfig.Reader
 	gateway  net.Gateway
@@ -31,7 +37,7 @@ func NewCloudControllerAppEventsRepository(config coreconfig.Reader, gateway net
 func (repo CloudControllerAppEventsRepository) RecentEvents(appGUID string, limit int64) ([]models.EventFields, error) {
 	count := int64(0)
 	events := make([]models.EventFields, 0, limit)
-	apiErr := repo.listEvents(appGUID, limit, func(eventField models.EventFields) bool {
+	apiErr := repo.listEvents(repo.strategy.EventsURL(appGUID, limit), func(eventField models.EventFields) bool {
 		count++
 		events = append(events, eventField)
 		return count < limit
@@ -40,10 +46,60 @@ func (repo CloudControllerAppEventsRepository) RecentEvents(appGUID string, limi
 	return events, apiErr
 }
 
-func (repo CloudControllerAppEventsRepository) listEvents(appGUID string, limit int64, cb func(models.EventFields) bool) error {
+// ListEvents returns the newest events matching the filter, following as many
+// pages as needed. A limit of 0 or less returns all matching events.
+func (repo CloudControllerAppEventsRepository) ListEvents(filter models.EventFilter, limit int64) ([]models.EventFields, error) {
+	perPage := int64(maxEventsPerPage)
+	if limit > 0 && limit < perPage {
+		perPage = limit
+	}
+
+	path := repo.strategy.FilteredEventsURL(filter, perPage)
+	if path == "" {
+		return nil, errors.New(T("Listing events of a space or org is not supported by this version of the Cloud Controller"))
+	}
+
+	events := []models.EventFields{}
+	apiErr := repo.listEvents(path, func(event models.EventFields) bool {
+		if matchesFilter(event, filter) {
+			events = append(events, event)
+		}
+		return limit <= 0 || int64(len(events)) < limit
+	})
+
+	return events, apiErr
+}
+
+// matchesFilter is checked for every event, as the cloud controller cannot
+// filter on the actor, and older cloud controllers do not filter at all.
+func matchesFilter(event models.EventFields, filter models.EventFilter) bool {
+	if filter.Actor != "" && filter.Actor != event.Actor && filter.Actor != event.ActorName {
+		return false
+	}
+
+	if !filter.Since.IsZero() && event.Timestamp.Before(filter.Since) {
+		return false
+	}
+
+	if !filter.Until.IsZero() && event.Timestamp.After(filter.Until) {
+		return false
+	}
+
+	if len(filter.Types) == 0 {
+		return true
+	}
+	for _, eventType := range filter.Types {
+		if eventType == event.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func (repo CloudControllerAppEventsRepository) listEvents(path string, cb func(models.EventFields) bool) error {
 	return repo.gateway.ListPaginatedResources(
 		repo.config.APIEndpoint(),
-		repo.strategy.EventsURL(appGUID, limit),
+		path,
 		repo.strategy.EventsResource(),
 
 		func(resource interface{}) bool {
@@ -14,6 +14,8 @@ import (
 	testtime "github.com/cloudfoundry/cli/testhelpers/time"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
 )
 
 var _ = Describe("App Events Repo", func() {
@@ -72,6 +74,47 @@ var _ = Describe("App Events Repo", func() {
 			}))
 		})
 	})
+
+	Describe("list events", func() {
+		It("follows the pages and filters on the actor", func() {
+			setupTestServer(spaceEventsPage1Request, spaceEventsPage2Request)
+
+			list, err := repo.ListEvents(models.EventFilter{SpaceGUID: "my-space-guid", Actor: "nobody@pivotallabs.com"}, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handler).To(HaveAllRequestsCalled())
+
+			Expect(list).To(HaveLen(2))
+			Expect(list[0].GUID).To(Equal("event-2-guid"))
+			Expect(list[1].GUID).To(Equal("event-3-guid"))
+			Expect(list[1].ActeeType).To(Equal("service_instance"))
+			Expect(list[1].ActeeName).To(Equal("my-db"))
+		})
+
+		It("stops at the limit", func() {
+			setupTestServer(eventsRequest)
+
+			list, err := repo.ListEvents(models.EventFilter{ActeeGUID: "my-app-guid"}, 2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handler).To(HaveAllRequestsCalled())
+
+			Expect(list).To(HaveLen(2))
+			Expect(list[0].GUID).To(Equal("event-1-guid"))
+		})
+
+		Context("when the cloud controller only lists events per app", func() {
+			BeforeEach(func() {
+				config.SetAPIVersion("2.0.0")
+			})
+
+			It("returns an error for a space", func() {
+				setupTestServer()
+
+				_, err := repo.ListEvents(models.EventFilter{SpaceGUID: "my-space-guid"}, 0)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("not supported"))
+			})
+		})
+	})
 })
 
 const eventTimestampFormat = "2006-01-02T15:04:05-07:00"
@@ -129,3 +172,62 @@ var eventsRequest = testnet.TestRequest{
 			}
 		  ]
 		}`}}
+
+var spaceEventsPage1Request = testnet.TestRequest{
+	Method: "GET",
+	Path:   "/v2/events?q=space_guid%3Amy-space-guid&order-direction=desc&results-per-page=100",
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `{
+		  "total_results": 3,
+		  "total_pages": 2,
+		  "next_url": "/v2/events?q=space_guid%3Amy-space-guid&order-direction=desc&results-per-page=100&page=2",
+		  "resources": [
+			{
+			  "metadata": {"guid": "event-1-guid"},
+			  "entity": {
+				"type": "audit.app.update",
+				"timestamp": "2014-01-21T00:20:11+00:00",
+				"actor": "cf-1-client",
+				"actor_name": "somebody@pivotallabs.com",
+				"actee_type": "app",
+				"actee_name": "dora"
+			  }
+			},
+			{
+			  "metadata": {"guid": "event-2-guid"},
+			  "entity": {
+				"type": "audit.app.update",
+				"timestamp": "2014-01-21T00:19:11+00:00",
+				"actor": "cf-2-client",
+				"actor_name": "nobody@pivotallabs.com",
+				"actee_type": "app",
+				"actee_name": "dora"
+			  }
+			}
+		  ]
+		}`}}
+
+var spaceEventsPage2Request = testnet.TestRequest{
+	Method: "GET",
+	Path:   "/v2/events?q=space_guid%3Amy-space-guid&order-direction=desc&results-per-page=100&page=2",
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `{
+		  "total_results": 3,
+		  "total_pages": 2,
+		  "next_url": null,
+		  "resources": [
+			{
+			  "metadata": {"guid": "event-3-guid"},
+			  "entity": {
+				"type": "audit.service_instance.create",
+				"timestamp": "2014-01-20T00:20:11+00:00",
+				"actor": "cf-2-client",
+				"actor_name": "nobody@pivotallabs.com",
+				"actee_type": "service_instance",
+				"actee_name": "my-db"
+			  }
+			}
+		  ]
+		}`}}
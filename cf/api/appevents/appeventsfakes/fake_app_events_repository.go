@@ -19,6 +19,16 @@ type FakeAppEventsRepository struct {
 		result1 []models.EventFields
 		result2 error
 	}
+	ListEventsStub        func(filter models.EventFilter, limit int64) ([]models.EventFields, error)
+	listEventsMutex       sync.RWMutex
+	listEventsArgsForCall []struct {
+		filter models.EventFilter
+		limit  int64
+	}
+	listEventsReturns struct {
+		result1 []models.EventFields
+		result2 error
+	}
 }
 
 func (fake *FakeAppEventsRepository) RecentEvents(appGUID string, limit int64) ([]models.EventFields, error) {
@@ -55,4 +65,38 @@ func (fake *FakeAppEventsRepository) RecentEventsReturns(result1 []models.EventF
 	}{result1, result2}
 }
 
+func (fake *FakeAppEventsRepository) ListEvents(filter models.EventFilter, limit int64) ([]models.EventFields, error) {
+	fake.listEventsMutex.Lock()
+	fake.listEventsArgsForCall = append(fake.listEventsArgsForCall, struct {
+		filter models.EventFilter
+		limit  int64
+	}{filter, limit})
+	fake.listEventsMutex.Unlock()
+	if fake.ListEventsStub != nil {
+		return fake.ListEventsStub(filter, limit)
+	} else {
+		return fake.listEventsReturns.result1, fake.listEventsReturns.result2
+	}
+}
+
+func (fake *FakeAppEventsRepository) ListEventsCallCount() int {
+	fake.listEventsMutex.RLock()
+	defer fake.listEventsMutex.RUnlock()
+	return len(fake.listEventsArgsForCall)
+}
+
+func (fake *FakeAppEventsRepository) ListEventsArgsForCall(i int) (models.EventFilter, int64) {
+	fake.listEventsMutex.RLock()
+	defer fake.listEventsMutex.RUnlock()
+	return fake.listEventsArgsForCall[i].filter, fake.listEventsArgsForCall[i].limit
+}
+
+func (fake *FakeAppEventsRepository) ListEventsReturns(result1 []models.EventFields, result2 error) {
+	fake.ListEventsStub = nil
+	fake.listEventsReturns = struct {
+		result1 []models.EventFields
+		result2 error
+	}{result1, result2}
+}
+
 var _ appevents.AppEventsRepository = new(FakeAppEventsRepository)
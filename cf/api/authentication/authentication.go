@@ -28,6 +28,7 @@ type AuthenticationRepository interface {
 
 	RefreshAuthToken() (updatedToken string, apiErr error)
 	Authenticate(credentials map[string]string) (apiErr error)
+	AuthenticateClientCredentials(clientID, clientSecret string) (apiErr error)
 	Authorize(token string) (string, error)
 	GetLoginPromptsAndSaveUAAServerURL() (map[string]coreconfig.AuthPrompt, error)
 }
@@ -119,24 +120,48 @@ func (uaa UAAAuthenticationRepository) Authenticate(credentials map[string]strin
 		data[key] = []string{val}
 	}
 
-	err := uaa.getAuthToken(data)
+	err := uaa.getAuthToken(data, "cf", "")
 	if err != nil {
-		httpError, ok := err.(errors.HTTPError)
-		if ok {
-			switch {
-			case httpError.StatusCode() == http.StatusUnauthorized:
-				return errors.New(T("Credentials were rejected, please try again."))
-			case httpError.StatusCode() >= http.StatusInternalServerError:
-				return errors.New(T("The targeted API endpoint could not be reached."))
-			}
-		}
+		return authenticationError(err)
+	}
 
-		return err
+	return nil
+}
+
+// AuthenticateClientCredentials gets a token for the client itself with the
+// client_credentials grant. The client is saved in the config, as there is
+// no refresh token and the token has to be requested again once it expires.
+func (uaa UAAAuthenticationRepository) AuthenticateClientCredentials(clientID, clientSecret string) error {
+	data := url.Values{
+		"grant_type": {"client_credentials"},
 	}
 
+	err := uaa.getAuthToken(data, clientID, clientSecret)
+	if err != nil {
+		return authenticationError(err)
+	}
+
+	uaa.config.SetUAAGrantType("client_credentials")
+	uaa.config.SetUAAOAuthClient(clientID)
+	uaa.config.SetUAAOAuthClientSecret(clientSecret)
+
 	return nil
 }
 
+func authenticationError(err error) error {
+	httpError, ok := err.(errors.HTTPError)
+	if ok {
+		switch {
+		case httpError.StatusCode() == http.StatusUnauthorized:
+			return errors.New(T("Credentials were rejected, please try again."))
+		case httpError.StatusCode() >= http.StatusInternalServerError:
+			return errors.New(T("The targeted API endpoint could not be reached."))
+		}
+	}
+
+	return err
+}
+
 func (uaa UAAAuthenticationRepository) DumpRequest(req *http.Request) {
 	uaa.dumper.DumpRequest(req)
 }
@@ -145,6 +170,10 @@ func (uaa UAAAuthenticationRepository) DumpResponse(res *http.Response) {
 	uaa.dumper.DumpResponse(res)
 }
 
+func (uaa UAAAuthenticationRepository) DumpError(req *http.Request, err error) {
+	uaa.dumper.DumpError(req, err)
+}
+
 type LoginResource struct {
 	Prompts map[string][]string
 	Links   map[string]string
@@ -181,19 +210,28 @@ func (uaa UAAAuthenticationRepository) GetLoginPromptsAndSaveUAAServerURL() (pro
 }
 
 func (uaa UAAAuthenticationRepository) RefreshAuthToken() (string, error) {
+	if uaa.config.UAAGrantType() == "client_credentials" {
+		data := url.Values{
+			"grant_type": {"client_credentials"},
+		}
+
+		apiErr := uaa.getAuthToken(data, uaa.config.UAAOAuthClient(), uaa.config.UAAOAuthClientSecret())
+		return uaa.config.AccessToken(), apiErr
+	}
+
 	data := url.Values{
 		"refresh_token": {uaa.config.RefreshToken()},
 		"grant_type":    {"refresh_token"},
 		"scope":         {""},
 	}
 
-	apiErr := uaa.getAuthToken(data)
+	apiErr := uaa.getAuthToken(data, "cf", "")
 	updatedToken := uaa.config.AccessToken()
 
 	return updatedToken, apiErr
 }
 
-func (uaa UAAAuthenticationRepository) getAuthToken(data url.Values) error {
+func (uaa UAAAuthenticationRepository) getAuthToken(data url.Values, clientID, clientSecret string) error {
 	type uaaErrorResponse struct {
 		Code        string `json:"error"`
 		Description string `json:"error_description"`
@@ -207,7 +245,7 @@ func (uaa UAAAuthenticationRepository) getAuthToken(data url.Values) error {
 	}
 
 	path := fmt.Sprintf("%s/oauth/token", uaa.config.AuthenticationEndpoint())
-	request, err := uaa.gateway.NewRequest("POST", path, "Basic "+base64.StdEncoding.EncodeToString([]byte("cf:")), strings.NewReader(data.Encode()))
+	request, err := uaa.gateway.NewRequest("POST", path, "Basic "+base64.StdEncoding.EncodeToString([]byte(clientID+":"+clientSecret)), strings.NewReader(data.Encode()))
 	if err != nil {
 		return fmt.Errorf("%s: %s", T("Failed to start oauth request"), err.Error())
 	}
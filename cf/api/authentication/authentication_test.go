@@ -136,6 +136,45 @@ var _ = Describe("AuthenticationRepository", func() {
 			})
 		})
 
+		Describe("authenticating with client credentials", func() {
+			var err error
+
+			JustBeforeEach(func() {
+				err = auth.AuthenticateClientCredentials("my-client", "my-client-secret")
+			})
+
+			Context("when login succeeds", func() {
+				BeforeEach(func() {
+					setupTestServer(successfulClientCredentialsRequest)
+				})
+
+				It("stores the access token and the client in the config", func() {
+					Expect(handler).To(HaveAllRequestsCalled())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(config.AccessToken()).To(Equal("bearer my_client_access_token"))
+					Expect(config.RefreshToken()).To(BeEmpty())
+					Expect(config.UAAGrantType()).To(Equal("client_credentials"))
+					Expect(config.UAAOAuthClient()).To(Equal("my-client"))
+					Expect(config.UAAOAuthClientSecret()).To(Equal("my-client-secret"))
+				})
+			})
+
+			Context("when login fails", func() {
+				BeforeEach(func() {
+					setupTestServer(unsuccessfulLoginRequest)
+				})
+
+				It("returns an error and does not store the client", func() {
+					Expect(handler).To(HaveAllRequestsCalled())
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(Equal("Credentials were rejected, please try again."))
+					Expect(config.AccessToken()).To(BeEmpty())
+					Expect(config.UAAGrantType()).To(BeEmpty())
+					Expect(config.UAAOAuthClient()).To(BeEmpty())
+				})
+			})
+		})
+
 		Describe("getting login info", func() {
 			var (
 				apiErr  error
@@ -220,6 +259,21 @@ var _ = Describe("AuthenticationRepository", func() {
 					Expect(apiErr).NotTo(BeNil())
 				})
 			})
+
+			Context("when logged in with client credentials", func() {
+				BeforeEach(func() {
+					config.SetUAAGrantType("client_credentials")
+					config.SetUAAOAuthClient("my-client")
+					config.SetUAAOAuthClientSecret("my-client-secret")
+					setupTestServer(successfulClientCredentialsRequest)
+				})
+
+				It("requests a new token with the client credentials", func() {
+					Expect(handler).To(HaveAllRequestsCalled())
+					Expect(apiErr).NotTo(HaveOccurred())
+					Expect(config.AccessToken()).To(Equal("bearer my_client_access_token"))
+				})
+			})
 		})
 	})
 
@@ -360,6 +414,34 @@ var successfulLoginMatcher = func(request *http.Request) {
 	Expect(request.Form.Get("scope")).To(Equal(""))
 }
 
+var successfulClientCredentialsRequest = testnet.TestRequest{
+	Method: "POST",
+	Path:   "/oauth/token",
+	Header: http.Header{
+		"accept":        {"application/json"},
+		"content-type":  {"application/x-www-form-urlencoded"},
+		"authorization": {"Basic " + base64.StdEncoding.EncodeToString([]byte("my-client:my-client-secret"))},
+	},
+	Matcher: func(request *http.Request) {
+		err := request.ParseForm()
+		if err != nil {
+			Fail(fmt.Sprintf("Failed to parse form: %s", err))
+			return
+		}
+
+		Expect(request.Form.Get("grant_type")).To(Equal("client_credentials"))
+		Expect(request.Form.Get("refresh_token")).To(BeEmpty())
+	},
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `
+{
+  "access_token": "my_client_access_token",
+  "token_type": "bearer",
+  "expires_in": 43199
+} `},
+}
+
 var unsuccessfulLoginRequest = testnet.TestRequest{
 	Method: "POST",
 	Path:   "/oauth/token",
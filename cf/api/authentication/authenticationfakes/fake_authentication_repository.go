@@ -20,6 +20,12 @@ type FakeAuthenticationRepository struct {
 	dumpResponseArgsForCall []struct {
 		arg1 *http.Response
 	}
+	DumpErrorStub        func(*http.Request, error)
+	dumpErrorMutex       sync.RWMutex
+	dumpErrorArgsForCall []struct {
+		arg1 *http.Request
+		arg2 error
+	}
 	RefreshAuthTokenStub        func() (updatedToken string, apiErr error)
 	refreshAuthTokenMutex       sync.RWMutex
 	refreshAuthTokenArgsForCall []struct{}
@@ -35,6 +41,15 @@ type FakeAuthenticationRepository struct {
 	authenticateReturns struct {
 		result1 error
 	}
+	AuthenticateClientCredentialsStub        func(clientID, clientSecret string) (apiErr error)
+	authenticateClientCredentialsMutex       sync.RWMutex
+	authenticateClientCredentialsArgsForCall []struct {
+		clientID     string
+		clientSecret string
+	}
+	authenticateClientCredentialsReturns struct {
+		result1 error
+	}
 	AuthorizeStub        func(token string) (string, error)
 	authorizeMutex       sync.RWMutex
 	authorizeArgsForCall []struct {
@@ -99,6 +114,30 @@ func (fake *FakeAuthenticationRepository) DumpResponseArgsForCall(i int) *http.R
 	return fake.dumpResponseArgsForCall[i].arg1
 }
 
+func (fake *FakeAuthenticationRepository) DumpError(arg1 *http.Request, arg2 error) {
+	fake.dumpErrorMutex.Lock()
+	fake.dumpErrorArgsForCall = append(fake.dumpErrorArgsForCall, struct {
+		arg1 *http.Request
+		arg2 error
+	}{arg1, arg2})
+	fake.dumpErrorMutex.Unlock()
+	if fake.DumpErrorStub != nil {
+		fake.DumpErrorStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeAuthenticationRepository) DumpErrorCallCount() int {
+	fake.dumpErrorMutex.RLock()
+	defer fake.dumpErrorMutex.RUnlock()
+	return len(fake.dumpErrorArgsForCall)
+}
+
+func (fake *FakeAuthenticationRepository) DumpErrorArgsForCall(i int) (*http.Request, error) {
+	fake.dumpErrorMutex.RLock()
+	defer fake.dumpErrorMutex.RUnlock()
+	return fake.dumpErrorArgsForCall[i].arg1, fake.dumpErrorArgsForCall[i].arg2
+}
+
 func (fake *FakeAuthenticationRepository) RefreshAuthToken() (updatedToken string, apiErr error) {
 	fake.refreshAuthTokenMutex.Lock()
 	fake.refreshAuthTokenArgsForCall = append(fake.refreshAuthTokenArgsForCall, struct{}{})
@@ -156,6 +195,39 @@ func (fake *FakeAuthenticationRepository) AuthenticateReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeAuthenticationRepository) AuthenticateClientCredentials(clientID string, clientSecret string) (apiErr error) {
+	fake.authenticateClientCredentialsMutex.Lock()
+	fake.authenticateClientCredentialsArgsForCall = append(fake.authenticateClientCredentialsArgsForCall, struct {
+		clientID     string
+		clientSecret string
+	}{clientID, clientSecret})
+	fake.authenticateClientCredentialsMutex.Unlock()
+	if fake.AuthenticateClientCredentialsStub != nil {
+		return fake.AuthenticateClientCredentialsStub(clientID, clientSecret)
+	} else {
+		return fake.authenticateClientCredentialsReturns.result1
+	}
+}
+
+func (fake *FakeAuthenticationRepository) AuthenticateClientCredentialsCallCount() int {
+	fake.authenticateClientCredentialsMutex.RLock()
+	defer fake.authenticateClientCredentialsMutex.RUnlock()
+	return len(fake.authenticateClientCredentialsArgsForCall)
+}
+
+func (fake *FakeAuthenticationRepository) AuthenticateClientCredentialsArgsForCall(i int) (string, string) {
+	fake.authenticateClientCredentialsMutex.RLock()
+	defer fake.authenticateClientCredentialsMutex.RUnlock()
+	return fake.authenticateClientCredentialsArgsForCall[i].clientID, fake.authenticateClientCredentialsArgsForCall[i].clientSecret
+}
+
+func (fake *FakeAuthenticationRepository) AuthenticateClientCredentialsReturns(result1 error) {
+	fake.AuthenticateClientCredentialsStub = nil
+	fake.authenticateClientCredentialsReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeAuthenticationRepository) Authorize(token string) (string, error) {
 	fake.authorizeMutex.Lock()
 	fake.authorizeArgsForCall = append(fake.authorizeArgsForCall, struct {
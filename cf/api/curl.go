@@ -19,6 +19,7 @@ import (
 
 type CurlRepository interface {
 	Request(method, path, header, body string) (resHeaders string, resBody string, apiErr error)
+	RequestForResponse(method, path string, header http.Header, body string) (statusCode int, resHeader http.Header, resBody string, apiErr error)
 }
 
 type CloudControllerCurlRepository struct {
@@ -33,6 +34,48 @@ func NewCloudControllerCurlRepository(config coreconfig.Reader, gateway net.Gate
 }
 
 func (repo CloudControllerCurlRepository) Request(method, path, headerString, body string) (resHeaders, resBody string, err error) {
+	res, resBody, err := repo.perform(method, path, body, func(header http.Header) error {
+		err := mergeHeaders(header, headerString)
+		if err != nil {
+			return fmt.Errorf("%s: %s", T("Error parsing headers"), err.Error())
+		}
+		return nil
+	})
+
+	if res != nil {
+		headerBytes, _ := httputil.DumpResponse(res, false)
+		resHeaders = string(headerBytes)
+	}
+
+	return
+}
+
+// RequestForResponse performs the request the way Request does, with the
+// headers replacing the default headers of the same name, and returns the
+// status, headers and body of the response.
+func (repo CloudControllerCurlRepository) RequestForResponse(method, path string, header http.Header, body string) (statusCode int, resHeader http.Header, resBody string, err error) {
+	res, resBody, err := repo.perform(method, path, body, func(destination http.Header) error {
+		for key, values := range header {
+			destination.Del(key)
+			for _, value := range values {
+				destination.Add(key, value)
+			}
+		}
+		return nil
+	})
+
+	if res != nil {
+		statusCode = res.StatusCode
+		resHeader = res.Header
+	}
+
+	return
+}
+
+// perform sends the request with the current access token and returns the
+// response with its body read, unless the request could not be made. Error
+// statuses are returned as responses rather than errors.
+func (repo CloudControllerCurlRepository) perform(method, path, body string, setHeaders func(http.Header) error) (res *http.Response, resBody string, err error) {
 	url := fmt.Sprintf("%s/%s", repo.config.APIEndpoint(), strings.TrimLeft(path, "/"))
 
 	if method == "" && body != "" {
@@ -44,26 +87,22 @@ func (repo CloudControllerCurlRepository) Request(method, path, headerString, bo
 		return
 	}
 
-	err = mergeHeaders(req.HTTPReq.Header, headerString)
+	err = setHeaders(req.HTTPReq.Header)
 	if err != nil {
-		err = fmt.Errorf("%s: %s", T("Error parsing headers"), err.Error())
 		return
 	}
 
-	res, err := repo.gateway.PerformRequest(req)
+	res, err = repo.gateway.PerformRequest(req)
 
 	if _, ok := err.(errors.HTTPError); ok {
 		err = nil
 	}
 
 	if err != nil {
-		return
+		return nil, "", err
 	}
 	defer res.Body.Close()
 
-	headerBytes, _ := httputil.DumpResponse(res, false)
-	resHeaders = string(headerBytes)
-
 	bytes, err := ioutil.ReadAll(res.Body)
 	if err != nil {
 		err = fmt.Errorf("%s: %s", T("Error reading response"), err.Error())
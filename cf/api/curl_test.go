@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/cloudfoundry/cli/cf/api/apifakes"
+	"github.com/cloudfoundry/cli/cf/api/authentication/authenticationfakes"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/net"
 	testassert "github.com/cloudfoundry/cli/testhelpers/assert"
@@ -156,6 +157,83 @@ var _ = Describe("CloudControllerCurlRepository ", func() {
 		})
 	})
 
+	Describe("RequestForResponse", func() {
+		var (
+			ccServer *ghttp.Server
+			deps     curlDependencies
+			repo     CloudControllerCurlRepository
+		)
+
+		BeforeEach(func() {
+			ccServer = ghttp.NewServer()
+			deps = newCurlDependencies()
+			deps.config.SetAPIEndpoint(ccServer.URL())
+			repo = NewCloudControllerCurlRepository(deps.config, deps.gateway)
+		})
+
+		AfterEach(func() {
+			ccServer.Close()
+		})
+
+		It("returns the status, headers and body of the response", func() {
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v2/endpoint"),
+					ghttp.VerifyHeader(http.Header{
+						"Authorization": []string{"BEARER my_access_token"},
+						"X-Something":   []string{"a", "b"},
+					}),
+					ghttp.VerifyBody([]byte(`{"name":"thing"}`)),
+					ghttp.RespondWith(http.StatusCreated, expectedJSONResponse, http.Header{"X-Cf-Warnings": []string{"careful"}}),
+				),
+			)
+
+			statusCode, header, body, err := repo.RequestForResponse("PUT", "/v2/endpoint", http.Header{"x-something": []string{"a", "b"}}, `{"name":"thing"}`)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(statusCode).To(Equal(http.StatusCreated))
+			Expect(header.Get("X-Cf-Warnings")).To(Equal("careful"))
+			testassert.JSONStringEquals(body, expectedJSONResponse)
+		})
+
+		It("returns error statuses as responses", func() {
+			ccServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusNotFound, `{"code":10000,"description":"Unknown request"}`),
+			)
+
+			statusCode, _, body, err := repo.RequestForResponse("GET", "/v2/missing", nil, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(statusCode).To(Equal(http.StatusNotFound))
+			Expect(body).To(ContainSubstring("Unknown request"))
+		})
+
+		It("refreshes the access token when it has expired", func() {
+			auth := new(authenticationfakes.FakeAuthenticationRepository)
+			auth.RefreshAuthTokenReturns("BEARER new_access_token", nil)
+			deps.gateway.SetTokenRefresher(auth)
+			repo = NewCloudControllerCurlRepository(deps.config, deps.gateway)
+
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyHeaderKV("Authorization", "BEARER my_access_token"),
+					ghttp.RespondWith(http.StatusUnauthorized, `{"code":1000,"description":"Invalid Auth Token"}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyHeaderKV("Authorization", "BEARER new_access_token"),
+					ghttp.RespondWith(http.StatusOK, expectedJSONResponse),
+				),
+			)
+
+			statusCode, _, _, err := repo.RequestForResponse("GET", "/v2/endpoint", nil, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(statusCode).To(Equal(http.StatusOK))
+			Expect(auth.RefreshAuthTokenCallCount()).To(Equal(1))
+			Expect(ccServer.ReceivedRequests()).To(HaveLen(2))
+		})
+	})
+
 	It("uses POST as the default method when a body is provided", func() {
 		ccServer := ghttp.NewServer()
 		ccServer.AppendHandlers(
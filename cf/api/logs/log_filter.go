@@ -0,0 +1,81 @@
+package logs
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Filter selects log messages. Empty fields match every message.
+type Filter struct {
+	// SourceTypes are matched against the start of the source name, so
+	// that APP also matches sources like APP/PROC/WEB.
+	SourceTypes []string
+	Instance    string
+	// Stream is either "stdout" or "stderr".
+	Stream  string
+	Pattern *regexp.Regexp
+}
+
+func (f Filter) Matches(msg Loggable) bool {
+	if len(f.SourceTypes) > 0 && !matchesSourceType(msg.GetSourceName(), f.SourceTypes) {
+		return false
+	}
+
+	if f.Instance != "" && msg.GetSourceInstance() != f.Instance {
+		return false
+	}
+
+	switch f.Stream {
+	case "stdout":
+		if msg.IsStderr() {
+			return false
+		}
+	case "stderr":
+		if !msg.IsStderr() {
+			return false
+		}
+	}
+
+	if f.Pattern != nil && !f.Pattern.MatchString(msg.ToSimpleLog()) {
+		return false
+	}
+
+	return true
+}
+
+func matchesSourceType(sourceName string, sourceTypes []string) bool {
+	sourceName = strings.ToUpper(sourceName)
+	for _, sourceType := range sourceTypes {
+		sourceType = strings.ToUpper(sourceType)
+		if sourceName == sourceType || strings.HasPrefix(sourceName, sourceType+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// Record is the machine readable form of a log message.
+type Record struct {
+	Timestamp      string `json:"timestamp" yaml:"timestamp"`
+	SourceType     string `json:"source_type" yaml:"source_type"`
+	SourceInstance string `json:"source_instance" yaml:"source_instance"`
+	Stream         string `json:"stream" yaml:"stream"`
+	Message        string `json:"message" yaml:"message"`
+}
+
+// NewRecord returns the record of msg with its timestamp in loc.
+func NewRecord(msg Loggable, loc *time.Location) Record {
+	stream := "stdout"
+	if msg.IsStderr() {
+		stream = "stderr"
+	}
+
+	return Record{
+		Timestamp:      msg.GetTimestamp().In(loc).Format(time.RFC3339Nano),
+		SourceType:     msg.GetSourceName(),
+		SourceInstance: msg.GetSourceInstance(),
+		Stream:         stream,
+		Message:        msg.ToSimpleLog(),
+	}
+}
@@ -0,0 +1,90 @@
+package logs_test
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/cloudfoundry/cli/cf/api/logs"
+	testlogs "github.com/cloudfoundry/cli/testhelpers/logs"
+
+	"github.com/cloudfoundry/loggregatorlib/logmessage"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Filter", func() {
+	var (
+		date      time.Time
+		appOut    logs.Loggable
+		appErr    logs.Loggable
+		routerOut logs.Loggable
+	)
+
+	BeforeEach(func() {
+		date = time.Date(2014, 4, 4, 11, 39, 20, 5, time.UTC)
+		appOut = testlogs.NewLogMessage("Hello World!\n", "app-guid", "APP/PROC/WEB", "0", logmessage.LogMessage_OUT, date)
+		appErr = testlogs.NewLogMessage("Something failed", "app-guid", "APP", "1", logmessage.LogMessage_ERR, date)
+		routerOut = testlogs.NewLogMessage("GET / 200", "app-guid", "RTR", "0", logmessage.LogMessage_OUT, date)
+	})
+
+	It("matches every message when empty", func() {
+		filter := logs.Filter{}
+		Expect(filter.Matches(appOut)).To(BeTrue())
+		Expect(filter.Matches(appErr)).To(BeTrue())
+		Expect(filter.Matches(routerOut)).To(BeTrue())
+	})
+
+	It("matches source types by prefix and regardless of case", func() {
+		filter := logs.Filter{SourceTypes: []string{"app"}}
+		Expect(filter.Matches(appOut)).To(BeTrue())
+		Expect(filter.Matches(appErr)).To(BeTrue())
+		Expect(filter.Matches(routerOut)).To(BeFalse())
+
+		filter = logs.Filter{SourceTypes: []string{"AP", "RTR"}}
+		Expect(filter.Matches(appOut)).To(BeFalse())
+		Expect(filter.Matches(routerOut)).To(BeTrue())
+	})
+
+	It("matches the instance", func() {
+		filter := logs.Filter{Instance: "1"}
+		Expect(filter.Matches(appOut)).To(BeFalse())
+		Expect(filter.Matches(appErr)).To(BeTrue())
+	})
+
+	It("matches the stream", func() {
+		filter := logs.Filter{Stream: "stderr"}
+		Expect(filter.Matches(appOut)).To(BeFalse())
+		Expect(filter.Matches(appErr)).To(BeTrue())
+
+		filter = logs.Filter{Stream: "stdout"}
+		Expect(filter.Matches(appOut)).To(BeTrue())
+		Expect(filter.Matches(appErr)).To(BeFalse())
+	})
+
+	It("matches the message against the pattern", func() {
+		filter := logs.Filter{Pattern: regexp.MustCompile(`^GET .* 2\d\d$`)}
+		Expect(filter.Matches(appOut)).To(BeFalse())
+		Expect(filter.Matches(routerOut)).To(BeTrue())
+	})
+
+	It("requires all of the conditions to match", func() {
+		filter := logs.Filter{SourceTypes: []string{"APP"}, Instance: "0", Stream: "stderr"}
+		Expect(filter.Matches(appOut)).To(BeFalse())
+		Expect(filter.Matches(appErr)).To(BeFalse())
+	})
+})
+
+var _ = Describe("NewRecord", func() {
+	It("returns the fields of the message", func() {
+		date := time.Date(2014, 4, 4, 11, 39, 20, 5, time.UTC)
+		msg := testlogs.NewLogMessage("Hello \"World\"\n", "app-guid", "APP", "2", logmessage.LogMessage_ERR, date)
+
+		Expect(logs.NewRecord(msg, time.UTC)).To(Equal(logs.Record{
+			Timestamp:      "2014-04-04T11:39:20.000000005Z",
+			SourceType:     "APP",
+			SourceInstance: "2",
+			Stream:         "stderr",
+			Message:        "Hello \"World\"",
+		}))
+	})
+})
@@ -6,6 +6,9 @@ type Loggable interface {
 	ToLog(loc *time.Location) string
 	ToSimpleLog() string
 	GetSourceName() string
+	GetSourceInstance() string
+	GetTimestamp() time.Time
+	IsStderr() bool
 }
 
 //go:generate counterfeiter . LogsRepository
@@ -63,6 +63,7 @@ type RepositoryLocator struct {
 	userRepo                        UserRepository
 	passwordRepo                    password.PasswordRepository
 	logsRepo                        logs.LogsRepository
+	newLogsRepo                     func() logs.LogsRepository
 	authTokenRepo                   ServiceAuthTokenRepository
 	serviceBrokerRepo               ServiceBrokerRepository
 	servicePlanRepo                 CloudControllerServicePlanRepository
@@ -109,15 +110,19 @@ func NewRepositoryLocator(config coreconfig.ReadWriter, gatewaysByName map[strin
 
 	apiVersion, _ := semver.Make(config.APIVersion())
 
-	if apiVersion.GTE(cf.NoaaMinimumAPIVersion) {
-		consumer := consumer.New(config.DopplerEndpoint(), tlsConfig, http.ProxyFromEnvironment)
-		consumer.SetDebugPrinter(terminal.DebugPrinter{Logger: logger})
-		loc.logsRepo = logs.NewNoaaLogsRepository(config, consumer, loc.authRepo)
-	} else {
+	authRepo := loc.authRepo
+	loc.newLogsRepo = func() logs.LogsRepository {
+		if apiVersion.GTE(cf.NoaaMinimumAPIVersion) {
+			consumer := consumer.New(config.DopplerEndpoint(), tlsConfig, http.ProxyFromEnvironment)
+			consumer.SetDebugPrinter(terminal.DebugPrinter{Logger: logger})
+			return logs.NewNoaaLogsRepository(config, consumer, authRepo)
+		}
+
 		consumer := loggregator_consumer.New(config.LoggregatorEndpoint(), tlsConfig, http.ProxyFromEnvironment)
 		consumer.SetDebugPrinter(terminal.DebugPrinter{Logger: logger})
-		loc.logsRepo = logs.NewLoggregatorLogsRepository(config, consumer, loc.authRepo)
+		return logs.NewLoggregatorLogsRepository(config, consumer, authRepo)
 	}
+	loc.logsRepo = loc.newLogsRepo()
 
 	loc.organizationRepo = organizations.NewCloudControllerOrganizationRepository(config, cloudControllerGateway)
 	loc.passwordRepo = password.NewCloudControllerPasswordRepository(config, uaaGateway)
@@ -148,7 +153,7 @@ func NewRepositoryLocator(config coreconfig.ReadWriter, gatewaysByName map[strin
 	loc.copyAppSourceRepo = copyapplicationsource.NewCloudControllerCopyApplicationSourceRepository(config, cloudControllerGateway)
 
 	client := v3client.NewClient(config.APIEndpoint(), config.AuthenticationEndpoint(), config.AccessToken(), config.RefreshToken())
-	loc.v3Repository = repository.NewRepository(config, client)
+	loc.v3Repository = repository.NewRepository(config, client, cloudControllerGateway)
 
 	return
 }
@@ -363,6 +368,21 @@ func (locator RepositoryLocator) GetLogsRepository() logs.LogsRepository {
 	return locator.logsRepo
 }
 
+func (locator RepositoryLocator) SetLogsRepositoryFactory(newRepo func() logs.LogsRepository) RepositoryLocator {
+	locator.newLogsRepo = newRepo
+	return locator
+}
+
+// NewLogsRepository returns a logs repository with a consumer of its own,
+// for tailing logs while other commands tail theirs. Without a factory it
+// falls back to the shared logs repository.
+func (locator RepositoryLocator) NewLogsRepository() logs.LogsRepository {
+	if locator.newLogsRepo == nil {
+		return locator.logsRepo
+	}
+	return locator.newLogsRepo()
+}
+
 func (locator RepositoryLocator) SetServiceAuthTokenRepository(repo ServiceAuthTokenRepository) RepositoryLocator {
 	locator.authTokenRepo = repo
 	return locator
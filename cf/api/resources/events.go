@@ -22,6 +22,8 @@ type EventResourceNewV2 struct {
 		Type      string
 		Actor     string `json:"actor"`
 		ActorName string `json:"actor_name"`
+		ActeeType string `json:"actee_type"`
+		ActeeName string `json:"actee_name"`
 		Metadata  map[string]interface{}
 	}
 }
@@ -49,6 +51,8 @@ func (resource EventResourceNewV2) ToFields() models.EventFields {
 		Description: formatDescription(metadata, knownMetadataKeys),
 		Actor:       resource.Entity.Actor,
 		ActorName:   resource.Entity.ActorName,
+		ActeeType:   resource.Entity.ActeeType,
+		ActeeName:   resource.Entity.ActeeName,
 	}
 }
 
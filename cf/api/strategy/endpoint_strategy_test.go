@@ -1,8 +1,11 @@
 package strategy_test
 
 import (
+	"time"
+
 	"github.com/cloudfoundry/cli/cf/api/resources"
 	. "github.com/cloudfoundry/cli/cf/api/strategy"
+	"github.com/cloudfoundry/cli/cf/models"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -31,6 +34,14 @@ var _ = Describe("EndpointStrategy", func() {
 				Expect(strategy.EventsURL("the-guid", 20)).To(Equal("/v2/apps/the-guid/events?results-per-page=20"))
 			})
 
+			It("only filters on the app", func() {
+				Expect(strategy.FilteredEventsURL(models.EventFilter{ActeeGUID: "the-guid", Actor: "admin"}, 20)).To(Equal("/v2/apps/the-guid/events?results-per-page=20"))
+			})
+
+			It("cannot list the events of a space or org", func() {
+				Expect(strategy.FilteredEventsURL(models.EventFilter{SpaceGUID: "space-guid"}, 20)).To(BeEmpty())
+			})
+
 			It("returns an old EventResource", func() {
 				Expect(strategy.EventsResource()).To(BeAssignableToTypeOf(resources.EventResourceOldV2{}))
 			})
@@ -45,6 +56,25 @@ var _ = Describe("EndpointStrategy", func() {
 				Expect(strategy.EventsURL("guids-r-us", 42)).To(Equal("/v2/events?order-direction=desc&q=actee%3Aguids-r-us&results-per-page=42"))
 			})
 
+			It("filters on the app, types and time window", func() {
+				filter := models.EventFilter{
+					ActeeGUID: "guids-r-us",
+					Types:     []string{"audit.app.start", "audit.app.stop"},
+					Since:     time.Date(2016, 1, 2, 3, 4, 5, 0, time.UTC),
+					Until:     time.Date(2016, 1, 3, 3, 4, 5, 0, time.UTC),
+				}
+				Expect(strategy.FilteredEventsURL(filter, 42)).To(Equal("/v2/events?order-direction=desc&q=actee%3Aguids-r-us&q=type+IN+audit.app.start%2Caudit.app.stop&q=timestamp%3E%3D2016-01-02T03%3A04%3A05Z&q=timestamp%3C%3D2016-01-03T03%3A04%3A05Z&results-per-page=42"))
+			})
+
+			It("filters on a single type", func() {
+				Expect(strategy.FilteredEventsURL(models.EventFilter{ActeeGUID: "guids-r-us", Types: []string{"audit.app.start"}}, 42)).To(Equal("/v2/events?order-direction=desc&q=actee%3Aguids-r-us&q=type%3Aaudit.app.start&results-per-page=42"))
+			})
+
+			It("lists the events of a space or org", func() {
+				Expect(strategy.FilteredEventsURL(models.EventFilter{SpaceGUID: "space-guid"}, 10)).To(Equal("/v2/events?order-direction=desc&q=space_guid%3Aspace-guid&results-per-page=10"))
+				Expect(strategy.FilteredEventsURL(models.EventFilter{OrganizationGUID: "org-guid"}, 10)).To(Equal("/v2/events?order-direction=desc&q=organization_guid%3Aorg-guid&results-per-page=10"))
+			})
+
 			It("returns a new EventResource", func() {
 				Expect(strategy.EventsResource()).To(BeAssignableToTypeOf(resources.EventResourceNewV2{}))
 			})
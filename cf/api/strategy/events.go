@@ -1,11 +1,18 @@
 package strategy
 
-import "github.com/cloudfoundry/cli/cf/api/resources"
+import (
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry/cli/cf/api/resources"
+	"github.com/cloudfoundry/cli/cf/models"
+)
 
 //go:generate counterfeiter . EventsEndpointStrategy
 
 type EventsEndpointStrategy interface {
 	EventsURL(appGUID string, limit int64) string
+	FilteredEventsURL(filter models.EventFilter, limit int64) string
 	EventsResource() resources.EventResource
 }
 
@@ -17,6 +24,17 @@ func (s eventsEndpointStrategy) EventsURL(appGUID string, limit int64) string {
 	})
 }
 
+// FilteredEventsURL returns an empty string when the filter is not for a
+// single app, since old cloud controllers only list events per app. The other
+// parts of the filter have to be applied by the caller.
+func (s eventsEndpointStrategy) FilteredEventsURL(filter models.EventFilter, limit int64) string {
+	if filter.ActeeGUID == "" {
+		return ""
+	}
+
+	return s.EventsURL(filter.ActeeGUID, limit)
+}
+
 func (s eventsEndpointStrategy) EventsResource() resources.EventResource {
 	return resources.EventResourceOldV2{}
 }
@@ -31,6 +49,41 @@ func (s globalEventsEndpointStrategy) EventsURL(appGUID string, limit int64) str
 	})
 }
 
+// FilteredEventsURL filters on everything but the actor, which the cloud
+// controller cannot query.
+func (s globalEventsEndpointStrategy) FilteredEventsURL(filter models.EventFilter, limit int64) string {
+	filters := []string{}
+
+	switch {
+	case filter.ActeeGUID != "":
+		filters = append(filters, "actee:"+filter.ActeeGUID)
+	case filter.SpaceGUID != "":
+		filters = append(filters, "space_guid:"+filter.SpaceGUID)
+	case filter.OrganizationGUID != "":
+		filters = append(filters, "organization_guid:"+filter.OrganizationGUID)
+	}
+
+	if len(filter.Types) == 1 {
+		filters = append(filters, "type:"+filter.Types[0])
+	} else if len(filter.Types) > 1 {
+		filters = append(filters, "type IN "+strings.Join(filter.Types, ","))
+	}
+
+	if !filter.Since.IsZero() {
+		filters = append(filters, "timestamp>="+filter.Since.UTC().Format(time.RFC3339))
+	}
+
+	if !filter.Until.IsZero() {
+		filters = append(filters, "timestamp<="+filter.Until.UTC().Format(time.RFC3339))
+	}
+
+	return buildURL(v2("events"), params{
+		resultsPerPage: limit,
+		orderDirection: "desc",
+		filters:        filters,
+	})
+}
+
 func (s globalEventsEndpointStrategy) EventsResource() resources.EventResource {
 	return resources.EventResourceNewV2{}
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cloudfoundry/cli/cf/api/resources"
 	"github.com/cloudfoundry/cli/cf/api/strategy"
+	"github.com/cloudfoundry/cli/cf/models"
 )
 
 type FakeEventsEndpointStrategy struct {
@@ -18,6 +19,15 @@ type FakeEventsEndpointStrategy struct {
 	eventsURLReturns struct {
 		result1 string
 	}
+	FilteredEventsURLStub        func(filter models.EventFilter, limit int64) string
+	filteredEventsURLMutex       sync.RWMutex
+	filteredEventsURLArgsForCall []struct {
+		filter models.EventFilter
+		limit  int64
+	}
+	filteredEventsURLReturns struct {
+		result1 string
+	}
 	EventsResourceStub        func() resources.EventResource
 	eventsResourceMutex       sync.RWMutex
 	eventsResourceArgsForCall []struct{}
@@ -59,6 +69,39 @@ func (fake *FakeEventsEndpointStrategy) EventsURLReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeEventsEndpointStrategy) FilteredEventsURL(filter models.EventFilter, limit int64) string {
+	fake.filteredEventsURLMutex.Lock()
+	fake.filteredEventsURLArgsForCall = append(fake.filteredEventsURLArgsForCall, struct {
+		filter models.EventFilter
+		limit  int64
+	}{filter, limit})
+	fake.filteredEventsURLMutex.Unlock()
+	if fake.FilteredEventsURLStub != nil {
+		return fake.FilteredEventsURLStub(filter, limit)
+	} else {
+		return fake.filteredEventsURLReturns.result1
+	}
+}
+
+func (fake *FakeEventsEndpointStrategy) FilteredEventsURLCallCount() int {
+	fake.filteredEventsURLMutex.RLock()
+	defer fake.filteredEventsURLMutex.RUnlock()
+	return len(fake.filteredEventsURLArgsForCall)
+}
+
+func (fake *FakeEventsEndpointStrategy) FilteredEventsURLArgsForCall(i int) (models.EventFilter, int64) {
+	fake.filteredEventsURLMutex.RLock()
+	defer fake.filteredEventsURLMutex.RUnlock()
+	return fake.filteredEventsURLArgsForCall[i].filter, fake.filteredEventsURLArgsForCall[i].limit
+}
+
+func (fake *FakeEventsEndpointStrategy) FilteredEventsURLReturns(result1 string) {
+	fake.FilteredEventsURLStub = nil
+	fake.filteredEventsURLReturns = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeEventsEndpointStrategy) EventsResource() resources.EventResource {
 	fake.eventsResourceMutex.Lock()
 	fake.eventsResourceArgsForCall = append(fake.eventsResourceArgsForCall, struct{}{})
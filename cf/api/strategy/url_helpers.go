@@ -10,6 +10,7 @@ type params struct {
 	resultsPerPage       int64
 	orderDirection       string
 	q                    map[string]string
+	filters              []string
 	recursive            bool
 	inlineRelationsDepth int64
 }
@@ -42,6 +43,10 @@ func buildURL(path string, params params) string {
 		query.Set("q", q)
 	}
 
+	for _, filter := range params.filters {
+		query.Add("q", filter)
+	}
+
 	if params.recursive {
 		query.Set("recursive", "true")
 	}
@@ -24,7 +24,11 @@ type AppFiles interface {
 	WalkAppFiles(dir string, onEachFile func(string, string) error) (err error)
 }
 
-type ApplicationFiles struct{}
+// ApplicationFiles reads app files from disk. When Cache is set, the SHA1s
+// of files that did not change since the last push are taken from it.
+type ApplicationFiles struct {
+	Cache *ResourceCache
+}
 
 func (appfiles ApplicationFiles) AppFilesInDir(dir string) ([]models.AppFileFields, error) {
 	appFiles := []models.AppFileFields{}
@@ -48,12 +52,15 @@ func (appfiles ApplicationFiles) AppFilesInDir(dir string) ([]models.AppFileFiel
 		if fileInfo.IsDir() {
 			appFile.Sha1 = "0"
 			appFile.Size = 0
+		} else if sha, ok := appfiles.Cache.Sha1(fullDirPath, appFile.Path, fileInfo); ok {
+			appFile.Sha1 = sha
 		} else {
 			sha, err := appfiles.shaFile(fullPath)
 			if err != nil {
 				return err
 			}
 			appFile.Sha1 = sha
+			appfiles.Cache.SetSha1(fullDirPath, appFile.Path, fileInfo, sha)
 		}
 
 		appFiles = append(appFiles, appFile)
@@ -98,6 +98,57 @@ var _ = Describe("AppFiles", func() {
 				Expect(sizes).To(Equal([]int64{0}))
 			})
 		})
+		Context("with a resource cache", func() {
+			var (
+				cacheDir      string
+				resourceCache *appfiles.ResourceCache
+				appPath       string
+			)
+
+			BeforeEach(func() {
+				var err error
+				cacheDir, err = ioutil.TempDir("", "resource-cache")
+				Expect(err).NotTo(HaveOccurred())
+
+				resourceCache = appfiles.NewResourceCache(filepath.Join(cacheDir, "resource_cache.json"))
+				Expect(resourceCache.Load("https://api.example.com")).To(Succeed())
+
+				appFiles = appfiles.ApplicationFiles{Cache: resourceCache}
+				appPath = filepath.Join(fixturePath, "app-with-cfignore")
+			})
+
+			AfterEach(func() {
+				os.RemoveAll(cacheDir)
+			})
+
+			It("uses the cached sha1 of files that did not change", func() {
+				files, err := appFiles.AppFilesInDir(appPath)
+				Expect(err).NotTo(HaveOccurred())
+
+				fullDirPath, err := filepath.Abs(appPath)
+				Expect(err).NotTo(HaveOccurred())
+				fileInfo, err := os.Lstat(filepath.Join(fullDirPath, "dir1", "file1.txt"))
+				Expect(err).NotTo(HaveOccurred())
+
+				sha, ok := resourceCache.Sha1(fullDirPath, "dir1/file1.txt", fileInfo)
+				Expect(ok).To(BeTrue())
+				for _, file := range files {
+					if file.Path == "dir1/file1.txt" {
+						Expect(file.Sha1).To(Equal(sha))
+					}
+				}
+
+				resourceCache.SetSha1(fullDirPath, "dir1/file1.txt", fileInfo, "cached-sha")
+
+				files, err = appFiles.AppFilesInDir(appPath)
+				Expect(err).NotTo(HaveOccurred())
+				for _, file := range files {
+					if file.Path == "dir1/file1.txt" {
+						Expect(file.Sha1).To(Equal("cached-sha"))
+					}
+				}
+			})
+		})
 	})
 
 	Describe("CopyFiles", func() {
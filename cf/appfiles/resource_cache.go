@@ -0,0 +1,293 @@
+package appfiles
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	. "github.com/cloudfoundry/cli/cf/i18n"
+)
+
+// matchedResourceTTL is how long a resource that the Cloud Controller had is
+// assumed to still be there. The Cloud Controller evicts resources from its
+// pool on its own, so it has to be asked again now and then.
+const matchedResourceTTL = 24 * time.Hour
+
+// ResourceCache remembers the SHA1s of app files and the resources that the
+// Cloud Controller already has, so that pushing files that did not change
+// neither hashes them again nor asks the Cloud Controller about them.
+//
+// The cache does nothing until it is loaded, and all of its methods can be
+// called on a nil cache.
+type ResourceCache struct {
+	path     string
+	endpoint string
+	loaded   bool
+	data     resourceCacheData
+	mutex    sync.Mutex
+
+	// seen holds the files looked up since the cache was loaded, per app
+	// directory, and skipped the directories whose files are not cached.
+	seen    map[string]map[string]bool
+	skipped map[string]bool
+}
+
+type resourceCacheData struct {
+	// Apps holds the cached files per app directory, by their path
+	// relative to it.
+	Apps map[string]map[string]CachedFile `json:"apps"`
+
+	// Matched holds the unix time at which the Cloud Controller last had a
+	// resource, per API endpoint and resource key.
+	Matched map[string]map[string]int64 `json:"matched"`
+}
+
+// CachedFile is the SHA1 of a file when it had the given size and
+// modification time.
+type CachedFile struct {
+	Size    int64  `json:"size"`
+	ModTime int64  `json:"mtime"`
+	Sha1    string `json:"sha1"`
+}
+
+func NewResourceCache(path string) *ResourceCache {
+	return &ResourceCache{path: path}
+}
+
+// Load reads the cache from disk. Resources are remembered per API
+// endpoint, as each Cloud Controller has its own resource pool.
+func (cache *ResourceCache) Load(apiEndpoint string) error {
+	if cache == nil {
+		return nil
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.endpoint = apiEndpoint
+	cache.loaded = true
+	cache.data = resourceCacheData{}
+	cache.seen = map[string]map[string]bool{}
+	cache.skipped = map[string]bool{}
+
+	err := cache.read()
+	if err != nil {
+		cache.data = resourceCacheData{}
+	}
+
+	if cache.data.Apps == nil {
+		cache.data.Apps = map[string]map[string]CachedFile{}
+	}
+	if cache.data.Matched == nil {
+		cache.data.Matched = map[string]map[string]int64{}
+	}
+	if cache.data.Matched[apiEndpoint] == nil {
+		cache.data.Matched[apiEndpoint] = map[string]int64{}
+	}
+
+	for key, matchedAt := range cache.data.Matched[apiEndpoint] {
+		if isExpired(matchedAt) {
+			delete(cache.data.Matched[apiEndpoint], key)
+		}
+	}
+
+	return err
+}
+
+func (cache *ResourceCache) read() error {
+	contents, err := ioutil.ReadFile(cache.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(contents, &cache.data)
+	if err != nil {
+		return errors.New(T("Invalid resource cache {{.Path}}: {{.Err}}",
+			map[string]interface{}{"Path": cache.path, "Err": err.Error()}))
+	}
+
+	return nil
+}
+
+// Save writes the cache back to disk if it was loaded. Files of the app
+// directories pushed since the cache was loaded that were not seen are
+// gone and dropped, as are app directories that no longer exist.
+func (cache *ResourceCache) Save() error {
+	if cache == nil {
+		return nil
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if !cache.loaded {
+		return nil
+	}
+
+	cache.prune()
+
+	contents, err := json.Marshal(cache.data)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(cache.path), 0700)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(cache.path, contents, 0600)
+}
+
+func (cache *ResourceCache) prune() {
+	for appDir, files := range cache.data.Apps {
+		seen, pushed := cache.seen[appDir]
+		if !pushed {
+			if _, err := os.Stat(appDir); os.IsNotExist(err) {
+				delete(cache.data.Apps, appDir)
+			}
+			continue
+		}
+
+		for fileName := range files {
+			if !seen[fileName] {
+				delete(files, fileName)
+			}
+		}
+	}
+}
+
+// SkipDir keeps the files of the app directory appDir out of the cache.
+// This is for directories such as extracted archives, whose files are
+// new on every push.
+func (cache *ResourceCache) SkipDir(appDir string) {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if !cache.loaded {
+		return
+	}
+
+	cache.skipped[filepath.Clean(appDir)] = true
+}
+
+// Sha1 returns the SHA1 of the file fileName in the app directory appDir
+// if it has not changed since it was cached.
+func (cache *ResourceCache) Sha1(appDir, fileName string, fileInfo os.FileInfo) (string, bool) {
+	if cache == nil {
+		return "", false
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if !cache.loaded || cache.skipped[appDir] {
+		return "", false
+	}
+
+	if cache.seen[appDir] == nil {
+		cache.seen[appDir] = map[string]bool{}
+	}
+	cache.seen[appDir][fileName] = true
+
+	cachedFile, ok := cache.data.Apps[appDir][fileName]
+	if !ok || cachedFile.Size != fileInfo.Size() || cachedFile.ModTime != fileInfo.ModTime().UnixNano() {
+		return "", false
+	}
+
+	return cachedFile.Sha1, true
+}
+
+func (cache *ResourceCache) SetSha1(appDir, fileName string, fileInfo os.FileInfo, sha1 string) {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if !cache.loaded || cache.skipped[appDir] {
+		return
+	}
+
+	if cache.data.Apps[appDir] == nil {
+		cache.data.Apps[appDir] = map[string]CachedFile{}
+	}
+	cache.data.Apps[appDir][fileName] = CachedFile{
+		Size:    fileInfo.Size(),
+		ModTime: fileInfo.ModTime().UnixNano(),
+		Sha1:    sha1,
+	}
+}
+
+// IsMatched tells whether the Cloud Controller recently had the resource
+// with the given SHA1 and size.
+func (cache *ResourceCache) IsMatched(sha1 string, size int64) bool {
+	if cache == nil {
+		return false
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if !cache.loaded {
+		return false
+	}
+
+	matchedAt, ok := cache.data.Matched[cache.endpoint][resourceKey(sha1, size)]
+	return ok && !isExpired(matchedAt)
+}
+
+func (cache *ResourceCache) SetMatched(sha1 string, size int64) {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if !cache.loaded {
+		return
+	}
+
+	cache.data.Matched[cache.endpoint][resourceKey(sha1, size)] = time.Now().Unix()
+}
+
+// ForgetMatched forgets all the resources the Cloud Controller had, for when
+// it turns out that it no longer has some of them. It tells whether there was
+// anything to forget.
+func (cache *ResourceCache) ForgetMatched() bool {
+	if cache == nil {
+		return false
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if !cache.loaded || len(cache.data.Matched[cache.endpoint]) == 0 {
+		return false
+	}
+
+	cache.data.Matched[cache.endpoint] = map[string]int64{}
+	return true
+}
+
+func isExpired(matchedAt int64) bool {
+	return time.Since(time.Unix(matchedAt, 0)) > matchedResourceTTL
+}
+
+func resourceKey(sha1 string, size int64) string {
+	return fmt.Sprintf("%s:%d", sha1, size)
+}
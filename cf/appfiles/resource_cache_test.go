@@ -0,0 +1,232 @@
+package appfiles_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cloudfoundry/cli/cf/appfiles"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResourceCache", func() {
+	var (
+		dir       string
+		cachePath string
+		filePath  string
+		fileInfo  os.FileInfo
+		cache     *appfiles.ResourceCache
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "resource-cache")
+		Expect(err).NotTo(HaveOccurred())
+
+		cachePath = filepath.Join(dir, "cache", "resource_cache.json")
+		filePath = filepath.Join(dir, "file.txt")
+		Expect(ioutil.WriteFile(filePath, []byte("contents"), 0600)).To(Succeed())
+
+		fileInfo, err = os.Lstat(filePath)
+		Expect(err).NotTo(HaveOccurred())
+
+		cache = appfiles.NewResourceCache(cachePath)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("does nothing until it is loaded", func() {
+		cache.SetSha1(dir, "file.txt", fileInfo, "some-sha")
+		cache.SetMatched("some-sha", 8)
+
+		_, ok := cache.Sha1(dir, "file.txt", fileInfo)
+		Expect(ok).To(BeFalse())
+		Expect(cache.IsMatched("some-sha", 8)).To(BeFalse())
+
+		Expect(cache.Save()).To(Succeed())
+		_, err := os.Stat(cachePath)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("can be used when nil", func() {
+		var nilCache *appfiles.ResourceCache
+		Expect(nilCache.Load("https://api.example.com")).To(Succeed())
+		nilCache.SetMatched("some-sha", 8)
+		Expect(nilCache.IsMatched("some-sha", 8)).To(BeFalse())
+		Expect(nilCache.Save()).To(Succeed())
+	})
+
+	Context("when loaded", func() {
+		BeforeEach(func() {
+			Expect(cache.Load("https://api.example.com")).To(Succeed())
+		})
+
+		It("returns the sha1 of files that did not change", func() {
+			cache.SetSha1(dir, "file.txt", fileInfo, "some-sha")
+
+			sha, ok := cache.Sha1(dir, "file.txt", fileInfo)
+			Expect(ok).To(BeTrue())
+			Expect(sha).To(Equal("some-sha"))
+		})
+
+		It("does not return the sha1 of files that changed", func() {
+			cache.SetSha1(dir, "file.txt", fileInfo, "some-sha")
+
+			later := fileInfo.ModTime().Add(time.Minute)
+			Expect(os.Chtimes(filePath, later, later)).To(Succeed())
+			newInfo, err := os.Lstat(filePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, ok := cache.Sha1(dir, "file.txt", newInfo)
+			Expect(ok).To(BeFalse())
+
+			Expect(ioutil.WriteFile(filePath, []byte("new contents"), 0600)).To(Succeed())
+			Expect(os.Chtimes(filePath, fileInfo.ModTime(), fileInfo.ModTime())).To(Succeed())
+			newInfo, err = os.Lstat(filePath)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, ok = cache.Sha1(dir, "file.txt", newInfo)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("keys files by the app directory they are in", func() {
+			cache.SetSha1(dir, "file.txt", fileInfo, "some-sha")
+
+			_, ok := cache.Sha1(filepath.Join(dir, "other-app"), "file.txt", fileInfo)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("does not cache the files of skipped directories", func() {
+			cache.SkipDir(dir)
+			cache.SetSha1(dir, "file.txt", fileInfo, "some-sha")
+
+			_, ok := cache.Sha1(dir, "file.txt", fileInfo)
+			Expect(ok).To(BeFalse())
+		})
+
+		Describe("saving", func() {
+			var (
+				otherDir   string
+				otherCache *appfiles.ResourceCache
+			)
+
+			BeforeEach(func() {
+				otherDir = filepath.Join(dir, "other-app")
+				Expect(os.Mkdir(otherDir, 0700)).To(Succeed())
+
+				cache.SetSha1(dir, "file.txt", fileInfo, "some-sha")
+				cache.SetSha1(dir, "deleted.txt", fileInfo, "deleted-sha")
+				cache.SetSha1(otherDir, "file.txt", fileInfo, "other-sha")
+				Expect(cache.Save()).To(Succeed())
+
+				otherCache = appfiles.NewResourceCache(cachePath)
+				Expect(otherCache.Load("https://api.example.com")).To(Succeed())
+			})
+
+			It("drops the files of pushed app directories that were not seen", func() {
+				_, ok := otherCache.Sha1(dir, "file.txt", fileInfo)
+				Expect(ok).To(BeTrue())
+				Expect(otherCache.Save()).To(Succeed())
+
+				lastCache := appfiles.NewResourceCache(cachePath)
+				Expect(lastCache.Load("https://api.example.com")).To(Succeed())
+
+				_, ok = lastCache.Sha1(dir, "file.txt", fileInfo)
+				Expect(ok).To(BeTrue())
+				_, ok = lastCache.Sha1(dir, "deleted.txt", fileInfo)
+				Expect(ok).To(BeFalse())
+				_, ok = lastCache.Sha1(otherDir, "file.txt", fileInfo)
+				Expect(ok).To(BeTrue())
+			})
+
+			It("drops app directories that no longer exist", func() {
+				Expect(os.RemoveAll(otherDir)).To(Succeed())
+				Expect(otherCache.Save()).To(Succeed())
+
+				lastCache := appfiles.NewResourceCache(cachePath)
+				Expect(lastCache.Load("https://api.example.com")).To(Succeed())
+
+				_, ok := lastCache.Sha1(otherDir, "file.txt", fileInfo)
+				Expect(ok).To(BeFalse())
+				_, ok = lastCache.Sha1(dir, "file.txt", fileInfo)
+				Expect(ok).To(BeTrue())
+			})
+		})
+
+		It("remembers matched resources by sha1 and size", func() {
+			cache.SetMatched("some-sha", 8)
+
+			Expect(cache.IsMatched("some-sha", 8)).To(BeTrue())
+			Expect(cache.IsMatched("some-sha", 9)).To(BeFalse())
+			Expect(cache.IsMatched("other-sha", 8)).To(BeFalse())
+		})
+
+		It("persists across loads", func() {
+			cache.SetSha1(dir, "file.txt", fileInfo, "some-sha")
+			cache.SetMatched("some-sha", 8)
+			Expect(cache.Save()).To(Succeed())
+
+			info, err := os.Stat(cachePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+			otherCache := appfiles.NewResourceCache(cachePath)
+			Expect(otherCache.Load("https://api.example.com")).To(Succeed())
+
+			sha, ok := otherCache.Sha1(dir, "file.txt", fileInfo)
+			Expect(ok).To(BeTrue())
+			Expect(sha).To(Equal("some-sha"))
+			Expect(otherCache.IsMatched("some-sha", 8)).To(BeTrue())
+		})
+
+		It("forgets all matched resources", func() {
+			Expect(cache.ForgetMatched()).To(BeFalse())
+
+			cache.SetMatched("some-sha", 8)
+			Expect(cache.ForgetMatched()).To(BeTrue())
+			Expect(cache.IsMatched("some-sha", 8)).To(BeFalse())
+		})
+
+		It("expires matched resources after a day", func() {
+			matchedAt := time.Now().Add(-25 * time.Hour).Unix()
+			contents := fmt.Sprintf(`{"matched":{"https://api.example.com":{"old-sha:8":%d,"new-sha:8":%d}}}`, matchedAt, time.Now().Unix())
+			Expect(os.MkdirAll(filepath.Dir(cachePath), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(cachePath, []byte(contents), 0600)).To(Succeed())
+
+			otherCache := appfiles.NewResourceCache(cachePath)
+			Expect(otherCache.Load("https://api.example.com")).To(Succeed())
+			Expect(otherCache.IsMatched("old-sha", 8)).To(BeFalse())
+			Expect(otherCache.IsMatched("new-sha", 8)).To(BeTrue())
+		})
+
+		It("keeps matched resources separately for each api endpoint", func() {
+			cache.SetMatched("some-sha", 8)
+			Expect(cache.Save()).To(Succeed())
+
+			otherCache := appfiles.NewResourceCache(cachePath)
+			Expect(otherCache.Load("https://api.other.com")).To(Succeed())
+			Expect(otherCache.IsMatched("some-sha", 8)).To(BeFalse())
+
+			_, ok := otherCache.Sha1(dir, "file.txt", fileInfo)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	It("returns an error when the cache file is invalid", func() {
+		Expect(os.MkdirAll(filepath.Dir(cachePath), 0700)).To(Succeed())
+		Expect(ioutil.WriteFile(cachePath, []byte("not json"), 0600)).To(Succeed())
+
+		err := cache.Load("https://api.example.com")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Invalid resource cache"))
+
+		cache.SetMatched("some-sha", 8)
+		Expect(cache.IsMatched("some-sha", 8)).To(BeTrue())
+	})
+})
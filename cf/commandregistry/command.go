@@ -24,4 +24,5 @@ type CommandMetadata struct {
 	TotalArgs       int //Optional: number of required arguments to skip for flag verification
 	Hidden          bool
 	Examples        []string
+	Records         bool //Optional: command prints its results as records for --output json|yaml
 }
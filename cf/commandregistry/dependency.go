@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/cloudfoundry/cli/cf/actors"
@@ -28,6 +29,7 @@ import (
 type Dependency struct {
 	UI                 terminal.UI
 	Config             coreconfig.Repository
+	ProfileRepo        coreconfig.ProfileRepository
 	RepoLocator        api.RepositoryLocator
 	PluginConfig       pluginconfig.PluginConfiguration
 	ManifestRepo       manifest.ManifestRepository
@@ -44,6 +46,7 @@ type Dependency struct {
 	WordGenerator      generator.WordGenerator
 	AppZipper          appfiles.Zipper
 	AppFiles           appfiles.AppFiles
+	ResourceCache      *appfiles.ResourceCache
 	PushActor          actors.PushActor
 	ChecksumUtil       utils.Sha1Checksum
 	WildcardDependency interface{} //use for injecting fakes
@@ -81,6 +84,12 @@ func NewDependency(writer io.Writer, logger trace.Printer) Dependency {
 	}
 	deps.Config = coreconfig.NewRepositoryFromFilepath(configPath, errorHandler)
 
+	profilesDir, err := confighelpers.ProfilesDir()
+	if err != nil {
+		errorHandler(err)
+	}
+	deps.ProfileRepo = coreconfig.NewDiskProfileRepository(profilesDir)
+
 	deps.ManifestRepo = manifest.NewManifestDiskRepository()
 	deps.AppManifest = manifest.NewGenerator()
 	deps.PluginConfig = pluginconfig.NewPluginConfig(errorHandler)
@@ -88,8 +97,17 @@ func NewDependency(writer io.Writer, logger trace.Printer) Dependency {
 	terminal.UserAskedForColors = deps.Config.ColorEnabled()
 	terminal.InitColorSupport()
 
+	ccGateway := net.NewCloudControllerGateway(deps.Config, time.Now, deps.UI, logger)
+	if deps.Config.HTTPCacheTTL() > 0 {
+		cacheDir, err := confighelpers.HTTPCacheDir()
+		if err != nil {
+			errorHandler(err)
+		}
+		ccGateway.Cache = net.NewResponseCache(cacheDir, time.Duration(deps.Config.HTTPCacheTTL())*time.Second, time.Now)
+	}
+
 	deps.Gateways = map[string]net.Gateway{
-		"cloud-controller": net.NewCloudControllerGateway(deps.Config, time.Now, deps.UI, logger),
+		"cloud-controller": ccGateway,
 		"uaa":              net.NewUAAGateway(deps.Config, deps.UI, logger),
 		"routing-api":      net.NewRoutingAPIGateway(deps.Config, time.Now, deps.UI, logger),
 	}
@@ -132,9 +150,14 @@ func NewDependency(writer io.Writer, logger trace.Printer) Dependency {
 	deps.WordGenerator = generator.NewWordGenerator()
 
 	deps.AppZipper = appfiles.ApplicationZipper{}
-	deps.AppFiles = appfiles.ApplicationFiles{}
+	configDir, err := confighelpers.ConfigDir()
+	if err != nil {
+		errorHandler(err)
+	}
+	deps.ResourceCache = appfiles.NewResourceCache(filepath.Join(configDir, "resource_cache.json"))
+	deps.AppFiles = appfiles.ApplicationFiles{Cache: deps.ResourceCache}
 
-	deps.PushActor = actors.NewPushActor(deps.RepoLocator.GetApplicationBitsRepository(), deps.AppZipper, deps.AppFiles)
+	deps.PushActor = actors.NewPushActor(deps.RepoLocator.GetApplicationBitsRepository(), deps.AppZipper, deps.AppFiles, deps.ResourceCache)
 
 	deps.ChecksumUtil = utils.NewSha1Checksum("")
 
@@ -3,6 +3,7 @@ package commandregistry
 import (
 	"fmt"
 	"os"
+	"reflect"
 	"strings"
 	"unicode/utf8"
 
@@ -66,6 +67,26 @@ func (r *registry) FindCommand(name string) Command {
 	return nil
 }
 
+// CopyCommand returns a shallow copy of a registered command. Commands
+// keep their dependencies in their own fields, so callers which need to
+// set up a command with dependencies of their own, e.g. to run it
+// concurrently with other users of the command, should use a copy.
+func (r *registry) CopyCommand(name string) Command {
+	cmd := r.FindCommand(name)
+	if cmd == nil {
+		return nil
+	}
+
+	original := reflect.ValueOf(cmd)
+	if original.Kind() != reflect.Ptr {
+		return cmd
+	}
+
+	duplicate := reflect.New(original.Elem().Type())
+	duplicate.Elem().Set(original.Elem())
+	return duplicate.Interface().(Command)
+}
+
 func (r *registry) CommandExists(name string) bool {
 	if strings.TrimSpace(name) == "" {
 		return false
@@ -41,6 +41,35 @@ var _ = Describe("CommandRegistry", func() {
 		})
 	})
 
+	Describe("CopyCommand()", func() {
+		AfterEach(func() {
+			commandregistry.Commands.RemoveCommand("fake-command")
+		})
+
+		It("returns a copy of a command registered as a pointer", func() {
+			original := &FakeCommand1{Data: "some data"}
+			commandregistry.Register(original)
+
+			cmd := commandregistry.Commands.CopyCommand("fake-command")
+			Expect(cmd).To(Equal(original))
+			Expect(cmd).ToNot(BeIdenticalTo(original))
+
+			cmd.(*FakeCommand1).Data = "other data"
+			Expect(original.Data).To(Equal("some data"))
+		})
+
+		It("returns commands registered as a value", func() {
+			commandregistry.Register(FakeCommand1{Data: "some data"})
+
+			cmd := commandregistry.Commands.CopyCommand("fc1")
+			Expect(cmd).To(Equal(FakeCommand1{Data: "some data"}))
+		})
+
+		It("returns nil when the command has not been registered", func() {
+			Expect(commandregistry.Commands.CopyCommand("non-exist-cmd")).To(BeNil())
+		})
+	})
+
 	Describe("CommandExists()", func() {
 		Context("when the command has been registered", func() {
 			BeforeEach(func() {
@@ -2,7 +2,11 @@ package application
 
 import (
 	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
 	"strings"
+	"time"
 
 	. "github.com/cloudfoundry/cli/cf/i18n"
 	"github.com/cloudfoundry/cli/flags"
@@ -34,6 +38,10 @@ type ShowApp struct {
 	appReq           requirements.ApplicationRequirement
 	pluginAppModel   *plugin_models.GetAppModel
 	pluginCall       bool
+	watchInterval    time.Duration
+
+	// Interrupt stops --watch. When nil, --watch stops on os.Interrupt.
+	Interrupt chan os.Signal
 }
 
 func init() {
@@ -43,14 +51,21 @@ func init() {
 func (cmd *ShowApp) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["guid"] = &flags.BoolFlag{Name: "guid", Usage: T("Retrieve and display the given app's guid.  All other health and status output for the app is suppressed.")}
+	fs["watch"] = &flags.BoolFlag{Name: "watch", Usage: T("Keep refreshing instance state and usage until Ctrl-C is pressed")}
+	fs["watch-interval"] = &flags.StringFlag{Name: "watch-interval", Usage: T("Time between refreshes with --watch, such as 30s (Default: 5s)")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "app",
 		Description: T("Display health and status for app"),
 		Usage: []string{
-			T("CF_NAME app APP_NAME"),
+			T("CF_NAME app APP_NAME [--watch [--watch-interval INTERVAL]]"),
+		},
+		Examples: []string{
+			"CF_NAME app my-app --watch",
+			"CF_NAME app my-app --watch --watch-interval 30s",
 		},
-		Flags: fs,
+		Flags:   fs,
+		Records: true,
 	}
 }
 
@@ -59,6 +74,25 @@ func (cmd *ShowApp) Requirements(requirementsFactory requirements.Factory, fc fl
 		cmd.ui.Failed(T("Incorrect Usage. Requires an argument\n\n") + commandregistry.Commands.CommandUsage("app"))
 	}
 
+	if fc.IsSet("watch-interval") && !fc.Bool("watch") {
+		cmd.ui.Failed(T("Incorrect Usage. --watch-interval requires --watch\n\n") + commandregistry.Commands.CommandUsage("app"))
+	}
+
+	if fc.Bool("watch") {
+		if fc.Bool("guid") || cmd.ui.OutputFormat().IsStructured() {
+			cmd.ui.Failed(T("Incorrect Usage. --watch cannot be used with --guid or --output\n\n") + commandregistry.Commands.CommandUsage("app"))
+		}
+
+		cmd.watchInterval = DefaultWatchInterval
+		if fc.IsSet("watch-interval") {
+			interval, err := parseDuration(fc.String("watch-interval"))
+			if err != nil {
+				cmd.ui.Failed(T("Incorrect Usage. Invalid interval {{.Interval}}: {{.Err}}\n\n", map[string]interface{}{"Interval": fc.String("watch-interval"), "Err": err.Error()}) + commandregistry.Commands.CommandUsage("app"))
+			}
+			cmd.watchInterval = interval
+		}
+	}
+
 	cmd.appReq = requirementsFactory.NewApplicationRequirement(fc.Args()[0])
 
 	reqs := []requirements.Requirement{
@@ -87,6 +121,8 @@ func (cmd *ShowApp) Execute(c flags.FlagContext) {
 
 	if c.Bool("guid") {
 		cmd.ui.Say(app.GUID)
+	} else if c.Bool("watch") {
+		cmd.watch(app, cmd.watchInterval)
 	} else {
 		cmd.ShowApp(app, cmd.config.OrganizationFields().Name, cmd.config.SpaceFields().Name)
 	}
@@ -130,6 +166,22 @@ func (cmd *ShowApp) ShowApp(app models.Application, orgName, spaceName string) {
 		cmd.populatePluginModel(application, app.Stack, instances)
 	}
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		record := newAppRecord(application)
+		if app.Stack != nil {
+			record.Stack = app.Stack.Name
+		}
+		record.Buildpack = app.Buildpack
+		if record.Buildpack == "" {
+			record.Buildpack = app.DetectedBuildpack
+		}
+		if !appIsStopped {
+			record.InstanceDetails = newAppInstanceRecords(instances)
+		}
+		cmd.ui.PrintStructured(record)
+		return
+	}
+
 	cmd.ui.Ok()
 	cmd.ui.Say("\n%s %s", terminal.HeaderColor(T("requested state:")), uihelpers.ColoredAppState(application.ApplicationFields))
 	cmd.ui.Say("%s %s", terminal.HeaderColor(T("instances:")), uihelpers.ColoredAppInstances(application.ApplicationFields))
@@ -267,3 +319,220 @@ func (cmd *ShowApp) populatePluginModel(
 		cmd.pluginAppModel.Services = append(cmd.pluginAppModel.Services, serviceSummary)
 	}
 }
+
+const (
+	DefaultWatchInterval = 5 * time.Second
+
+	trendLength    = 10
+	maxWatchEvents = 5
+)
+
+var sparks = []rune("▁▂▃▄▅▆▇█")
+
+type instanceHistory struct {
+	state  models.InstanceState
+	cpu    []float64
+	memory []float64
+}
+
+type appWatch struct {
+	instances map[int]*instanceHistory
+	events    []string
+}
+
+// parseDuration accepts a number of seconds or a duration such as 1m30s.
+func parseDuration(value string) (time.Duration, error) {
+	var interval time.Duration
+
+	seconds, err := strconv.Atoi(value)
+	if err == nil {
+		interval = time.Duration(seconds) * time.Second
+	} else {
+		interval, err = time.ParseDuration(value)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if interval <= 0 {
+		return 0, errors.New(T("Duration must be greater than zero"))
+	}
+	return interval, nil
+}
+
+// watch redraws the instances of the app every interval until interrupted.
+func (cmd *ShowApp) watch(app models.Application, interval time.Duration) {
+	interrupt := cmd.Interrupt
+	if interrupt == nil {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt)
+		defer signal.Stop(signals)
+		interrupt = signals
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	state := &appWatch{instances: map[int]*instanceHistory{}}
+	for {
+		cmd.drawWatch(app, interval, state)
+
+		select {
+		case <-interrupt:
+			cmd.ui.Say("")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (cmd *ShowApp) drawWatch(app models.Application, interval time.Duration, state *appWatch) {
+	now := time.Now()
+
+	cmd.ui.Say(terminal.ClearScreen())
+	cmd.ui.Say(T("Watching app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}} every {{.Interval}}, press Ctrl-C to stop...",
+		map[string]interface{}{
+			"AppName":   terminal.EntityNameColor(app.Name),
+			"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"Username":  terminal.EntityNameColor(cmd.config.Username()),
+			"Interval":  interval}))
+	cmd.ui.Say("")
+
+	application, err := cmd.appSummaryRepo.GetSummary(app.GUID)
+	if err != nil && !isStoppedError(err) {
+		cmd.ui.Warn(err.Error())
+		return
+	}
+
+	cmd.ui.Say("%s %s", terminal.HeaderColor(T("updated:")), now.Format("15:04:05"))
+	cmd.ui.Say("%s %s", terminal.HeaderColor(T("requested state:")), uihelpers.ColoredAppState(application.ApplicationFields))
+	cmd.ui.Say("%s %s\n", terminal.HeaderColor(T("instances:")), uihelpers.ColoredAppInstances(application.ApplicationFields))
+
+	instances, err := cmd.appInstancesRepo.GetInstances(app.GUID)
+	if err != nil && !isStoppedError(err) {
+		cmd.ui.Warn(err.Error())
+		return
+	}
+
+	if err != nil || len(instances) == 0 {
+		cmd.ui.Say(T("There are no running instances of this app."))
+	} else {
+		cmd.drawInstances(instances, now, state)
+	}
+
+	if len(state.events) > 0 {
+		cmd.ui.Say("\n%s", terminal.HeaderColor(T("recent changes:")))
+		for _, event := range state.events {
+			cmd.ui.Say("  %s", event)
+		}
+	}
+}
+
+func (cmd *ShowApp) drawInstances(instances []models.AppInstanceFields, now time.Time, state *appWatch) {
+	table := cmd.ui.Table([]string{"", T("state"), T("since"), T("cpu"), T("memory"), T("disk"), T("cpu trend"), T("memory trend"), T("details")})
+
+	for index, instance := range instances {
+		history, seen := state.instances[index]
+		if !seen {
+			history = &instanceHistory{state: instance.State}
+			state.instances[index] = history
+		}
+
+		stateCell := uihelpers.ColoredInstanceState(instance)
+		if history.state != instance.State {
+			transition := fmt.Sprintf("%s → %s", history.state, instance.State)
+			stateCell = transitionColor(instance.State)(transition)
+			state.addEvent(fmt.Sprintf("%s #%d %s", now.Format("15:04:05"), index, stateCell))
+			history.state = instance.State
+		}
+
+		history.cpu = appendSample(history.cpu, instance.CPUUsage)
+		history.memory = appendSample(history.memory, float64(instance.MemUsage))
+
+		table.Add(
+			fmt.Sprintf("#%d", index),
+			stateCell,
+			instance.Since.Format("2006-01-02 03:04:05 PM"),
+			fmt.Sprintf("%.1f%%", instance.CPUUsage*100),
+			fmt.Sprintf(T("{{.MemUsage}} of {{.MemQuota}}",
+				map[string]interface{}{
+					"MemUsage": formatters.ByteSize(instance.MemUsage),
+					"MemQuota": formatters.ByteSize(instance.MemQuota)})),
+			fmt.Sprintf(T("{{.DiskUsage}} of {{.DiskQuota}}",
+				map[string]interface{}{
+					"DiskUsage": formatters.ByteSize(instance.DiskUsage),
+					"DiskQuota": formatters.ByteSize(instance.DiskQuota)})),
+			sparkline(history.cpu, maxSample(history.cpu)),
+			sparkline(history.memory, float64(instance.MemQuota)),
+			instance.Details,
+		)
+	}
+
+	for index := range state.instances {
+		if index >= len(instances) {
+			delete(state.instances, index)
+		}
+	}
+
+	table.Print()
+}
+
+func (state *appWatch) addEvent(event string) {
+	state.events = append(state.events, event)
+	if len(state.events) > maxWatchEvents {
+		state.events = state.events[len(state.events)-maxWatchEvents:]
+	}
+}
+
+func isStoppedError(err error) bool {
+	httpErr, ok := err.(errors.HTTPError)
+	return ok && (httpErr.ErrorCode() == errors.InstancesError || httpErr.ErrorCode() == errors.NotStaged)
+}
+
+func transitionColor(state models.InstanceState) func(string) string {
+	switch state {
+	case models.InstanceRunning:
+		return terminal.SuccessColor
+	case models.InstanceCrashed, models.InstanceFlapping, models.InstanceDown:
+		return terminal.CrashedColor
+	default:
+		return terminal.AdvisoryColor
+	}
+}
+
+func appendSample(samples []float64, sample float64) []float64 {
+	samples = append(samples, sample)
+	if len(samples) > trendLength {
+		samples = samples[len(samples)-trendLength:]
+	}
+	return samples
+}
+
+func maxSample(samples []float64) float64 {
+	max := 0.0
+	for _, sample := range samples {
+		if sample > max {
+			max = sample
+		}
+	}
+	return max
+}
+
+// sparkline draws samples as bars relative to max.
+func sparkline(samples []float64, max float64) string {
+	line := make([]rune, len(samples))
+	for i, sample := range samples {
+		level := 0
+		if max > 0 {
+			level = int(sample/max*float64(len(sparks)-1) + 0.5)
+		}
+		if level < 0 {
+			level = 0
+		} else if level >= len(sparks) {
+			level = len(sparks) - 1
+		}
+		line[i] = sparks[level]
+	}
+	return string(line)
+}
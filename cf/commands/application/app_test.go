@@ -2,6 +2,7 @@ package application_test
 
 import (
 	"encoding/json"
+	"os"
 	"time"
 
 	"github.com/cloudfoundry/cli/cf/api"
@@ -13,6 +14,7 @@ import (
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/requirements/requirementsfakes"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 	"github.com/cloudfoundry/cli/plugin/models"
 
@@ -202,6 +204,32 @@ var _ = Describe("App", func() {
 			))
 		})
 
+		It("prints the app as a record with --output json", func() {
+			ui.Format = terminal.JSONOutput
+
+			cmd.Execute(flagContext)
+
+			Expect(ui.StructuredOutputs).To(HaveLen(1))
+			encoded, err := json.Marshal(ui.StructuredOutputs[0])
+			Expect(err).NotTo(HaveOccurred())
+
+			var record map[string]interface{}
+			Expect(json.Unmarshal(encoded, &record)).To(Succeed())
+			Expect(record).To(HaveKeyWithValue("name", "fake-app-name"))
+			Expect(record).To(HaveKeyWithValue("state", "started"))
+			Expect(record).To(HaveKeyWithValue("stack", "fake-stack-name"))
+			Expect(record).To(HaveKeyWithValue("urls", []interface{}{"fake-route-host.fake-route-domain-name"}))
+			Expect(record["instance_details"]).To(Equal([]interface{}{
+				map[string]interface{}{
+					"index": 0.0, "state": "running", "since": "2015-11-19T01:01:17Z", "cpu_usage": 0.25,
+					"memory_usage_in_bytes": float64(24 * formatters.MEGABYTE), "memory_quota_in_bytes": float64(32 * formatters.MEGABYTE),
+					"disk_usage_in_bytes": float64(1 * formatters.GIGABYTE), "disk_quota_in_bytes": float64(2 * formatters.GIGABYTE),
+					"details": "fake-instance-details",
+				},
+			}))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"requested state"}))
+		})
+
 		Context("when getting the application summary fails because the app is stopped", func() {
 			BeforeEach(func() {
 				getAppSummaryModel.RunningInstances = 0
@@ -468,6 +496,123 @@ var _ = Describe("App", func() {
 			})
 		})
 	})
+
+	Describe("--watch", func() {
+		var instancesCalls int
+
+		BeforeEach(func() {
+			flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+
+			applicationRequirement.GetApplicationReturns(models.Application{
+				ApplicationFields: models.ApplicationFields{Name: "fake-app-name", GUID: "fake-app-guid"},
+			})
+			appSummaryRepo.GetSummaryReturns(models.Application{
+				ApplicationFields: models.ApplicationFields{Name: "fake-app-name", State: "started", InstanceCount: 1, RunningInstances: 1},
+			}, nil)
+
+			states := []models.InstanceState{models.InstanceStarting, models.InstanceRunning, models.InstanceCrashed}
+			instancesCalls = 0
+			interrupt := make(chan os.Signal, 1)
+			cmd.(*application.ShowApp).Interrupt = interrupt
+			appInstancesRepo.GetInstancesStub = func(string) ([]models.AppInstanceFields, error) {
+				state := states[instancesCalls]
+				instancesCalls++
+				if instancesCalls == len(states) {
+					interrupt <- os.Interrupt
+				}
+				return []models.AppInstanceFields{{
+					State:    state,
+					CPUUsage: 0.1 * float64(instancesCalls),
+					MemUsage: int64(8 * instancesCalls * formatters.MEGABYTE),
+					MemQuota: int64(32 * formatters.MEGABYTE),
+				}}, nil
+			}
+		})
+
+		Describe("Requirements", func() {
+			It("accepts an interval", func() {
+				Expect(flagContext.Parse("app-name", "--watch", "--watch-interval", "10ms")).To(Succeed())
+				cmd.Requirements(factory, flagContext)
+				Expect(factory.NewApplicationRequirementArgsForCall(0)).To(Equal("app-name"))
+			})
+
+			It("fails with usage when given a second argument", func() {
+				Expect(flagContext.Parse("app-name", "--watch", "10ms")).To(Succeed())
+				Expect(func() { cmd.Requirements(factory, flagContext) }).To(Panic())
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Incorrect Usage. Requires an argument"},
+				))
+			})
+
+			It("fails with usage when the interval is given without --watch", func() {
+				Expect(flagContext.Parse("app-name", "--watch-interval", "10ms")).To(Succeed())
+				Expect(func() { cmd.Requirements(factory, flagContext) }).To(Panic())
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Incorrect Usage. --watch-interval requires --watch"},
+				))
+			})
+
+			It("fails with usage when the interval is invalid", func() {
+				Expect(flagContext.Parse("app-name", "--watch", "--watch-interval", "soon")).To(Succeed())
+				Expect(func() { cmd.Requirements(factory, flagContext) }).To(Panic())
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Incorrect Usage. Invalid interval soon"},
+				))
+			})
+
+			It("fails with usage when combined with --guid", func() {
+				Expect(flagContext.Parse("app-name", "--watch", "--guid")).To(Succeed())
+				Expect(func() { cmd.Requirements(factory, flagContext) }).To(Panic())
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Incorrect Usage. --watch cannot be used with --guid or --output"},
+				))
+			})
+		})
+
+		It("refreshes the instances until interrupted and highlights state changes", func() {
+			Expect(flagContext.Parse("app-name", "--watch", "--watch-interval", "10ms")).To(Succeed())
+			cmd.Requirements(factory, flagContext)
+			cmd.Execute(flagContext)
+
+			Expect(instancesCalls).To(Equal(3))
+			Expect(appSummaryRepo.GetSummaryCallCount()).To(Equal(3))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Watching app fake-app-name", "every 10ms, press Ctrl-C to stop"},
+				[]string{"#0", "starting"},
+				[]string{"#0", "starting → running"},
+				[]string{"#0", "running → crashed", "24M of 32M"},
+				[]string{"recent changes:"},
+				[]string{"#0 starting → running"},
+				[]string{"#0 running → crashed"},
+			))
+		})
+
+		It("shows CPU and memory trends", func() {
+			Expect(flagContext.Parse("app-name", "--watch", "--watch-interval", "10ms")).To(Succeed())
+			cmd.Requirements(factory, flagContext)
+			cmd.Execute(flagContext)
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"cpu trend", "memory trend"},
+				[]string{"running → crashed", "▃▆█", "▃▅▆"},
+			))
+		})
+
+		It("keeps watching when the app is stopped", func() {
+			appInstancesRepo.GetInstancesStub = nil
+			appInstancesRepo.GetInstancesReturns(nil, errors.NewHTTPError(400, errors.InstancesError, "app stopped"))
+			interrupt := cmd.(*application.ShowApp).Interrupt
+			interrupt <- os.Interrupt
+
+			Expect(flagContext.Parse("app-name", "--watch")).To(Succeed())
+			cmd.Requirements(factory, flagContext)
+			cmd.Execute(flagContext)
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"There are no running instances of this app."},
+			))
+		})
+	})
 })
 
 var getApplicationJSON string = `{
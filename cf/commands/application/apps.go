@@ -3,6 +3,7 @@ package application
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	. "github.com/cloudfoundry/cli/cf/i18n"
@@ -39,6 +40,7 @@ func (cmd *ListApps) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			"CF_NAME apps",
 		},
+		Records: true,
 	}
 }
 
@@ -85,6 +87,15 @@ func (cmd *ListApps) Execute(c flags.FlagContext) {
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
+	if cmd.pluginCall {
+		cmd.populatePluginModel(apps)
+	}
+
+	if cmd.ui.OutputFormat().IsStructured() {
+		cmd.ui.PrintStructured(newAppRecords(apps))
+		return
+	}
+
 	if len(apps) == 0 {
 		cmd.ui.Say(T("No apps found"))
 		return
@@ -125,10 +136,6 @@ func (cmd *ListApps) Execute(c flags.FlagContext) {
 	}
 
 	table.Print()
-
-	if cmd.pluginCall {
-		cmd.populatePluginModel(apps)
-	}
 }
 
 func (cmd *ListApps) populatePluginModel(apps []models.Application) {
@@ -159,3 +166,77 @@ func (cmd *ListApps) populatePluginModel(apps []models.Application) {
 
 	}
 }
+
+// appRecord is the machine readable form of an application printed by
+// the app and apps commands when a structured output format is chosen.
+type appRecord struct {
+	Name             string              `json:"name" yaml:"name"`
+	GUID             string              `json:"guid" yaml:"guid"`
+	State            string              `json:"state" yaml:"state"`
+	Instances        int                 `json:"instances" yaml:"instances"`
+	RunningInstances int                 `json:"running_instances" yaml:"running_instances"`
+	Memory           int64               `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskQuota        int64               `json:"disk_quota_in_mb" yaml:"disk_quota_in_mb"`
+	URLs             []string            `json:"urls" yaml:"urls"`
+	Stack            string              `json:"stack,omitempty" yaml:"stack,omitempty"`
+	Buildpack        string              `json:"buildpack,omitempty" yaml:"buildpack,omitempty"`
+	LastUploaded     *time.Time          `json:"last_uploaded,omitempty" yaml:"last_uploaded,omitempty"`
+	InstanceDetails  []appInstanceRecord `json:"instance_details,omitempty" yaml:"instance_details,omitempty"`
+}
+
+type appInstanceRecord struct {
+	Index     int       `json:"index" yaml:"index"`
+	State     string    `json:"state" yaml:"state"`
+	Since     time.Time `json:"since" yaml:"since"`
+	CPUUsage  float64   `json:"cpu_usage" yaml:"cpu_usage"`
+	MemUsage  int64     `json:"memory_usage_in_bytes" yaml:"memory_usage_in_bytes"`
+	MemQuota  int64     `json:"memory_quota_in_bytes" yaml:"memory_quota_in_bytes"`
+	DiskUsage int64     `json:"disk_usage_in_bytes" yaml:"disk_usage_in_bytes"`
+	DiskQuota int64     `json:"disk_quota_in_bytes" yaml:"disk_quota_in_bytes"`
+	Details   string    `json:"details,omitempty" yaml:"details,omitempty"`
+}
+
+func newAppRecord(app models.Application) appRecord {
+	urls := []string{}
+	for _, route := range app.Routes {
+		urls = append(urls, route.URL())
+	}
+
+	return appRecord{
+		Name:             app.Name,
+		GUID:             app.GUID,
+		State:            app.State,
+		Instances:        app.InstanceCount,
+		RunningInstances: app.RunningInstances,
+		Memory:           app.Memory,
+		DiskQuota:        app.DiskQuota,
+		URLs:             urls,
+		LastUploaded:     app.PackageUpdatedAt,
+	}
+}
+
+func newAppRecords(apps []models.Application) []appRecord {
+	records := make([]appRecord, 0, len(apps))
+	for _, app := range apps {
+		records = append(records, newAppRecord(app))
+	}
+	return records
+}
+
+func newAppInstanceRecords(instances []models.AppInstanceFields) []appInstanceRecord {
+	records := make([]appInstanceRecord, 0, len(instances))
+	for index, instance := range instances {
+		records = append(records, appInstanceRecord{
+			Index:     index,
+			State:     string(instance.State),
+			Since:     instance.Since,
+			CPUUsage:  instance.CPUUsage,
+			MemUsage:  instance.MemUsage,
+			MemQuota:  instance.MemQuota,
+			DiskUsage: instance.DiskUsage,
+			DiskQuota: instance.DiskQuota,
+			Details:   instance.Details,
+		})
+	}
+	return records
+}
@@ -1,10 +1,13 @@
 package application_test
 
 import (
+	"encoding/json"
+
 	"github.com/cloudfoundry/cli/cf/api/apifakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/cf/trace/tracefakes"
 	"github.com/cloudfoundry/cli/plugin/models"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
@@ -222,6 +225,38 @@ var _ = Describe("list-apps command", func() {
 			})
 		})
 
+		Context("when a structured output format is selected", func() {
+			BeforeEach(func() {
+				ui.Format = terminal.JSONOutput
+			})
+
+			It("prints the apps as records instead of a table", func() {
+				runCommand()
+
+				Expect(ui.StructuredOutputs).To(HaveLen(1))
+				encoded, err := json.Marshal(ui.StructuredOutputs[0])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(encoded).To(MatchJSON(`[
+					{"name": "Application-1", "guid": "Application-1-guid", "state": "started", "instances": 1, "running_instances": 1,
+					 "memory_in_mb": 512, "disk_quota_in_mb": 1024, "urls": ["app1.cfapps.io", "app1.example.com"]},
+					{"name": "Application-2", "guid": "Application-2-guid", "state": "started", "instances": 2, "running_instances": 1,
+					 "memory_in_mb": 256, "disk_quota_in_mb": 1024, "urls": ["app2.cfapps.io"]}
+				]`))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"requested state"}))
+			})
+
+			It("prints an empty list when there are no apps", func() {
+				appSummaryRepo.GetSummariesInCurrentSpaceApps = []models.Application{}
+
+				runCommand()
+
+				Expect(ui.StructuredOutputs).To(HaveLen(1))
+				encoded, err := json.Marshal(ui.StructuredOutputs[0])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(encoded).To(MatchJSON(`[]`))
+			})
+		})
+
 		Context("when there are no apps", func() {
 			It("tells the user that there are no apps", func() {
 				appSummaryRepo.GetSummariesInCurrentSpaceApps = []models.Application{}
@@ -1,20 +1,50 @@
 package application
 
 import (
+	"time"
+
 	"github.com/cloudfoundry/cli/cf/api/appevents"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 )
 
+const DefaultEventsLimit = 50
+
+// eventTimeFormats are the accepted absolute formats of --since and --until.
+// Times without a zone are local.
+var eventTimeFormats = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// eventRecord is the machine readable form of an event printed by the
+// events command.
+type eventRecord struct {
+	GUID        string    `json:"guid" yaml:"guid"`
+	Time        time.Time `json:"time" yaml:"time"`
+	Event       string    `json:"event" yaml:"event"`
+	TargetType  string    `json:"target_type" yaml:"target_type"`
+	TargetName  string    `json:"target_name" yaml:"target_name"`
+	Actor       string    `json:"actor" yaml:"actor"`
+	ActorName   string    `json:"actor_name" yaml:"actor_name"`
+	Description string    `json:"description" yaml:"description"`
+}
+
 type Events struct {
 	ui         terminal.UI
 	config     coreconfig.Reader
 	appReq     requirements.ApplicationRequirement
 	eventsRepo appevents.AppEventsRepository
+
+	filter models.EventFilter
+	limit  int64
 }
 
 func init() {
@@ -22,27 +52,95 @@ func init() {
 }
 
 func (cmd *Events) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["since"] = &flags.StringFlag{Name: "since", Usage: T("Only show events at or after this time, as 2006-01-02, 2006-01-02T15:04:05, RFC 3339, or a duration ago such as 2h")}
+	fs["until"] = &flags.StringFlag{Name: "until", Usage: T("Only show events at or before this time, in the same formats as --since")}
+	fs["type"] = &flags.StringSliceFlag{Name: "type", Usage: T("Only show events of this type, such as audit.app.update, flag can be specified multiple times")}
+	fs["actor"] = &flags.StringFlag{Name: "actor", Usage: T("Only show events caused by this user name or GUID")}
+	fs["limit"] = &flags.IntFlag{Name: "limit", Usage: T("Maximum number of events to show (Default: 50)")}
+	fs["all"] = &flags.BoolFlag{Name: "all", Usage: T("Show all matching events")}
+	fs["space"] = &flags.BoolFlag{Name: "space", Usage: T("Show the events of everything in the targeted space instead of an app")}
+	fs["org"] = &flags.BoolFlag{Name: "org", Usage: T("Show the events of everything in the targeted org instead of an app")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "events",
 		Description: T("Show recent app events"),
 		Usage: []string{
-			"CF_NAME events ",
-			T("APP_NAME"),
+			T("CF_NAME events APP_NAME [--since TIME] [--until TIME] [--type TYPE]... [--actor ACTOR] [--limit N | --all]\n   CF_NAME events (--space | --org) [--since TIME] [--until TIME] [--type TYPE]... [--actor ACTOR] [--limit N | --all]"),
+		},
+		Examples: []string{
+			"CF_NAME events my-app",
+			"CF_NAME events my-app --since 24h --type audit.app.update",
+			"CF_NAME events --space --actor admin --since 2016-06-01 --until 2016-07-01 --all",
 		},
+		Flags:   fs,
+		Records: true,
 	}
 }
 
 func (cmd *Events) Requirements(requirementsFactory requirements.Factory, c flags.FlagContext) []requirements.Requirement {
-	if len(c.Args()) != 1 {
+	wide := c.Bool("space") || c.Bool("org")
+
+	if c.Bool("space") && c.Bool("org") {
+		cmd.ui.Failed(T("Incorrect Usage. --space and --org cannot be used together\n\n") + commandregistry.Commands.CommandUsage("events"))
+	}
+	if wide && len(c.Args()) != 0 {
+		cmd.ui.Failed(T("Incorrect Usage. No argument required with --space or --org\n\n") + commandregistry.Commands.CommandUsage("events"))
+	}
+	if !wide && len(c.Args()) != 1 {
 		cmd.ui.Failed(T("Incorrect Usage. Requires an argument\n\n") + commandregistry.Commands.CommandUsage("events"))
 	}
 
-	cmd.appReq = requirementsFactory.NewApplicationRequirement(c.Args()[0])
+	cmd.limit = DefaultEventsLimit
+	if c.IsSet("limit") {
+		if c.Bool("all") || c.Int("limit") < 1 {
+			cmd.ui.Failed(T("Incorrect Usage. --limit must be a positive number and cannot be used with --all\n\n") + commandregistry.Commands.CommandUsage("events"))
+		}
+		cmd.limit = int64(c.Int("limit"))
+	}
+	if c.Bool("all") {
+		cmd.limit = 0
+	}
+
+	cmd.filter = models.EventFilter{
+		Types: c.StringSlice("type"),
+		Actor: c.String("actor"),
+	}
+
+	now := time.Now()
+	for _, flagName := range []string{"since", "until"} {
+		if !c.IsSet(flagName) {
+			continue
+		}
+
+		value, ok := parseEventTime(c.String(flagName), now)
+		if !ok {
+			cmd.ui.Failed(T("Incorrect Usage. Invalid time {{.Time}} for --{{.Flag}}\n\n", map[string]interface{}{"Time": c.String(flagName), "Flag": flagName}) + commandregistry.Commands.CommandUsage("events"))
+		}
+
+		if flagName == "since" {
+			cmd.filter.Since = value
+		} else {
+			cmd.filter.Until = value
+		}
+	}
+
+	if !cmd.filter.Since.IsZero() && !cmd.filter.Until.IsZero() && cmd.filter.Until.Before(cmd.filter.Since) {
+		cmd.ui.Failed(T("Incorrect Usage. --until must not be before --since\n\n") + commandregistry.Commands.CommandUsage("events"))
+	}
 
 	reqs := []requirements.Requirement{
 		requirementsFactory.NewLoginRequirement(),
-		requirementsFactory.NewTargetedSpaceRequirement(),
-		cmd.appReq,
+	}
+
+	switch {
+	case c.Bool("org"):
+		reqs = append(reqs, requirementsFactory.NewTargetedOrgRequirement())
+	case c.Bool("space"):
+		reqs = append(reqs, requirementsFactory.NewTargetedSpaceRequirement())
+	default:
+		cmd.appReq = requirementsFactory.NewApplicationRequirement(c.Args()[0])
+		reqs = append(reqs, requirementsFactory.NewTargetedSpaceRequirement(), cmd.appReq)
 	}
 
 	return reqs
@@ -56,43 +154,132 @@ func (cmd *Events) SetDependency(deps commandregistry.Dependency, pluginCall boo
 }
 
 func (cmd *Events) Execute(c flags.FlagContext) {
-	app := cmd.appReq.GetApplication()
+	var noEventsMessage string
+	wide := true
+
+	switch {
+	case c.Bool("org"):
+		cmd.filter.OrganizationGUID = cmd.config.OrganizationFields().GUID
 
-	cmd.ui.Say(T("Getting events for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...\n",
-		map[string]interface{}{
-			"AppName":   terminal.EntityNameColor(app.Name),
-			"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
-			"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
-			"Username":  terminal.EntityNameColor(cmd.config.Username())}))
+		cmd.ui.Say(T("Getting events for org {{.OrgName}} as {{.Username}}...\n",
+			map[string]interface{}{
+				"OrgName":  terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+				"Username": terminal.EntityNameColor(cmd.config.Username())}))
+		noEventsMessage = T("No events for org {{.OrgName}}",
+			map[string]interface{}{"OrgName": terminal.EntityNameColor(cmd.config.OrganizationFields().Name)})
+	case c.Bool("space"):
+		cmd.filter.SpaceGUID = cmd.config.SpaceFields().GUID
 
-	table := cmd.ui.Table([]string{T("time"), T("event"), T("actor"), T("description")})
+		cmd.ui.Say(T("Getting events for space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...\n",
+			map[string]interface{}{
+				"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+				"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+				"Username":  terminal.EntityNameColor(cmd.config.Username())}))
+		noEventsMessage = T("No events for space {{.SpaceName}}",
+			map[string]interface{}{"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name)})
+	default:
+		wide = false
+		app := cmd.appReq.GetApplication()
+		cmd.filter.ActeeGUID = app.GUID
+
+		cmd.ui.Say(T("Getting events for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...\n",
+			map[string]interface{}{
+				"AppName":   terminal.EntityNameColor(app.Name),
+				"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+				"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+				"Username":  terminal.EntityNameColor(cmd.config.Username())}))
+		noEventsMessage = T("No events for app {{.AppName}}",
+			map[string]interface{}{"AppName": terminal.EntityNameColor(app.Name)})
+	}
 
-	events, apiErr := cmd.eventsRepo.RecentEvents(app.GUID, 50)
+	headers := []string{T("time"), T("event"), T("actor"), T("description")}
+	if wide {
+		headers = []string{T("time"), T("event"), T("target"), T("actor"), T("description")}
+	}
+	table := cmd.ui.Table(headers)
+
+	// One more event than shown is fetched to know whether there are more.
+	fetchLimit := cmd.limit
+	if fetchLimit > 0 {
+		fetchLimit++
+	}
+
+	events, apiErr := cmd.eventsRepo.ListEvents(cmd.filter, fetchLimit)
 	if apiErr != nil {
 		cmd.ui.Failed(T("Failed fetching events.\n{{.APIErr}}",
 			map[string]interface{}{"APIErr": apiErr.Error()}))
 		return
 	}
 
+	truncated := cmd.limit > 0 && int64(len(events)) > cmd.limit
+	if truncated {
+		events = events[:cmd.limit]
+	}
+
+	if cmd.ui.OutputFormat().IsStructured() {
+		records := make([]eventRecord, 0, len(events))
+		for _, event := range events {
+			records = append(records, eventRecord{
+				GUID:        event.GUID,
+				Time:        event.Timestamp,
+				Event:       event.Name,
+				TargetType:  event.ActeeType,
+				TargetName:  event.ActeeName,
+				Actor:       event.Actor,
+				ActorName:   event.ActorName,
+				Description: event.Description,
+			})
+		}
+		cmd.ui.PrintStructured(records)
+		return
+	}
+
 	for _, event := range events {
 		actor := event.ActorName
 		if actor == "" {
 			actor = event.Actor
 		}
 
-		table.Add(
+		row := []string{
 			event.Timestamp.Local().Format("2006-01-02T15:04:05.00-0700"),
 			event.Name,
-			actor,
-			event.Description,
-		)
+		}
+		if wide {
+			row = append(row, event.ActeeType+" "+event.ActeeName)
+		}
+		row = append(row, actor, event.Description)
+
+		table.Add(row...)
 	}
 
 	table.Print()
 
 	if len(events) == 0 {
-		cmd.ui.Say(T("No events for app {{.AppName}}",
-			map[string]interface{}{"AppName": terminal.EntityNameColor(app.Name)}))
+		cmd.ui.Say(noEventsMessage)
 		return
 	}
+
+	if truncated {
+		cmd.ui.Say("")
+		cmd.ui.Say(T("Showing the newest {{.Limit}} events. Use --limit or --all to see more.",
+			map[string]interface{}{"Limit": cmd.limit}))
+	}
+}
+
+// parseEventTime parses an absolute time in one of eventTimeFormats, or a
+// duration before now.
+func parseEventTime(value string, now time.Time) (time.Time, bool) {
+	for _, format := range eventTimeFormats {
+		t, err := time.ParseInLocation(format, value, time.Local)
+		if err == nil {
+			return t, true
+		}
+	}
+
+	ago, err := time.ParseDuration(value)
+	if err != nil || ago < 0 {
+		return time.Time{}, false
+	}
+
+	return now.Add(-ago), true
 }
@@ -1,6 +1,7 @@
 package application_test
 
 import (
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/cloudfoundry/cli/cf/commands/application"
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 
 	"github.com/cloudfoundry/cli/cf/api/appevents/appeventsfakes"
@@ -108,6 +110,86 @@ var _ = Describe("events command", func() {
 				Expect(actualRequirements).To(ContainElement(applicationRequirement))
 			})
 		})
+		Context("when given --space and --org", func() {
+			It("fails", func() {
+				err := flagContext.Parse("--space", "--org")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(func() { cmd.Requirements(reqFactory, flagContext) }).To(Panic())
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Incorrect Usage", "--space and --org cannot be used together"},
+				))
+			})
+		})
+
+		Context("when given an app name with --space", func() {
+			It("fails", func() {
+				err := flagContext.Parse("my-app", "--space")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(func() { cmd.Requirements(reqFactory, flagContext) }).To(Panic())
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Incorrect Usage", "No argument required"},
+				))
+			})
+		})
+
+		Context("when given --limit with --all", func() {
+			It("fails", func() {
+				err := flagContext.Parse("my-app", "--limit", "10", "--all")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(func() { cmd.Requirements(reqFactory, flagContext) }).To(Panic())
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Incorrect Usage", "--limit must be a positive number"},
+				))
+			})
+		})
+
+		Context("when given an invalid time", func() {
+			It("fails", func() {
+				err := flagContext.Parse("my-app", "--since", "yesterday")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(func() { cmd.Requirements(reqFactory, flagContext) }).To(Panic())
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Incorrect Usage", "Invalid time yesterday for --since"},
+				))
+			})
+		})
+
+		Context("when --until is before --since", func() {
+			It("fails", func() {
+				err := flagContext.Parse("my-app", "--since", "2016-02-01", "--until", "2016-01-01")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(func() { cmd.Requirements(reqFactory, flagContext) }).To(Panic())
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Incorrect Usage", "--until must not be before --since"},
+				))
+			})
+		})
+
+		Context("when given --org", func() {
+			var (
+				actualRequirements []requirements.Requirement
+				orgRequirement     *requirementsfakes.FakeTargetedOrgRequirement
+			)
+
+			BeforeEach(func() {
+				orgRequirement = new(requirementsfakes.FakeTargetedOrgRequirement)
+				reqFactory.NewTargetedOrgRequirementReturns(orgRequirement)
+
+				err := flagContext.Parse("--org")
+				Expect(err).NotTo(HaveOccurred())
+				actualRequirements = cmd.Requirements(reqFactory, flagContext)
+			})
+
+			It("returns a LoginRequirement and a TargetedOrgRequirement", func() {
+				Expect(actualRequirements).To(ContainElement(loginRequirement))
+				Expect(actualRequirements).To(ContainElement(orgRequirement))
+			})
+
+			It("does not return an ApplicationRequirement", func() {
+				Expect(reqFactory.NewApplicationRequirementCallCount()).To(BeZero())
+				Expect(reqFactory.NewTargetedSpaceRequirementCallCount()).To(BeZero())
+			})
+		})
 	})
 
 	Describe("Execute", func() {
@@ -125,7 +207,7 @@ var _ = Describe("events command", func() {
 
 		Context("when no events exist", func() {
 			BeforeEach(func() {
-				eventsRepo.RecentEventsReturns([]models.EventFields{}, nil)
+				eventsRepo.ListEventsReturns([]models.EventFields{}, nil)
 
 				cmd.SetDependency(deps, false)
 				cmd.Requirements(reqFactory, flagContext)
@@ -155,7 +237,7 @@ var _ = Describe("events command", func() {
 				timestamp, err = time.Parse(TIMESTAMP_FORMAT, "2000-01-01T00:01:11.00-0000")
 				Expect(err).NotTo(HaveOccurred())
 
-				eventsRepo.RecentEventsReturns([]models.EventFields{
+				eventsRepo.ListEventsReturns([]models.EventFields{
 					{
 						GUID:        "event-guid-1",
 						Name:        "app crashed",
@@ -172,17 +254,19 @@ var _ = Describe("events command", func() {
 						Actor:       "marcel-marceau",
 					},
 				}, nil)
+			})
 
+			JustBeforeEach(func() {
 				cmd.SetDependency(deps, false)
 				cmd.Requirements(reqFactory, flagContext)
 				cmd.Execute(flagContext)
 			})
 
 			It("lists events given an app name", func() {
-				Expect(eventsRepo.RecentEventsCallCount()).To(Equal(1))
-				appGUID, limit := eventsRepo.RecentEventsArgsForCall(0)
-				Expect(limit).To(Equal(int64(50)))
-				Expect(appGUID).To(Equal("my-app-guid"))
+				Expect(eventsRepo.ListEventsCallCount()).To(Equal(1))
+				filter, limit := eventsRepo.ListEventsArgsForCall(0)
+				Expect(limit).To(Equal(int64(51)))
+				Expect(filter).To(Equal(models.EventFilter{ActeeGUID: "my-app-guid", Types: []string{}}))
 
 				Expect(ui.Outputs).To(ContainSubstrings(
 					[]string{"Getting events for app", "my-app", "my-org", "my-space", "my-user"},
@@ -191,11 +275,30 @@ var _ = Describe("events command", func() {
 					[]string{timestamp.Local().Format(TIMESTAMP_FORMAT), "app crashed", "marcel-marceau", "app instance was stopped", "77"},
 				))
 			})
+
+			Context("with --output json", func() {
+				BeforeEach(func() {
+					ui.Format = terminal.JSONOutput
+				})
+
+				It("prints the events as records", func() {
+					Expect(ui.StructuredOutputs).To(HaveLen(1))
+					encoded, err := json.Marshal(ui.StructuredOutputs[0])
+					Expect(err).NotTo(HaveOccurred())
+					Expect(encoded).To(MatchJSON(`[
+						{"guid": "event-guid-1", "time": "1999-12-31T23:59:11Z", "event": "app crashed", "target_type": "", "target_name": "",
+						 "actor": "george-clooney", "actor_name": "George Clooney", "description": "reason: app instance exited, exit_status: 78"},
+						{"guid": "event-guid-2", "time": "2000-01-01T00:01:11Z", "event": "app crashed", "target_type": "", "target_name": "",
+						 "actor": "marcel-marceau", "actor_name": "", "description": "reason: app instance was stopped, exit_status: 77"}
+					]`))
+					Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"time", "event", "actor", "description"}))
+				})
+			})
 		})
 
 		Context("when the request fails", func() {
 			BeforeEach(func() {
-				eventsRepo.RecentEventsReturns([]models.EventFields{}, errors.New("welp"))
+				eventsRepo.ListEventsReturns([]models.EventFields{}, errors.New("welp"))
 
 				cmd.SetDependency(deps, false)
 				cmd.Requirements(reqFactory, flagContext)
@@ -210,5 +313,153 @@ var _ = Describe("events command", func() {
 				))
 			})
 		})
+
+		Context("when given filters", func() {
+			BeforeEach(func() {
+				eventsRepo.ListEventsReturns([]models.EventFields{}, nil)
+
+				flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+				err := flagContext.Parse("my-app", "--since", "2016-01-02T03:04:05Z", "--until", "2016-01-03",
+					"--type", "audit.app.update", "--type", "audit.app.start", "--actor", "admin", "--limit", "5")
+				Expect(err).NotTo(HaveOccurred())
+
+				cmd.SetDependency(deps, false)
+				cmd.Requirements(reqFactory, flagContext)
+				cmd.Execute(flagContext)
+			})
+
+			It("lists the events matching the filters", func() {
+				Expect(eventsRepo.ListEventsCallCount()).To(Equal(1))
+				filter, limit := eventsRepo.ListEventsArgsForCall(0)
+				Expect(limit).To(Equal(int64(6)))
+				Expect(filter.ActeeGUID).To(Equal("my-app-guid"))
+				Expect(filter.Types).To(Equal([]string{"audit.app.update", "audit.app.start"}))
+				Expect(filter.Actor).To(Equal("admin"))
+				Expect(filter.Since).To(Equal(time.Date(2016, 1, 2, 3, 4, 5, 0, time.UTC)))
+				Expect(filter.Until).To(Equal(time.Date(2016, 1, 3, 0, 0, 0, 0, time.Local)))
+			})
+		})
+
+		Context("when --since is a duration", func() {
+			BeforeEach(func() {
+				eventsRepo.ListEventsReturns([]models.EventFields{}, nil)
+
+				flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+				err := flagContext.Parse("my-app", "--since", "2h")
+				Expect(err).NotTo(HaveOccurred())
+
+				cmd.SetDependency(deps, false)
+				cmd.Requirements(reqFactory, flagContext)
+				cmd.Execute(flagContext)
+			})
+
+			It("lists the events since that long ago", func() {
+				filter, _ := eventsRepo.ListEventsArgsForCall(0)
+				Expect(filter.Since).To(BeTemporally("~", time.Now().Add(-2*time.Hour), time.Minute))
+			})
+		})
+
+		Context("when given --all", func() {
+			BeforeEach(func() {
+				eventsRepo.ListEventsReturns([]models.EventFields{}, nil)
+
+				flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+				err := flagContext.Parse("my-app", "--all")
+				Expect(err).NotTo(HaveOccurred())
+
+				cmd.SetDependency(deps, false)
+				cmd.Requirements(reqFactory, flagContext)
+				cmd.Execute(flagContext)
+			})
+
+			It("lists the events without a limit", func() {
+				_, limit := eventsRepo.ListEventsArgsForCall(0)
+				Expect(limit).To(BeZero())
+			})
+		})
+
+		Context("when there are more events than the limit", func() {
+			BeforeEach(func() {
+				eventsRepo.ListEventsReturns([]models.EventFields{
+					{Name: "audit.app.update", Description: "newest"},
+					{Name: "audit.app.update", Description: "older"},
+					{Name: "audit.app.update", Description: "oldest"},
+				}, nil)
+
+				flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+				err := flagContext.Parse("my-app", "--limit", "2")
+				Expect(err).NotTo(HaveOccurred())
+
+				cmd.SetDependency(deps, false)
+				cmd.Requirements(reqFactory, flagContext)
+				cmd.Execute(flagContext)
+			})
+
+			It("shows the limit and tells the user there are more", func() {
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"newest"},
+					[]string{"older"},
+					[]string{"Showing the newest 2 events", "--all"},
+				))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"oldest"}))
+			})
+		})
+	})
+
+	Describe("Execute for a space", func() {
+		BeforeEach(func() {
+			config.SpaceFieldsReturns(models.SpaceFields{Name: "my-space", GUID: "my-space-guid"})
+			eventsRepo.ListEventsReturns([]models.EventFields{
+				{
+					Name:        "audit.service_instance.create",
+					ActeeType:   "service_instance",
+					ActeeName:   "my-db",
+					ActorName:   "admin",
+					Description: "created",
+				},
+			}, nil)
+
+			err := flagContext.Parse("--space")
+			Expect(err).NotTo(HaveOccurred())
+
+			cmd.SetDependency(deps, false)
+			cmd.Requirements(reqFactory, flagContext)
+			cmd.Execute(flagContext)
+		})
+
+		It("lists the events of the targeted space with their targets", func() {
+			filter, _ := eventsRepo.ListEventsArgsForCall(0)
+			Expect(filter).To(Equal(models.EventFilter{SpaceGUID: "my-space-guid", Types: []string{}}))
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Getting events for space", "my-space", "my-org", "my-user"},
+				[]string{"time", "event", "target", "actor", "description"},
+				[]string{"audit.service_instance.create", "service_instance my-db", "admin", "created"},
+			))
+		})
+	})
+
+	Describe("Execute for an org", func() {
+		BeforeEach(func() {
+			config.OrganizationFieldsReturns(models.OrganizationFields{Name: "my-org", GUID: "my-org-guid"})
+			eventsRepo.ListEventsReturns([]models.EventFields{}, nil)
+
+			err := flagContext.Parse("--org")
+			Expect(err).NotTo(HaveOccurred())
+
+			cmd.SetDependency(deps, false)
+			cmd.Requirements(reqFactory, flagContext)
+			cmd.Execute(flagContext)
+		})
+
+		It("lists the events of the targeted org", func() {
+			filter, _ := eventsRepo.ListEventsArgsForCall(0)
+			Expect(filter).To(Equal(models.EventFilter{OrganizationGUID: "my-org-guid", Types: []string{}}))
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Getting events for org", "my-org", "my-user"},
+				[]string{"No events for org", "my-org"},
+			))
+		})
 	})
 })
@@ -1,6 +1,8 @@
 package application
 
 import (
+	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/cloudfoundry/cli/cf/api/logs"
@@ -19,6 +21,8 @@ type Logs struct {
 	logsRepo logs.LogsRepository
 	config   coreconfig.Reader
 	appReq   requirements.ApplicationRequirement
+	filter   logs.Filter
+	format   string
 }
 
 func init() {
@@ -28,14 +32,24 @@ func init() {
 func (cmd *Logs) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["recent"] = &flags.BoolFlag{Name: "recent", Usage: T("Dump recent logs instead of tailing")}
+	fs["source"] = &flags.StringSliceFlag{Name: "source", Usage: T("Only show logs from this source type (e.g. APP, RTR, STG, API, CELL), flag can be specified multiple times")}
+	fs["instance"] = &flags.IntFlag{Name: "instance", Usage: T("Only show logs from the instance with this index")}
+	fs["stream"] = &flags.StringFlag{Name: "stream", Usage: T("Only show logs written to this stream: 'stdout' or 'stderr'")}
+	fs["grep"] = &flags.StringFlag{Name: "grep", Usage: T("Only show logs whose message matches this regular expression")}
+	fs["format"] = &flags.StringFlag{Name: "format", Usage: T("Print only the message of each log with 'raw'")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "logs",
 		Description: T("Tail or show recent logs for an app"),
 		Usage: []string{
-			T("CF_NAME logs APP_NAME"),
+			T("CF_NAME logs APP_NAME [--recent] [--source SOURCE_TYPE] [--instance INDEX]\n   [--stream stdout|stderr] [--grep REGEX] [--format raw]"),
 		},
-		Flags: fs,
+		Examples: []string{
+			"CF_NAME logs my-app --recent --source APP --stream stderr",
+			"CF_NAME logs my-app --source RTR --grep ' 5[0-9][0-9] ' --output json | jq .message",
+		},
+		Flags:   fs,
+		Records: true,
 	}
 }
 
@@ -44,6 +58,26 @@ func (cmd *Logs) Requirements(requirementsFactory requirements.Factory, fc flags
 		cmd.ui.Failed(T("Incorrect Usage. Requires an argument\n\n") + commandregistry.Commands.CommandUsage("logs"))
 	}
 
+	if fc.IsSet("instance") && fc.Int("instance") < 0 {
+		cmd.ui.Failed(T("Incorrect Usage. The value of --instance must be a positive number or zero.\n\n") + commandregistry.Commands.CommandUsage("logs"))
+	}
+
+	switch fc.String("stream") {
+	case "", "stdout", "stderr":
+	default:
+		cmd.ui.Failed(T("Incorrect Usage. The value of --stream must be 'stdout' or 'stderr'.\n\n") + commandregistry.Commands.CommandUsage("logs"))
+	}
+
+	switch fc.String("format") {
+	case "":
+	case "raw":
+		if cmd.ui.OutputFormat().IsStructured() {
+			cmd.ui.Failed(T("Incorrect Usage. --format cannot be used with --output.\n\n") + commandregistry.Commands.CommandUsage("logs"))
+		}
+	default:
+		cmd.ui.Failed(T("Incorrect Usage. The value of --format must be 'raw'.\n\n") + commandregistry.Commands.CommandUsage("logs"))
+	}
+
 	cmd.appReq = requirementsFactory.NewApplicationRequirement(fc.Args()[0])
 
 	reqs := []requirements.Requirement{
@@ -65,6 +99,23 @@ func (cmd *Logs) SetDependency(deps commandregistry.Dependency, pluginCall bool)
 func (cmd *Logs) Execute(c flags.FlagContext) {
 	app := cmd.appReq.GetApplication()
 
+	cmd.filter = logs.Filter{
+		SourceTypes: c.StringSlice("source"),
+		Stream:      c.String("stream"),
+	}
+	if c.IsSet("instance") {
+		cmd.filter.Instance = strconv.Itoa(c.Int("instance"))
+	}
+	if c.String("grep") != "" {
+		pattern, err := regexp.Compile(c.String("grep"))
+		if err != nil {
+			cmd.ui.Failed(T("Invalid regular expression for --grep: {{.Err}}", map[string]interface{}{"Err": err.Error()}))
+			return
+		}
+		cmd.filter.Pattern = pattern
+	}
+	cmd.format = c.String("format")
+
 	if c.Bool("recent") {
 		cmd.recentLogsFor(app)
 	} else {
@@ -73,12 +124,14 @@ func (cmd *Logs) Execute(c flags.FlagContext) {
 }
 
 func (cmd *Logs) recentLogsFor(app models.Application) {
-	cmd.ui.Say(T("Connected, dumping recent logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...\n",
-		map[string]interface{}{
-			"AppName":   terminal.EntityNameColor(app.Name),
-			"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
-			"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
-			"Username":  terminal.EntityNameColor(cmd.config.Username())}))
+	if cmd.format == "" {
+		cmd.ui.Say(T("Connected, dumping recent logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...\n",
+			map[string]interface{}{
+				"AppName":   terminal.EntityNameColor(app.Name),
+				"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+				"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+				"Username":  terminal.EntityNameColor(cmd.config.Username())}))
+	}
 
 	messages, err := cmd.logsRepo.RecentLogsFor(app.GUID)
 	if err != nil {
@@ -86,12 +139,15 @@ func (cmd *Logs) recentLogsFor(app models.Application) {
 	}
 
 	for _, msg := range messages {
-		cmd.ui.Say("%s", msg.ToLog(time.Local))
+		cmd.printLog(msg)
 	}
 }
 
 func (cmd *Logs) tailLogsFor(app models.Application) {
 	onConnect := func() {
+		if cmd.format != "" {
+			return
+		}
 		cmd.ui.Say(T("Connected, tailing logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...\n",
 			map[string]interface{}{
 				"AppName":   terminal.EntityNameColor(app.Name),
@@ -111,13 +167,28 @@ func (cmd *Logs) tailLogsFor(app models.Application) {
 			if !ok {
 				return
 			}
-			cmd.ui.Say("%s", msg.ToLog(time.Local))
+			cmd.printLog(msg)
 		case err := <-e:
 			cmd.handleError(err)
 		}
 	}
 }
 
+func (cmd *Logs) printLog(msg logs.Loggable) {
+	if !cmd.filter.Matches(msg) {
+		return
+	}
+
+	switch {
+	case cmd.ui.OutputFormat().IsStructured():
+		cmd.ui.PrintStructured(logs.NewRecord(msg, time.Local))
+	case cmd.format == "raw":
+		cmd.ui.Say("%s", msg.ToSimpleLog())
+	default:
+		cmd.ui.Say("%s", msg.ToLog(time.Local))
+	}
+}
+
 func (cmd *Logs) handleError(err error) {
 	switch err.(type) {
 	case nil:
@@ -1,6 +1,8 @@
 package application_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/cloudfoundry/cli/cf/api/logs"
@@ -8,6 +10,7 @@ import (
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/errors"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
 	testlogs "github.com/cloudfoundry/cli/testhelpers/logs"
@@ -143,6 +146,134 @@ var _ = Describe("logs command", func() {
 			))
 		})
 
+		Context("when filtering the logs", func() {
+			BeforeEach(func() {
+				logsRepo.RecentLogsForReturns([]logs.Loggable{
+					testlogs.NewLogMessage("App Out 0", app.GUID, "APP", "0", logmessage.LogMessage_OUT, time.Now()),
+					testlogs.NewLogMessage("App Err 1", app.GUID, "APP", "1", logmessage.LogMessage_ERR, time.Now()),
+					testlogs.NewLogMessage("Router Out 0", app.GUID, "RTR", "0", logmessage.LogMessage_OUT, time.Now()),
+				}, nil)
+			})
+
+			It("only shows logs from the given source types", func() {
+				runCommand("--recent", "--source", "RTR", "my-app")
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"Router Out 0"}))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"App Out 0"}))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"App Err 1"}))
+			})
+
+			It("only shows logs from the given instance", func() {
+				runCommand("--recent", "--instance", "1", "my-app")
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"App Err 1"}))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"App Out 0"}))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Router Out 0"}))
+			})
+
+			It("only shows logs from the given stream", func() {
+				runCommand("--recent", "--stream", "stdout", "my-app")
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"App Out 0"}, []string{"Router Out 0"}))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"App Err 1"}))
+			})
+
+			It("only shows logs matching the regular expression", func() {
+				runCommand("--recent", "--grep", "Out [0-9]$", "--source", "APP", "my-app")
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"App Out 0"}))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"App Err 1"}))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Router Out 0"}))
+			})
+
+			It("filters tailed logs", func() {
+				runCommand("--source", "RTR", "my-app")
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Log Line 1"}))
+			})
+
+			It("fails with an invalid regular expression", func() {
+				runCommand("--recent", "--grep", "(", "my-app")
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Invalid regular expression for --grep"},
+				))
+			})
+
+			It("fails with usage when the stream is invalid", func() {
+				runCommand("--stream", "stdin", "my-app")
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Incorrect Usage", "--stream must be 'stdout' or 'stderr'"},
+				))
+			})
+
+			It("fails with usage when the instance is negative", func() {
+				runCommand("--instance", "-1", "my-app")
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Incorrect Usage", "--instance must be a positive number or zero"},
+				))
+			})
+		})
+
+		Context("when a format is given", func() {
+			BeforeEach(func() {
+				logsRepo.RecentLogsForReturns([]logs.Loggable{
+					testlogs.NewLogMessage("Log Line 1", app.GUID, "APP", "0", logmessage.LogMessage_ERR, time.Unix(1400000000, 0)),
+				}, nil)
+			})
+
+			It("prints only the messages with raw", func() {
+				runCommand("--recent", "--format", "raw", "my-app")
+				Expect(ui.Outputs).To(Equal([]string{"Log Line 1"}))
+			})
+
+			It("does not print the connection message when tailing", func() {
+				runCommand("--format", "raw", "my-app")
+				Expect(ui.Outputs).To(Equal([]string{"Log Line 1"}))
+			})
+
+			It("fails with usage when the format is unknown", func() {
+				runCommand("--format", "json", "my-app")
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Incorrect Usage", "--format must be 'raw'"},
+				))
+			})
+
+			It("fails with usage when --output is given as well", func() {
+				ui.Format = terminal.JSONOutput
+				runCommand("--format", "raw", "my-app")
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Incorrect Usage", "--format cannot be used with --output"},
+				))
+			})
+		})
+
+		Context("with --output json", func() {
+			BeforeEach(func() {
+				ui.Format = terminal.JSONOutput
+				logsRepo.RecentLogsForReturns([]logs.Loggable{
+					testlogs.NewLogMessage("Log Line 1", app.GUID, "APP", "0", logmessage.LogMessage_ERR, time.Unix(1400000000, 0)),
+				}, nil)
+			})
+
+			It("prints a record per log", func() {
+				runCommand("--recent", "my-app")
+
+				Expect(ui.StructuredOutputs).To(HaveLen(1))
+				encoded, err := json.Marshal(ui.StructuredOutputs[0])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(encoded).To(MatchJSON(fmt.Sprintf(`{
+					"timestamp": %q,
+					"source_type": "APP",
+					"source_instance": "0",
+					"stream": "stderr",
+					"message": "Log Line 1"
+				}`, time.Unix(1400000000, 0).Local().Format(time.RFC3339Nano))))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Log Line 1"}))
+			})
+
+			It("prints the records when tailing", func() {
+				runCommand("my-app")
+				Expect(ui.StructuredOutputs).To(HaveLen(1))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Log Line 1"}))
+			})
+		})
+
 		Context("when the loggregator server has an invalid cert", func() {
 			Context("when the skip-ssl-validation flag is not set", func() {
 				It("fails and informs the user about the skip-ssl-validation flag", func() {
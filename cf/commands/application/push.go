@@ -7,6 +7,8 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	. "github.com/cloudfoundry/cli/cf/i18n"
 	"github.com/cloudfoundry/cli/flags"
@@ -14,6 +16,7 @@ import (
 	"github.com/cloudfoundry/cli/cf"
 	"github.com/cloudfoundry/cli/cf/actors"
 	"github.com/cloudfoundry/cli/cf/api"
+	"github.com/cloudfoundry/cli/cf/api/appinstances"
 	"github.com/cloudfoundry/cli/cf/api/applications"
 	"github.com/cloudfoundry/cli/cf/api/authentication"
 	"github.com/cloudfoundry/cli/cf/api/stacks"
@@ -30,23 +33,33 @@ import (
 	"github.com/cloudfoundry/cli/words/generator"
 )
 
+// blueGreenSuffix is appended to the name of an app to form the name of
+// the temporary app pushed by a blue-green push.
+const blueGreenSuffix = "-blue-green"
+
 type Push struct {
-	ui            terminal.UI
-	config        coreconfig.Reader
-	manifestRepo  manifest.ManifestRepository
-	appStarter    ApplicationStarter
-	appStopper    ApplicationStopper
-	serviceBinder service.ServiceBinder
-	appRepo       applications.ApplicationRepository
-	domainRepo    api.DomainRepository
-	routeRepo     api.RouteRepository
-	serviceRepo   api.ServiceRepository
-	stackRepo     stacks.StackRepository
-	authRepo      authentication.AuthenticationRepository
-	wordGenerator generator.WordGenerator
-	actor         actors.PushActor
-	zipper        appfiles.Zipper
-	appfiles      appfiles.AppFiles
+	deps             commandregistry.Dependency
+	ui               terminal.UI
+	config           coreconfig.Reader
+	manifestRepo     manifest.ManifestRepository
+	appStarter       ApplicationStarter
+	appStopper       ApplicationStopper
+	serviceBinder    service.ServiceBinder
+	appRepo          applications.ApplicationRepository
+	appSummaryRepo   api.AppSummaryRepository
+	appInstancesRepo appinstances.AppInstancesRepository
+	domainRepo       api.DomainRepository
+	routeRepo        api.RouteRepository
+	serviceRepo      api.ServiceRepository
+	stackRepo        stacks.StackRepository
+	authRepo         authentication.AuthenticationRepository
+	wordGenerator    generator.WordGenerator
+	actor            actors.PushActor
+	zipper           appfiles.Zipper
+	appfiles         appfiles.AppFiles
+	resourceCache    *appfiles.ResourceCache
+
+	PingerThrottle time.Duration
 }
 
 func init() {
@@ -72,10 +85,15 @@ func (cmd *Push) MetaData() commandregistry.CommandMetadata {
 	fs["no-manifest"] = &flags.BoolFlag{Name: "no-manifest", Usage: T("Ignore manifest file")}
 	fs["no-route"] = &flags.BoolFlag{Name: "no-route", Usage: T("Do not map a route to this app and remove routes from previous pushes of this app")}
 	fs["no-start"] = &flags.BoolFlag{Name: "no-start", Usage: T("Do not start an app after pushing")}
+	fs["no-resource-cache"] = &flags.BoolFlag{Name: "no-resource-cache", Usage: T("Hash every app file and ask the server about all of them, instead of using the local cache of files from previous pushes")}
 	fs["random-route"] = &flags.BoolFlag{Name: "random-route", Usage: T("Create a random route for this app")}
 	fs["route-path"] = &flags.StringFlag{Name: "route-path", Usage: T("Path for the route")}
 	// Hidden:true to hide app-ports for release #117189491
 	fs["app-ports"] = &flags.StringFlag{Name: "app-ports", Usage: T("Comma delimited list of ports the application may listen on"), Hidden: true}
+	fs["blue-green"] = &flags.BoolFlag{Name: "blue-green", Usage: T("Replace an existing app without downtime: push a temporary app, move the routes once all its instances are running, then delete the old app")}
+	fs["parallel"] = &flags.IntFlag{Name: "parallel", Usage: T("Push up to this many apps from the manifest at the same time")}
+	fs["vars-file"] = &flags.StringSliceFlag{Name: "vars-file", Usage: T("Path to a YAML file with values for the ((variables)) of the manifest, flag can be specified multiple times")}
+	fs["var"] = &flags.StringSliceFlag{Name: "var", Usage: T("Value for a ((variable)) of the manifest as KEY=VALUE, flag can be specified multiple times")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "push",
@@ -103,12 +121,18 @@ func (cmd *Push) MetaData() commandregistry.CommandMetadata {
 			"\n   ",
 			// Commented to hide app-ports for release #117189491
 			// fmt.Sprintf("[--app-ports %s] ", T("APP_PORTS")),
-			"[--no-hostname] [--no-manifest] [--no-route] [--no-start]\n",
+			"[--no-hostname] [--no-manifest] [--no-route] [--no-start] [--blue-green]",
+			"\n   ",
+			"[--no-resource-cache]\n",
 			"\n   ",
 			T("Push multiple apps with a manifest"),
 			":\n   ",
 			"CF_NAME push ",
 			fmt.Sprintf("[-f %s] ", T("MANIFEST_PATH")),
+			fmt.Sprintf("[--parallel %s] ", T("NUM_APPS")),
+			"\n   ",
+			fmt.Sprintf("[--vars-file %s] ", T("VARS_FILE_PATH")),
+			fmt.Sprintf("[--var %s]", T("KEY=VALUE")),
 			"\n",
 		},
 		Flags: fs,
@@ -143,26 +167,34 @@ func (cmd *Push) Requirements(requirementsFactory requirements.Factory, fc flags
 }
 
 func (cmd *Push) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.setDependency(deps, commandregistry.Commands.FindCommand)
+	return cmd
+}
+
+func (cmd *Push) setDependency(deps commandregistry.Dependency, findCommand func(string) commandregistry.Command) {
+	cmd.deps = deps
 	cmd.ui = deps.UI
 	cmd.config = deps.Config
 	cmd.manifestRepo = deps.ManifestRepo
 
 	//set appStarter
-	appCommand := commandregistry.Commands.FindCommand("start")
+	appCommand := findCommand("start")
 	appCommand = appCommand.SetDependency(deps, false)
 	cmd.appStarter = appCommand.(ApplicationStarter)
 
 	//set appStopper
-	appCommand = commandregistry.Commands.FindCommand("stop")
+	appCommand = findCommand("stop")
 	appCommand = appCommand.SetDependency(deps, false)
 	cmd.appStopper = appCommand.(ApplicationStopper)
 
 	//set serviceBinder
-	appCommand = commandregistry.Commands.FindCommand("bind-service")
+	appCommand = findCommand("bind-service")
 	appCommand = appCommand.SetDependency(deps, false)
 	cmd.serviceBinder = appCommand.(service.ServiceBinder)
 
 	cmd.appRepo = deps.RepoLocator.GetApplicationRepository()
+	cmd.appSummaryRepo = deps.RepoLocator.GetAppSummaryRepository()
+	cmd.appInstancesRepo = deps.RepoLocator.GetAppInstancesRepository()
 	cmd.domainRepo = deps.RepoLocator.GetDomainRepository()
 	cmd.routeRepo = deps.RepoLocator.GetRouteRepository()
 	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
@@ -172,8 +204,8 @@ func (cmd *Push) SetDependency(deps commandregistry.Dependency, pluginCall bool)
 	cmd.actor = deps.PushActor
 	cmd.zipper = deps.AppZipper
 	cmd.appfiles = deps.AppFiles
-
-	return cmd
+	cmd.resourceCache = deps.ResourceCache
+	cmd.PingerThrottle = DefaultPingerThrottle
 }
 
 func (cmd *Push) Execute(c flags.FlagContext) {
@@ -187,94 +219,404 @@ func (cmd *Push) Execute(c flags.FlagContext) {
 		return
 	}
 
-	routeActor := actors.NewRouteActor(cmd.ui, cmd.routeRepo)
+	if c.Bool("blue-green") && (c.Bool("no-start") || c.Bool("no-route")) {
+		cmd.ui.Failed(T("Incorrect Usage. The --blue-green flag cannot be combined with --no-start or --no-route."))
+	}
+
+	if c.IsSet("parallel") && c.Int("parallel") < 1 {
+		cmd.ui.Failed(T("Incorrect Usage. The value of --parallel must be a positive number."))
+	}
 
 	for _, appParams := range appSet {
 		if appParams.Name == nil {
 			cmd.ui.Failed(T("Error: No name found for app"))
 		}
+	}
 
-		cmd.fetchStackGUID(&appParams)
+	if !c.Bool("no-resource-cache") {
+		cmd.loadResourceCache()
+		defer cmd.saveResourceCache()
+	}
 
-		if c.IsSet("docker-image") {
-			diego := true
-			appParams.Diego = &diego
-		}
+	if c.Int("parallel") > 1 && len(appSet) > 1 {
+		cmd.pushInParallel(appSet, c.Int("parallel"), c)
+		return
+	}
 
-		var app models.Application
-		existingApp, err := cmd.appRepo.Read(*appParams.Name)
-		switch err.(type) {
-		case nil:
-			cmd.ui.Say(T("Updating app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
-				map[string]interface{}{
-					"AppName":   terminal.EntityNameColor(existingApp.Name),
-					"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
-					"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
-					"Username":  terminal.EntityNameColor(cmd.config.Username())}))
-
-			if appParams.EnvironmentVars != nil {
-				for key, val := range existingApp.EnvironmentVars {
-					if _, ok := (*appParams.EnvironmentVars)[key]; !ok {
-						(*appParams.EnvironmentVars)[key] = val
-					}
+	routeActor := actors.NewRouteActor(cmd.ui, cmd.routeRepo)
+
+	for _, appParams := range appSet {
+		cmd.pushApp(routeActor, appParams, c)
+	}
+}
+
+func (cmd *Push) loadResourceCache() {
+	err := cmd.resourceCache.Load(cmd.config.APIEndpoint())
+	if err != nil {
+		cmd.ui.Warn(T("Could not read the resource cache, all app files will be hashed and matched with the server: {{.Err}}", map[string]interface{}{"Err": err.Error()}))
+	}
+}
+
+func (cmd *Push) saveResourceCache() {
+	err := cmd.resourceCache.Save()
+	if err != nil {
+		cmd.ui.Warn(T("Could not save the resource cache: {{.Err}}", map[string]interface{}{"Err": err.Error()}))
+	}
+}
+
+func (cmd *Push) pushApp(routeActor actors.RouteActor, appParams models.AppParams, c flags.FlagContext) {
+	cmd.fetchStackGUID(&appParams)
+
+	if c.IsSet("docker-image") {
+		diego := true
+		appParams.Diego = &diego
+	}
+
+	var app models.Application
+	existingApp, err := cmd.appRepo.Read(*appParams.Name)
+	if err == nil && c.Bool("blue-green") {
+		cmd.blueGreenPush(routeActor, existingApp, appParams, c)
+		return
+	}
+
+	switch err.(type) {
+	case nil:
+		cmd.ui.Say(T("Updating app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppName":   terminal.EntityNameColor(existingApp.Name),
+				"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+				"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+				"Username":  terminal.EntityNameColor(cmd.config.Username())}))
+
+		if appParams.EnvironmentVars != nil {
+			for key, val := range existingApp.EnvironmentVars {
+				if _, ok := (*appParams.EnvironmentVars)[key]; !ok {
+					(*appParams.EnvironmentVars)[key] = val
 				}
 			}
+		}
 
-			app, err = cmd.appRepo.Update(existingApp.GUID, appParams)
-			if err != nil {
-				cmd.ui.Failed(err.Error())
-			}
-		case *errors.ModelNotFoundError:
-			spaceGUID := cmd.config.SpaceFields().GUID
-			appParams.SpaceGUID = &spaceGUID
+		app, err = cmd.appRepo.Update(existingApp.GUID, appParams)
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+		}
+	case *errors.ModelNotFoundError:
+		spaceGUID := cmd.config.SpaceFields().GUID
+		appParams.SpaceGUID = &spaceGUID
 
-			cmd.ui.Say(T("Creating app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
-				map[string]interface{}{
-					"AppName":   terminal.EntityNameColor(*appParams.Name),
-					"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
-					"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
-					"Username":  terminal.EntityNameColor(cmd.config.Username())}))
+		cmd.ui.Say(T("Creating app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppName":   terminal.EntityNameColor(*appParams.Name),
+				"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+				"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+				"Username":  terminal.EntityNameColor(cmd.config.Username())}))
 
-			app, err = cmd.appRepo.Create(appParams)
-			if err != nil {
-				cmd.ui.Failed(err.Error())
-			}
-		default:
+		app, err = cmd.appRepo.Create(appParams)
+		if err != nil {
 			cmd.ui.Failed(err.Error())
 		}
+	default:
+		cmd.ui.Failed(err.Error())
+	}
 
-		cmd.ui.Ok()
-		cmd.ui.Say("")
+	cmd.ui.Ok()
+	cmd.ui.Say("")
 
-		cmd.updateRoutes(routeActor, app, appParams)
+	cmd.updateRoutes(routeActor, app, appParams)
 
-		if c.String("docker-image") == "" {
-			err = cmd.actor.ProcessPath(*appParams.Path, cmd.processPathCallback(*appParams.Path, app))
-			if err != nil {
-				cmd.ui.Failed(
-					T("Error processing app files: {{.Error}}",
-						map[string]interface{}{
-							"Error": err.Error(),
-						}),
-				)
-				return
+	cmd.uploadAndBind(app, appParams, c)
+
+	err = cmd.restart(app, appParams, c)
+	if err != nil {
+		cmd.ui.Failed(
+			T("Error restarting application: {{.Error}}",
+				map[string]interface{}{
+					"Error": err.Error(),
+				}),
+		)
+	}
+}
+
+// pushInParallel pushes the apps of appSet with up to maxInFlight
+// pushes running at the same time. Every app is pushed by a worker of
+// its own which prefixes its output with the name of the app. A failure
+// does not stop the other pushes, the outcome of all of them is
+// summarized at the end.
+func (cmd *Push) pushInParallel(appSet []models.AppParams, maxInFlight int, c flags.FlagContext) {
+	nameWidth := 0
+	for _, appParams := range appSet {
+		if len(*appParams.Name) > nameWidth {
+			nameWidth = len(*appParams.Name)
+		}
+	}
+
+	results := make([]error, len(appSet))
+	indexes := make(chan int)
+	wg := sync.WaitGroup{}
+
+	for i := 0; i < maxInFlight && i < len(appSet); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				prefix := fmt.Sprintf("[%-*s] ", nameWidth, *appSet[index].Name)
+				results[index] = cmd.newWorker(prefix).pushAppRecovering(appSet[index], c)
 			}
+		}()
+	}
+
+	for index := range appSet {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+
+	cmd.ui.Say("")
+	table := cmd.ui.Table([]string{T("app"), T("status")})
+	failed := 0
+	for index, appParams := range appSet {
+		status := terminal.SuccessColor(T("pushed"))
+		if results[index] != nil {
+			status = terminal.FailureColor(T("failed"))
+			failed++
 		}
+		table.Add(*appParams.Name, status)
+	}
+	table.Print()
+
+	if failed > 0 {
+		cmd.ui.Failed(T("{{.FailedCount}} of {{.AppCount}} apps failed to push",
+			map[string]interface{}{"FailedCount": failed, "AppCount": len(appSet)}))
+	}
+}
 
-		if appParams.ServicesToBind != nil {
-			cmd.bindAppToServices(*appParams.ServicesToBind, app)
+// newWorker returns a Push reporting through a UI which prefixes every
+// line with prefix. It works with copies of the commands it depends on
+// so that it does not share their state with the other workers.
+func (cmd *Push) newWorker(prefix string) *Push {
+	deps := cmd.deps
+	deps.UI = terminal.NewPrefixedUI(cmd.ui, prefix)
+	// a logs consumer tails a single app, so every worker starting an app
+	// needs a logs repository of its own
+	deps.RepoLocator = deps.RepoLocator.SetLogsRepository(deps.RepoLocator.NewLogsRepository())
+
+	worker := &Push{}
+	worker.setDependency(deps, commandregistry.Commands.CopyCommand)
+	worker.PingerThrottle = cmd.PingerThrottle
+
+	// start shows the app summary through the app command, which needs
+	// the UI of the worker as well
+	if start, ok := worker.appStarter.(*Start); ok {
+		appCommand := commandregistry.Commands.CopyCommand("app").SetDependency(deps, false)
+		start.appDisplayer = appCommand.(ApplicationDisplayer)
+	}
+
+	return worker
+}
+
+// pushAppRecovering pushes a single app and turns a failure reported
+// through the UI into an error, so that one failed app does not bring
+// down the pushes of the others. Any other panic is a bug and is left
+// to crash the CLI with its stack.
+func (cmd *Push) pushAppRecovering(appParams models.AppParams, c flags.FlagContext) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if r != terminal.QuietPanic {
+				panic(r)
+			}
+			err = fmt.Errorf("%v", r)
 		}
+	}()
 
-		err = cmd.restart(app, appParams, c)
+	cmd.pushApp(actors.NewRouteActor(cmd.ui, cmd.routeRepo), appParams, c)
+	return nil
+}
+
+func (cmd *Push) uploadAndBind(app models.Application, appParams models.AppParams, c flags.FlagContext) {
+	if c.String("docker-image") == "" {
+		err := cmd.actor.ProcessPath(*appParams.Path, cmd.processPathCallback(*appParams.Path, app))
 		if err != nil {
 			cmd.ui.Failed(
-				T("Error restarting application: {{.Error}}",
+				T("Error processing app files: {{.Error}}",
 					map[string]interface{}{
 						"Error": err.Error(),
 					}),
 			)
+			return
 		}
 	}
+
+	if appParams.ServicesToBind != nil {
+		cmd.bindAppToServices(*appParams.ServicesToBind, app)
+	}
+}
+
+// blueGreenPush replaces oldApp without downtime. The new version is
+// pushed as a temporary app without routes and started. Once all of its
+// instances are running the routes of oldApp are moved over, oldApp is
+// deleted and the temporary app takes over its name. If the temporary
+// app fails to start it is deleted and oldApp is left untouched.
+func (cmd *Push) blueGreenPush(routeActor actors.RouteActor, oldApp models.Application, appParams models.AppParams, c flags.FlagContext) {
+	appName := *appParams.Name
+	tempName := appName + blueGreenSuffix
+
+	cmd.ui.Say(T("Pushing {{.TempAppName}} to replace app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}} without downtime...",
+		map[string]interface{}{
+			"TempAppName": terminal.EntityNameColor(tempName),
+			"AppName":     terminal.EntityNameColor(appName),
+			"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"Username":    terminal.EntityNameColor(cmd.config.Username())}))
+
+	oldSummary, err := cmd.appSummaryRepo.GetSummary(oldApp.GUID)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	tempParams := oldApp.ToParams()
+	tempParams.GUID = nil
+	tempParams.State = nil
+	if oldApp.DockerImage == "" {
+		tempParams.DockerImage = nil
+	}
+	tempParams.Merge(&appParams)
+	tempParams.Name = &tempName
+	if appParams.EnvironmentVars != nil {
+		envVars := map[string]interface{}{}
+		for key, val := range oldApp.EnvironmentVars {
+			envVars[key] = val
+		}
+		for key, val := range *appParams.EnvironmentVars {
+			envVars[key] = val
+		}
+		tempParams.EnvironmentVars = &envVars
+	}
+
+	services := []string{}
+	for _, serviceSummary := range oldSummary.Services {
+		services = append(services, serviceSummary.Name)
+	}
+	if appParams.ServicesToBind != nil {
+		services = append(services, *appParams.ServicesToBind...)
+	}
+	tempParams.ServicesToBind = &services
+
+	if _, err = cmd.appRepo.Read(tempName); err == nil {
+		cmd.ui.Failed(T("App {{.TempAppName}} already exists. Delete it before pushing {{.AppName}} with --blue-green.",
+			map[string]interface{}{"TempAppName": tempName, "AppName": appName}))
+	}
+
+	tempApp, err := cmd.appRepo.Create(tempParams)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	err = cmd.startReplacement(tempApp, tempParams, c)
+	if err != nil {
+		cmd.rollbackBlueGreen(tempApp)
+		cmd.ui.Failed(T("{{.TempAppName}} did not become healthy, app {{.AppName}} was left unchanged: {{.Error}}",
+			map[string]interface{}{"TempAppName": tempName, "AppName": appName, "Error": err.Error()}))
+	}
+
+	for _, routeSummary := range oldApp.Routes {
+		routeActor.BindRoute(tempApp, models.Route{
+			GUID:   routeSummary.GUID,
+			Host:   routeSummary.Host,
+			Domain: routeSummary.Domain,
+			Path:   routeSummary.Path,
+			Port:   routeSummary.Port,
+		})
+	}
+	tempApp.Name = appName
+	tempApp.Routes = oldApp.Routes
+	cmd.updateRoutes(routeActor, tempApp, appParams)
+	routeActor.UnbindAll(oldApp)
+
+	cmd.ui.Say(T("Deleting previous version of app {{.AppName}}...",
+		map[string]interface{}{"AppName": terminal.EntityNameColor(appName)}))
+	err = cmd.appRepo.Delete(oldApp.GUID)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+	cmd.ui.Ok()
+
+	cmd.ui.Say(T("Renaming app {{.TempAppName}} to {{.AppName}}...",
+		map[string]interface{}{
+			"TempAppName": terminal.EntityNameColor(tempName),
+			"AppName":     terminal.EntityNameColor(appName)}))
+	_, err = cmd.appRepo.Update(tempApp.GUID, models.AppParams{Name: &appName})
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+	cmd.ui.Ok()
+}
+
+// startReplacement uploads and starts the temporary app of a blue-green
+// push and waits for all of its instances to run. The starter reports
+// failures by panicking through the UI, so the temporary app is rolled
+// back before the panic is passed on.
+func (cmd *Push) startReplacement(app models.Application, appParams models.AppParams, c flags.FlagContext) error {
+	defer func() {
+		if r := recover(); r != nil {
+			cmd.rollbackBlueGreen(app)
+			panic(r)
+		}
+	}()
+
+	cmd.uploadAndBind(app, appParams, c)
+
+	err := cmd.restart(app, appParams, c)
+	if err != nil {
+		return err
+	}
+
+	timeout := DefaultStartupTimeout
+	if appParams.HealthCheckTimeout != nil {
+		timeout = time.Duration(*appParams.HealthCheckTimeout) * time.Second
+	}
+
+	return cmd.waitForAllInstancesRunning(app, timeout)
+}
+
+func (cmd *Push) waitForAllInstancesRunning(app models.Application, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		instances, err := cmd.appInstancesRepo.GetInstances(app.GUID)
+		if err != nil {
+			return err
+		}
+
+		running := 0
+		for _, instance := range instances {
+			switch instance.State {
+			case models.InstanceRunning:
+				running++
+			case models.InstanceCrashed, models.InstanceFlapping:
+				return errors.New(T("an instance of {{.AppName}} crashed", map[string]interface{}{"AppName": app.Name}))
+			}
+		}
+
+		if len(instances) > 0 && running == len(instances) {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return errors.New(T("timed out waiting for all instances of {{.AppName}} to run", map[string]interface{}{"AppName": app.Name}))
+		}
+
+		time.Sleep(cmd.PingerThrottle)
+	}
+}
+
+func (cmd *Push) rollbackBlueGreen(app models.Application) {
+	cmd.ui.Warn(T("Rolling back: deleting app {{.AppName}}...", map[string]interface{}{"AppName": app.Name}))
+	err := cmd.appRepo.Delete(app.GUID)
+	if err != nil {
+		cmd.ui.Warn(T("Could not delete app {{.AppName}}: {{.Error}}", map[string]interface{}{"AppName": app.Name, "Error": err.Error()}))
+	}
 }
 
 func (cmd *Push) processPathCallback(path string, app models.Application) func(string) {
@@ -303,6 +645,11 @@ func (cmd *Push) processPathCallback(path string, app models.Application) func(s
 			map[string]interface{}{"AppName": terminal.EntityNameColor(app.Name)}))
 
 		err = cmd.uploadApp(app.GUID, appDir, path, localFiles)
+		if err != nil && cmd.resourceCache.ForgetMatched() {
+			cmd.ui.Warn(T("Upload failed, retrying without the resources remembered in the resource cache: {{.Err}}",
+				map[string]interface{}{"Err": err.Error()}))
+			err = cmd.uploadApp(app.GUID, appDir, path, localFiles)
+		}
 		if err != nil {
 			cmd.ui.Failed(fmt.Sprintf(T("Error uploading application.\n{{.APIErr}}",
 				map[string]interface{}{"APIErr": err.Error()})))
@@ -326,6 +673,24 @@ func (cmd *Push) updateRoutes(routeActor actors.RouteActor, app models.Applicati
 		return
 	}
 
+	if appParams.Routes != nil {
+		for _, manifestRoute := range appParams.Routes {
+			host, domain, path, port := cmd.parseManifestRoute(manifestRoute.Route)
+			cmd.createAndBindRoute(
+				&host,
+				false,
+				false,
+				routeActor,
+				app,
+				host == "",
+				domain,
+				&path,
+				port,
+			)
+		}
+		return
+	}
+
 	if routeDefined || defaultRouteAcceptable {
 		if appParams.Domains == nil {
 			domain := cmd.findDomain(nil)
@@ -363,6 +728,7 @@ func (cmd *Push) processDomainsAndBindRoutes(
 			appParams.NoHostname,
 			domain,
 			appParams.RoutePath,
+			0,
 		)
 	} else {
 		for _, host := range *(appParams.Hosts) {
@@ -375,6 +741,7 @@ func (cmd *Push) processDomainsAndBindRoutes(
 				appParams.NoHostname,
 				domain,
 				appParams.RoutePath,
+				0,
 			)
 		}
 	}
@@ -389,6 +756,7 @@ func (cmd *Push) createAndBindRoute(
 	noHostName bool,
 	domain models.DomainFields,
 	routePath *string,
+	port int,
 ) {
 	var hostname string
 	if !noHostName {
@@ -406,9 +774,9 @@ func (cmd *Push) createAndBindRoute(
 
 	var route models.Route
 	if routePath != nil {
-		route = routeActor.FindOrCreateRoute(hostname, domain, *routePath, UseRandomPort)
+		route = routeActor.FindOrCreateRoute(hostname, domain, *routePath, port, UseRandomPort)
 	} else {
-		route = routeActor.FindOrCreateRoute(hostname, domain, "", UseRandomPort)
+		route = routeActor.FindOrCreateRoute(hostname, domain, "", port, UseRandomPort)
 	}
 	routeActor.BindRoute(app, route)
 }
@@ -423,6 +791,50 @@ func hostNameForString(name string) string {
 	return name
 }
 
+// parseManifestRoute splits a route of the manifest, host.domain/path or
+// tcp-domain:port, into its parts. The host is only split off when the
+// whole name of the route is not a domain of the org.
+func (cmd *Push) parseManifestRoute(route string) (string, models.DomainFields, string, int) {
+	var (
+		host string
+		path string
+		port int
+		err  error
+	)
+
+	name := route
+	if index := strings.Index(name, "/"); index != -1 {
+		name, path = name[:index], name[index:]
+	}
+
+	if index := strings.LastIndex(name, ":"); index != -1 {
+		port, err = strconv.Atoi(name[index+1:])
+		if err != nil || port <= 0 {
+			cmd.ui.Failed(T("Invalid port in route {{.Route}}", map[string]interface{}{"Route": route}))
+		}
+		name = name[:index]
+	}
+
+	orgGUID := cmd.config.OrganizationFields().GUID
+	domain, err := cmd.domainRepo.FindByNameInOrg(name, orgGUID)
+	if _, ok := err.(*errors.ModelNotFoundError); ok && port == 0 {
+		if index := strings.Index(name, "."); index != -1 {
+			host = name[:index]
+			domain, err = cmd.domainRepo.FindByNameInOrg(name[index+1:], orgGUID)
+		}
+	}
+
+	switch err.(type) {
+	case nil:
+	case *errors.ModelNotFoundError:
+		cmd.ui.Failed(T("The route {{.Route}} did not match any existing domains.", map[string]interface{}{"Route": route}))
+	default:
+		cmd.ui.Failed(err.Error())
+	}
+
+	return host, domain, path, port
+}
+
 func (cmd *Push) findDomain(domainName *string) models.DomainFields {
 	domain, err := cmd.domainRepo.FirstOrDefault(cmd.config.OrganizationFields().GUID, domainName)
 	if err != nil {
@@ -536,6 +948,21 @@ func (cmd *Push) getAppParamsFromManifest(c flags.FlagContext) []models.AppParam
 		cmd.ui.Failed(T("Error reading manifest file:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
 	}
 
+	vars, err := manifest.ReadVarsFiles(c.StringSlice("vars-file"))
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	err = vars.AddVarFlags(c.StringSlice("var"))
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	err = m.Interpolate(vars)
+	if err != nil {
+		cmd.ui.Failed(T("Error reading manifest file:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
+	}
+
 	apps, err := m.Applications()
 	if err != nil {
 		cmd.ui.Failed("Error reading manifest file:\n%s", err)
@@ -562,6 +989,9 @@ func (cmd *Push) createAppSetFromContextAndManifest(contextApp models.AppParams,
 			err = addApp(&apps, contextApp)
 		}
 	case 1:
+		if manifestApps[0].Routes != nil && (contextApp.Domains != nil || contextApp.Hosts != nil || contextApp.NoHostname || contextApp.RoutePath != nil) {
+			cmd.ui.Failed("%s", T("Incorrect Usage. The -d, -n, --hostname, --no-hostname and --route-path flags cannot be used with routes in the manifest."))
+		}
 		manifestApps[0].Merge(&contextApp)
 		err = addApp(&apps, manifestApps[0])
 	default:
@@ -2,16 +2,23 @@ package application_test
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 	"syscall"
+	"time"
 
 	"github.com/cloudfoundry/cli/cf/actors/actorsfakes"
 	"github.com/cloudfoundry/cli/cf/api/apifakes"
+	"github.com/cloudfoundry/cli/cf/api/appinstances/appinstancesfakes"
 	"github.com/cloudfoundry/cli/cf/api/applications/applicationsfakes"
 	"github.com/cloudfoundry/cli/cf/api/authentication/authenticationfakes"
+	"github.com/cloudfoundry/cli/cf/api/logs"
+	"github.com/cloudfoundry/cli/cf/api/logs/logsfakes"
 	"github.com/cloudfoundry/cli/cf/api/resources"
 	"github.com/cloudfoundry/cli/cf/api/stacks/stacksfakes"
+	appfilespkg "github.com/cloudfoundry/cli/cf/appfiles"
 	"github.com/cloudfoundry/cli/cf/appfiles/appfilesfakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/commands/application"
@@ -47,6 +54,8 @@ var _ = Describe("Push Command", func() {
 		stopper                    *applicationfakes.FakeApplicationStopper
 		serviceBinder              *servicefakes.OldFakeAppBinder
 		appRepo                    *applicationsfakes.FakeApplicationRepository
+		appSummaryRepo             *apifakes.FakeAppSummaryRepository
+		appInstancesRepo           *appinstancesfakes.FakeAppInstancesRepository
 		domainRepo                 *apifakes.FakeDomainRepository
 		routeRepo                  *apifakes.FakeRouteRepository
 		stackRepo                  *stacksfakes.FakeStackRepository
@@ -68,6 +77,8 @@ var _ = Describe("Push Command", func() {
 		deps.Config = configRepo
 		deps.ManifestRepo = manifestRepo
 		deps.RepoLocator = deps.RepoLocator.SetApplicationRepository(appRepo)
+		deps.RepoLocator = deps.RepoLocator.SetAppSummaryRepository(appSummaryRepo)
+		deps.RepoLocator = deps.RepoLocator.SetAppInstancesRepository(appInstancesRepo)
 		deps.RepoLocator = deps.RepoLocator.SetDomainRepository(domainRepo)
 		deps.RepoLocator = deps.RepoLocator.SetRouteRepository(routeRepo)
 		deps.RepoLocator = deps.RepoLocator.SetServiceRepository(serviceRepo)
@@ -105,6 +116,8 @@ var _ = Describe("Push Command", func() {
 		stopper.MetaDataReturns(commandregistry.CommandMetadata{Name: "stop"})
 
 		appRepo = new(applicationsfakes.FakeApplicationRepository)
+		appSummaryRepo = new(apifakes.FakeAppSummaryRepository)
+		appInstancesRepo = new(appinstancesfakes.FakeAppInstancesRepository)
 
 		domainRepo = new(apifakes.FakeDomainRepository)
 		sharedDomain := maker.NewSharedDomainFields(maker.Overrides{"name": "foo.cf-app.com", "guid": "foo-domain-guid"})
@@ -137,7 +150,7 @@ var _ = Describe("Push Command", func() {
 		OriginalCommandServiceBind = commandregistry.Commands.FindCommand("bind-service")
 
 		routeRepo = new(apifakes.FakeRouteRepository)
-		routeRepo.CreateStub = func(host string, domain models.DomainFields, path string, _ bool) (models.Route, error) {
+		routeRepo.CreateStub = func(host string, domain models.DomainFields, path string, _ int, _ bool) (models.Route, error) {
 			// This never returns an error, which means it isn't tested.
 			// This is copied from the old route repo fake.
 			route := models.Route{}
@@ -290,6 +303,72 @@ var _ = Describe("Push Command", func() {
 			Expect(port).To(Equal(0))
 		})
 
+		Context("with a resource cache", func() {
+			var cacheDir string
+			var cachePath string
+
+			BeforeEach(func() {
+				var err error
+				cacheDir, err = ioutil.TempDir("", "resource-cache")
+				Expect(err).NotTo(HaveOccurred())
+
+				cachePath = filepath.Join(cacheDir, "resource_cache.json")
+				deps.ResourceCache = appfilespkg.NewResourceCache(cachePath)
+			})
+
+			AfterEach(func() {
+				deps.ResourceCache = nil
+				os.RemoveAll(cacheDir)
+			})
+
+			It("loads and saves the cache", func() {
+				callPush("app-name")
+
+				_, err := os.Stat(cachePath)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not use the cache with --no-resource-cache", func() {
+				callPush("--no-resource-cache", "app-name")
+
+				_, err := os.Stat(cachePath)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+
+			It("retries an upload that failed without the matched resources of the cache", func() {
+				contents := fmt.Sprintf(`{"matched":{%q:{"some-sha:8":%d}}}`, configRepo.APIEndpoint(), time.Now().Unix())
+				err := ioutil.WriteFile(cachePath, []byte(contents), 0600)
+				Expect(err).NotTo(HaveOccurred())
+
+				actor.UploadAppStub = func(string, *os.File, []resources.AppFileResource) error {
+					if actor.UploadAppCallCount() == 1 {
+						return errors.New("resource not found")
+					}
+					return nil
+				}
+
+				callPush("app-name")
+
+				Expect(actor.GatherFilesCallCount()).To(Equal(2))
+				Expect(actor.UploadAppCallCount()).To(Equal(2))
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Upload failed, retrying without the resources remembered in the resource cache", "resource not found"},
+				))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"FAILED"}))
+			})
+
+			It("warns when the cache cannot be read", func() {
+				err := ioutil.WriteFile(cachePath, []byte("not json"), 0600)
+				Expect(err).NotTo(HaveOccurred())
+
+				callPush("app-name")
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Could not read the resource cache, all app files will be hashed and matched with the server", "Invalid resource cache"},
+				))
+			})
+		})
+
 		Context("when given a bad path", func() {
 			BeforeEach(func() {
 				actor.ProcessPathStub = func(dirOrZipFile string, f func(string)) error {
@@ -456,7 +535,7 @@ var _ = Describe("Push Command", func() {
 				Expect(host).To(Equal("app-name"))
 
 				Expect(routeRepo.CreateCallCount()).To(Equal(1))
-				createdHost, createdDomainFields, createdPath, randomPort := routeRepo.CreateArgsForCall(0)
+				createdHost, createdDomainFields, createdPath, _, randomPort := routeRepo.CreateArgsForCall(0)
 				Expect(createdHost).To(Equal("app-name"))
 				Expect(createdDomainFields.GUID).To(Equal("foo-domain-guid"))
 				Expect(createdPath).To(BeEmpty())
@@ -498,7 +577,7 @@ var _ = Describe("Push Command", func() {
 				host, _, _, _ := routeRepo.FindArgsForCall(0)
 				Expect(host).To(Equal("appname"))
 				Expect(routeRepo.CreateCallCount()).To(Equal(1))
-				createdHost, _, _, _ := routeRepo.CreateArgsForCall(0)
+				createdHost, _, _, _, _ := routeRepo.CreateArgsForCall(0)
 				Expect(createdHost).To(Equal("appname"))
 
 				Expect(ui.Outputs).To(ContainSubstrings(
@@ -566,7 +645,7 @@ var _ = Describe("Push Command", func() {
 				Expect(owningOrgGUID).To(Equal("my-org-guid"))
 
 				Expect(routeRepo.CreateCallCount()).To(Equal(1))
-				createdHost, createdDomainFields, createdPath, randomPort := routeRepo.CreateArgsForCall(0)
+				createdHost, createdDomainFields, createdPath, _, randomPort := routeRepo.CreateArgsForCall(0)
 				Expect(createdHost).To(Equal("my-hostname"))
 				Expect(createdDomainFields.GUID).To(Equal("bar-domain-guid"))
 				Expect(createdPath).To(Equal("my-route-path"))
@@ -655,7 +734,7 @@ var _ = Describe("Push Command", func() {
 					Expect(host).To(Equal("app-name"))
 
 					Expect(routeRepo.CreateCallCount()).To(Equal(1))
-					createdHost, createdDomainFields, createdPath, randomPort := routeRepo.CreateArgsForCall(0)
+					createdHost, createdDomainFields, createdPath, _, randomPort := routeRepo.CreateArgsForCall(0)
 					Expect(createdHost).To(Equal("app-name"))
 					Expect(createdDomainFields.GUID).To(Equal("shared-domain-guid"))
 					Expect(createdPath).To(Equal("the-route-path"))
@@ -701,7 +780,7 @@ var _ = Describe("Push Command", func() {
 					Expect(host).To(Equal("app-name"))
 
 					Expect(routeRepo.CreateCallCount()).To(Equal(1))
-					createdHost, createdDomainFields, createdPath, randomPort := routeRepo.CreateArgsForCall(0)
+					createdHost, createdDomainFields, createdPath, _, randomPort := routeRepo.CreateArgsForCall(0)
 					Expect(createdHost).To(Equal("app-name"))
 					Expect(createdDomainFields.GUID).To(Equal("private-domain-guid"))
 					Expect(createdPath).To(BeEmpty())
@@ -793,7 +872,7 @@ var _ = Describe("Push Command", func() {
 						Expect(port).To(Equal(0))
 
 						Expect(routeRepo.CreateCallCount()).To(Equal(1))
-						host, domain, path, useRandomPort := routeRepo.CreateArgsForCall(0)
+						host, domain, path, _, useRandomPort := routeRepo.CreateArgsForCall(0)
 						Expect(host).To(Equal(""))
 						Expect(domain).To(Equal(expectedDomain))
 						Expect(path).To(Equal(""))
@@ -817,7 +896,7 @@ var _ = Describe("Push Command", func() {
 						Expect(port).To(Equal(0))
 
 						Expect(routeRepo.CreateCallCount()).To(Equal(1))
-						host, domain, path, useRandomPort := routeRepo.CreateArgsForCall(0)
+						host, domain, path, _, useRandomPort := routeRepo.CreateArgsForCall(0)
 						Expect(host).To(Equal(""))
 						Expect(domain).To(Equal(expectedDomain))
 						Expect(path).To(Equal(""))
@@ -830,6 +909,84 @@ var _ = Describe("Push Command", func() {
 				})
 			})
 
+			Describe("with routes in the manifest", func() {
+				var (
+					manifestApp generic.Map
+					httpDomain  models.DomainFields
+					tcpDomain   models.DomainFields
+				)
+
+				BeforeEach(func() {
+					manifest := singleAppManifest()
+					manifestApp = manifest.Data.Get("applications").([]interface{})[0].(generic.Map)
+					manifestApp.Delete("host")
+					manifestApp.Delete("domain")
+					manifestApp.Set("routes", []interface{}{
+						map[interface{}]interface{}{"route": "app-host.example.com/some-path"},
+						map[interface{}]interface{}{"route": "tcp.example.com:1234"},
+					})
+					manifestRepo.ReadManifestReturns.Manifest = manifest
+
+					httpDomain = models.DomainFields{GUID: "example-domain-guid", Name: "example.com"}
+					tcpDomain = models.DomainFields{GUID: "tcp-domain-guid", Name: "tcp.example.com", RouterGroupType: "tcp"}
+					domainRepo.FindByNameInOrgStub = func(name string, orgGUID string) (models.DomainFields, error) {
+						switch name {
+						case "example.com":
+							return httpDomain, nil
+						case "tcp.example.com":
+							return tcpDomain, nil
+						}
+						return models.DomainFields{}, errors.NewModelNotFoundError("Domain", name)
+					}
+
+					routeRepo.FindReturns(models.Route{}, errors.NewModelNotFoundError("Route", "some-route"))
+				})
+
+				It("creates and binds each route", func() {
+					callPush("app-name")
+
+					Expect(routeRepo.CreateCallCount()).To(Equal(2))
+
+					host, domain, path, port, useRandomPort := routeRepo.CreateArgsForCall(0)
+					Expect(host).To(Equal("app-host"))
+					Expect(domain).To(Equal(httpDomain))
+					Expect(path).To(Equal("/some-path"))
+					Expect(port).To(BeZero())
+					Expect(useRandomPort).To(BeFalse())
+
+					host, domain, path, port, useRandomPort = routeRepo.CreateArgsForCall(1)
+					Expect(host).To(BeEmpty())
+					Expect(domain).To(Equal(tcpDomain))
+					Expect(path).To(BeEmpty())
+					Expect(port).To(Equal(1234))
+					Expect(useRandomPort).To(BeFalse())
+
+					Expect(routeRepo.BindCallCount()).To(Equal(2))
+				})
+
+				It("fails when a route does not match any domain", func() {
+					manifestApp.Set("routes", []interface{}{
+						map[interface{}]interface{}{"route": "app-host.unknown.com"},
+					})
+
+					callPush("app-name")
+					Expect(routeRepo.CreateCallCount()).To(BeZero())
+					Expect(ui.Outputs).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"The route app-host.unknown.com did not match any existing domains."},
+					))
+				})
+
+				It("fails when route flags are given", func() {
+					callPush("-n", "other-host", "app-name")
+					Expect(routeRepo.CreateCallCount()).To(BeZero())
+					Expect(ui.Outputs).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"cannot be used with routes in the manifest"},
+					))
+				})
+			})
+
 			It("includes the app files in dir", func() {
 				expectedLocalFiles := []models.AppFileFields{
 					{
@@ -964,6 +1121,71 @@ var _ = Describe("Push Command", func() {
 				}))
 			})
 
+			Context("when the manifest has ((variables))", func() {
+				var varsFile *os.File
+
+				BeforeEach(func() {
+					manifestRepo.ReadManifestReturns.Manifest = &manifest.Manifest{
+						Path: "manifest.yml",
+						Data: generic.NewMap(map[interface{}]interface{}{
+							"applications": []interface{}{
+								map[interface{}]interface{}{
+									"name":      "((app-name))",
+									"instances": "((instances))",
+									"memory":    "((memory))",
+									"env": map[interface{}]interface{}{
+										"GREETING": "hello ((app-name))",
+									},
+								},
+							},
+						}),
+					}
+
+					var err error
+					varsFile, err = ioutil.TempFile("", "vars")
+					Expect(err).NotTo(HaveOccurred())
+					_, err = varsFile.WriteString("app-name: vars-app\ninstances: 3\nmemory: 256M\n")
+					Expect(err).NotTo(HaveOccurred())
+					varsFile.Close()
+				})
+
+				AfterEach(func() {
+					os.Remove(varsFile.Name())
+				})
+
+				It("resolves them from vars files, letting --var override", func() {
+					callPush("--vars-file", varsFile.Name(), "--var", "memory=512M", "--no-route")
+
+					params := appRepo.CreateArgsForCall(0)
+					Expect(*params.Name).To(Equal("vars-app"))
+					Expect(*params.InstanceCount).To(Equal(3))
+					Expect(*params.Memory).To(Equal(int64(512)))
+					Expect(*params.EnvironmentVars).To(Equal(map[string]interface{}{
+						"GREETING": "hello vars-app",
+					}))
+				})
+
+				It("fails listing every unresolved variable before contacting the API", func() {
+					callPush("--var", "memory=512M")
+
+					Expect(ui.Outputs).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"Expected to find variables: app-name, instances"},
+					))
+					Expect(authRepo.RefreshAuthTokenCallCount()).To(Equal(0))
+					Expect(appRepo.CreateCallCount()).To(Equal(0))
+				})
+
+				It("fails when a --var is not a KEY=VALUE pair", func() {
+					callPush("--var", "memory")
+
+					Expect(ui.Outputs).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"Invalid variable 'memory'. Expected KEY=VALUE"},
+					))
+				})
+			})
+
 			It("pushes an app with multiple routes when multiple hosts are provided", func() {
 				domainRepo.FindByNameInOrgReturns(models.DomainFields{
 					Name: "manifest-example.com",
@@ -1029,7 +1251,7 @@ var _ = Describe("Push Command", func() {
 				params := appRepo.CreateArgsForCall(0)
 				Expect(*params.Name).To(Equal("app-name"))
 				Expect(routeRepo.CreateCallCount()).To(Equal(1))
-				createdHost, createdDomainFields, _, _ := routeRepo.CreateArgsForCall(0)
+				createdHost, createdDomainFields, _, _, _ := routeRepo.CreateArgsForCall(0)
 				Expect(createdHost).To(Equal(""))
 				Expect(createdDomainFields.GUID).To(Equal("bar-domain-guid"))
 			})
@@ -1278,7 +1500,7 @@ var _ = Describe("Push Command", func() {
 					It("adds the route", func() {
 						callPush("existing-app")
 						Expect(routeRepo.CreateCallCount()).To(Equal(1))
-						createdHost, _, _, _ := routeRepo.CreateArgsForCall(0)
+						createdHost, _, _, _, _ := routeRepo.CreateArgsForCall(0)
 						Expect(createdHost).To(Equal("new-manifest-host"))
 					})
 				})
@@ -1299,7 +1521,7 @@ var _ = Describe("Push Command", func() {
 				Expect(domain.Name).To(Equal("newdomain.com"))
 
 				Expect(routeRepo.CreateCallCount()).To(Equal(1))
-				createdHost, createdDomainFields, _, randomPort := routeRepo.CreateArgsForCall(0)
+				createdHost, createdDomainFields, _, _, randomPort := routeRepo.CreateArgsForCall(0)
 				Expect(createdHost).To(Equal("existing-app"))
 				Expect(createdDomainFields.GUID).To(Equal("domain-guid"))
 				Expect(randomPort).To(BeFalse())
@@ -1322,7 +1544,7 @@ var _ = Describe("Push Command", func() {
 				Expect(domain.Name).To(Equal("example.com"))
 
 				Expect(routeRepo.CreateCallCount()).To(Equal(1))
-				createdHost, createdDomainFields, _, _ := routeRepo.CreateArgsForCall(0)
+				createdHost, createdDomainFields, _, _, _ := routeRepo.CreateArgsForCall(0)
 				Expect(createdHost).To(Equal("new-host"))
 				Expect(createdDomainFields.GUID).To(Equal("domain-guid"))
 
@@ -1360,7 +1582,7 @@ var _ = Describe("Push Command", func() {
 				Expect(domain.Name).To(Equal("example.com"))
 
 				Expect(routeRepo.CreateCallCount()).To(Equal(1))
-				createdHost, createdDomainFields, _, randomPort := routeRepo.CreateArgsForCall(0)
+				createdHost, createdDomainFields, _, _, randomPort := routeRepo.CreateArgsForCall(0)
 				Expect(createdHost).To(Equal(""))
 				Expect(createdDomainFields.GUID).To(Equal("domain-guid"))
 				Expect(randomPort).To(BeFalse())
@@ -1375,6 +1597,159 @@ var _ = Describe("Push Command", func() {
 		})
 	})
 
+	Describe("blue-green push", func() {
+		var (
+			existingApp models.Application
+			tempApp     models.Application
+		)
+
+		BeforeEach(func() {
+			existingApp = models.Application{}
+			existingApp.Name = "existing-app"
+			existingApp.GUID = "existing-app-guid"
+			existingApp.State = "started"
+			existingApp.InstanceCount = 2
+			existingApp.Memory = 256
+			existingApp.EnvironmentVars = map[string]interface{}{"crazy": "pants"}
+			existingApp.Routes = []models.RouteSummary{
+				{GUID: "existing-route-guid", Host: "existing-app", Domain: models.DomainFields{Name: "foo.cf-app.com"}},
+			}
+
+			tempApp = models.Application{}
+			tempApp.Name = "existing-app-blue-green"
+			tempApp.GUID = "temp-app-guid"
+			tempApp.State = "stopped"
+
+			appRepo.ReadStub = func(name string) (models.Application, error) {
+				if name == "existing-app" {
+					return existingApp, nil
+				}
+				return models.Application{}, errors.NewModelNotFoundError("App", name)
+			}
+			appRepo.CreateReturns(tempApp, nil)
+
+			summary := existingApp
+			summary.Services = []models.ServicePlanSummary{{Name: "existing-service"}}
+			appSummaryRepo.GetSummaryReturns(summary, nil)
+			serviceRepo.FindInstanceByNameStub = func(name string) (models.ServiceInstance, error) {
+				instance := models.ServiceInstance{}
+				instance.Name = name
+				return instance, nil
+			}
+
+			appInstancesRepo.GetInstancesReturns([]models.AppInstanceFields{
+				{State: models.InstanceRunning},
+				{State: models.InstanceRunning},
+			}, nil)
+		})
+
+		It("pushes a temporary app based on the existing one", func() {
+			callPush("--blue-green", "-m", "512M", "existing-app")
+
+			Expect(appRepo.UpdateCallCount()).To(Equal(1))
+			params := appRepo.CreateArgsForCall(0)
+			Expect(*params.Name).To(Equal("existing-app-blue-green"))
+			Expect(*params.Memory).To(Equal(int64(512)))
+			Expect(*params.InstanceCount).To(Equal(2))
+			Expect((*params.EnvironmentVars)["crazy"]).To(Equal("pants"))
+
+			appGUID, _, _ := actor.UploadAppArgsForCall(0)
+			Expect(appGUID).To(Equal("temp-app-guid"))
+
+			startedApp, _, _ := starter.ApplicationStartArgsForCall(0)
+			Expect(startedApp.GUID).To(Equal("temp-app-guid"))
+			Expect(stopper.ApplicationStopCallCount()).To(Equal(0))
+		})
+
+		It("binds the services of the existing app to the temporary app", func() {
+			callPush("--blue-green", "existing-app")
+
+			Expect(serviceBinder.AppsToBind[0].GUID).To(Equal("temp-app-guid"))
+			Expect(serviceBinder.InstancesToBindTo[0].Name).To(Equal("existing-service"))
+		})
+
+		It("moves the routes, deletes the old app and renames the new one", func() {
+			callPush("--blue-green", "existing-app")
+
+			routeGUID, appGUID := routeRepo.BindArgsForCall(0)
+			Expect(routeGUID).To(Equal("existing-route-guid"))
+			Expect(appGUID).To(Equal("temp-app-guid"))
+
+			routeGUID, appGUID = routeRepo.UnbindArgsForCall(0)
+			Expect(routeGUID).To(Equal("existing-route-guid"))
+			Expect(appGUID).To(Equal("existing-app-guid"))
+
+			Expect(appRepo.DeleteCallCount()).To(Equal(1))
+			Expect(appRepo.DeleteArgsForCall(0)).To(Equal("existing-app-guid"))
+
+			appGUID, params := appRepo.UpdateArgsForCall(0)
+			Expect(appGUID).To(Equal("temp-app-guid"))
+			Expect(*params.Name).To(Equal("existing-app"))
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Deleting previous version of app", "existing-app"},
+				[]string{"Renaming app", "existing-app-blue-green", "existing-app"},
+			))
+		})
+
+		Context("when an instance of the temporary app crashes", func() {
+			BeforeEach(func() {
+				appInstancesRepo.GetInstancesReturns([]models.AppInstanceFields{
+					{State: models.InstanceRunning},
+					{State: models.InstanceCrashed},
+				}, nil)
+			})
+
+			It("deletes the temporary app and leaves the existing app alone", func() {
+				callPush("--blue-green", "existing-app")
+
+				Expect(appRepo.DeleteCallCount()).To(Equal(1))
+				Expect(appRepo.DeleteArgsForCall(0)).To(Equal("temp-app-guid"))
+				Expect(routeRepo.BindCallCount()).To(Equal(0))
+				Expect(routeRepo.UnbindCallCount()).To(Equal(0))
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Rolling back", "existing-app-blue-green"},
+					[]string{"FAILED"},
+					[]string{"existing-app-blue-green", "did not become healthy"},
+				))
+			})
+		})
+
+		Context("when the temporary app fails to start", func() {
+			BeforeEach(func() {
+				starter.ApplicationStartStub = func(models.Application, string, string) (models.Application, error) {
+					ui.Failed("Start unsuccessful")
+					return models.Application{}, nil
+				}
+			})
+
+			It("deletes the temporary app", func() {
+				callPush("--blue-green", "existing-app")
+
+				Expect(appRepo.DeleteCallCount()).To(Equal(1))
+				Expect(appRepo.DeleteArgsForCall(0)).To(Equal("temp-app-guid"))
+			})
+		})
+
+		It("pushes normally when the app does not exist yet", func() {
+			existingApp.Name = "other-app"
+			appRepo.CreateReturns(existingApp, nil)
+
+			callPush("--blue-green", "new-app")
+
+			params := appRepo.CreateArgsForCall(0)
+			Expect(*params.Name).To(Equal("new-app"))
+			Expect(appRepo.DeleteCallCount()).To(Equal(0))
+		})
+
+		It("cannot be combined with --no-start", func() {
+			callPush("--blue-green", "--no-start", "existing-app")
+
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"--blue-green", "--no-start"}))
+			Expect(appRepo.CreateCallCount()).To(Equal(0))
+		})
+	})
+
 	Describe("service instances", func() {
 		BeforeEach(func() {
 			serviceRepo.FindInstanceByNameStub = func(name string) (models.ServiceInstance, error) {
@@ -1537,6 +1912,147 @@ var _ = Describe("Push Command", func() {
 			[]string{"USAGE:"},
 		))
 	})
+
+	Describe("pushing the apps of a manifest in parallel", func() {
+		BeforeEach(func() {
+			manifestRepo.ReadManifestReturns.Manifest = multipleAppsManifest()
+			appRepo.ReadReturns(models.Application{}, errors.NewModelNotFoundError("App", "the-app"))
+			appRepo.CreateStub = func(params models.AppParams) (models.Application, error) {
+				app := models.Application{}
+				app.Name = *params.Name
+				app.GUID = *params.Name + "-guid"
+				app.State = "stopped"
+				return app, nil
+			}
+		})
+
+		It("pushes every app and prefixes the output with the app name", func() {
+			callPush("--parallel", "2")
+
+			Expect(appRepo.CreateCallCount()).To(Equal(2))
+			Expect(actor.UploadAppCallCount()).To(Equal(2))
+			Expect(starter.ApplicationStartCallCount()).To(Equal(2))
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"[app1] ", "Creating app", "app1"},
+				[]string{"[app2] ", "Creating app", "app2"},
+			))
+			Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"FAILED"}))
+		})
+
+		It("prints a summary of the pushes", func() {
+			callPush("--parallel", "2")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"app", "status"},
+				[]string{"app1", "pushed"},
+				[]string{"app2", "pushed"},
+			))
+		})
+
+		Context("when one of the apps fails to push", func() {
+			BeforeEach(func() {
+				appRepo.CreateStub = func(params models.AppParams) (models.Application, error) {
+					if *params.Name == "app2" {
+						return models.Application{}, errors.New("create failed")
+					}
+					app := models.Application{}
+					app.Name = *params.Name
+					app.GUID = *params.Name + "-guid"
+					app.State = "stopped"
+					return app, nil
+				}
+			})
+
+			It("pushes the other apps and fails with a summary", func() {
+				callPush("--parallel", "2")
+
+				appGUID, _, _ := actor.UploadAppArgsForCall(0)
+				Expect(appGUID).To(Equal("app1-guid"))
+				Expect(actor.UploadAppCallCount()).To(Equal(1))
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"[app2] ", "create failed"},
+					[]string{"app1", "pushed"},
+					[]string{"app2", "failed"},
+					[]string{"FAILED"},
+					[]string{"1 of 2 apps failed to push"},
+				))
+			})
+		})
+
+		Context("when the apps are started at the same time", func() {
+			var (
+				sharedLogsRepo *logsfakes.FakeLogsRepository
+				startLogsRepos []logs.LogsRepository
+			)
+
+			BeforeEach(func() {
+				sharedLogsRepo = new(logsfakes.FakeLogsRepository)
+				startLogsRepos = nil
+				deps.RepoLocator = deps.RepoLocator.SetLogsRepository(sharedLogsRepo)
+				deps.RepoLocator = deps.RepoLocator.SetLogsRepositoryFactory(func() logs.LogsRepository {
+					return new(logsfakes.FakeLogsRepository)
+				})
+
+				var reposMutex sync.Mutex
+				starter.SetDependencyStub = func(deps commandregistry.Dependency, _ bool) commandregistry.Command {
+					reposMutex.Lock()
+					defer reposMutex.Unlock()
+					startLogsRepos = append(startLogsRepos, deps.RepoLocator.GetLogsRepository())
+					return starter
+				}
+
+				var startsMutex sync.Mutex
+				starts := 0
+				bothStarting := make(chan struct{})
+				starter.ApplicationStartStub = func(app models.Application, _, _ string) (models.Application, error) {
+					startsMutex.Lock()
+					starts++
+					if starts == 2 {
+						close(bothStarting)
+					}
+					startsMutex.Unlock()
+
+					select {
+					case <-bothStarting:
+						return app, nil
+					case <-time.After(5 * time.Second):
+						return app, errors.New("the other app was not started at the same time")
+					}
+				}
+			})
+
+			It("gives every start a logs repository of its own", func() {
+				callPush("--parallel", "2")
+
+				Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"FAILED"}))
+				// the first start is the one of push itself, set up before
+				// the workers are
+				Expect(startLogsRepos).To(HaveLen(3))
+				workerLogsRepos := startLogsRepos[1:]
+				Expect(workerLogsRepos[0]).NotTo(BeIdenticalTo(workerLogsRepos[1]))
+				Expect(workerLogsRepos).NotTo(ContainElement(BeIdenticalTo(sharedLogsRepo)))
+			})
+		})
+
+		It("pushes the apps one after another when --parallel is 1", func() {
+			callPush("--parallel", "1")
+
+			Expect(appRepo.CreateCallCount()).To(Equal(2))
+			Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"[app1] "}))
+		})
+
+		It("fails when --parallel is not a positive number", func() {
+			callPush("--parallel", "0")
+
+			Expect(appRepo.CreateCallCount()).To(Equal(0))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"--parallel must be a positive number"},
+			))
+		})
+	})
 })
 
 func existingAppManifest() *manifest.Manifest {
@@ -1666,3 +2182,19 @@ func manifestWithServicesAndEnv() *manifest.Manifest {
 		}),
 	}
 }
+
+func multipleAppsManifest() *manifest.Manifest {
+	return &manifest.Manifest{
+		Path: "manifest.yml",
+		Data: generic.NewMap(map[interface{}]interface{}{
+			"applications": []interface{}{
+				generic.NewMap(map[interface{}]interface{}{
+					"name": "app1",
+				}),
+				generic.NewMap(map[interface{}]interface{}{
+					"name": "app2",
+				}),
+			},
+		}),
+	}
+}
@@ -1,6 +1,12 @@
 package application
 
 import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry/cli/cf/api/appinstances"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	. "github.com/cloudfoundry/cli/cf/i18n"
@@ -18,11 +24,15 @@ type ApplicationRestarter interface {
 }
 
 type Restart struct {
-	ui      terminal.UI
-	config  coreconfig.Reader
-	starter ApplicationStarter
-	stopper ApplicationStopper
-	appReq  requirements.ApplicationRequirement
+	ui               terminal.UI
+	config           coreconfig.Reader
+	starter          ApplicationStarter
+	stopper          ApplicationStopper
+	appInstancesRepo appinstances.AppInstancesRepository
+	appReq           requirements.ApplicationRequirement
+
+	StartupTimeout time.Duration
+	PingerThrottle time.Duration
 }
 
 func init() {
@@ -30,13 +40,18 @@ func init() {
 }
 
 func (cmd *Restart) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["strategy"] = &flags.StringFlag{Name: "strategy", Usage: T("Restart strategy, 'rolling' restarts the instances in batches to avoid downtime")}
+	fs["batch-size"] = &flags.IntFlag{Name: "batch-size", Usage: T("Number of instances to restart at a time with the rolling strategy (Default: 1)")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "restart",
 		ShortName:   "rs",
 		Description: T("Restart an app"),
 		Usage: []string{
-			T("CF_NAME restart APP_NAME"),
+			T("CF_NAME restart APP_NAME [--strategy rolling [--batch-size NUM_INSTANCES]]"),
 		},
+		Flags: fs,
 	}
 }
 
@@ -45,6 +60,21 @@ func (cmd *Restart) Requirements(requirementsFactory requirements.Factory, fc fl
 		cmd.ui.Failed(T("Incorrect Usage. Requires an argument\n\n") + commandregistry.Commands.CommandUsage("restart"))
 	}
 
+	switch fc.String("strategy") {
+	case "", "rolling":
+	default:
+		cmd.ui.Failed(T("Incorrect Usage. The value of --strategy must be 'rolling'.\n\n") + commandregistry.Commands.CommandUsage("restart"))
+	}
+
+	if fc.IsSet("batch-size") {
+		if fc.String("strategy") != "rolling" {
+			cmd.ui.Failed(T("Incorrect Usage. --batch-size can only be used with --strategy rolling.\n\n") + commandregistry.Commands.CommandUsage("restart"))
+		}
+		if fc.Int("batch-size") < 1 {
+			cmd.ui.Failed(T("Incorrect Usage. The value of --batch-size must be a positive number.\n\n") + commandregistry.Commands.CommandUsage("restart"))
+		}
+	}
+
 	cmd.appReq = requirementsFactory.NewApplicationRequirement(fc.Args()[0])
 
 	reqs := []requirements.Requirement{
@@ -70,11 +100,35 @@ func (cmd *Restart) SetDependency(deps commandregistry.Dependency, pluginCall bo
 	stopper = stopper.SetDependency(deps, false)
 	cmd.stopper = stopper.(ApplicationStopper)
 
+	cmd.appInstancesRepo = deps.RepoLocator.GetAppInstancesRepository()
+
+	cmd.PingerThrottle = DefaultPingerThrottle
+	if os.Getenv("CF_STARTUP_TIMEOUT") != "" {
+		duration, err := strconv.ParseInt(os.Getenv("CF_STARTUP_TIMEOUT"), 10, 64)
+		if err != nil {
+			cmd.ui.Failed(T("invalid value for env var CF_STARTUP_TIMEOUT\n{{.Err}}",
+				map[string]interface{}{"Err": err}))
+		}
+		cmd.StartupTimeout = time.Duration(duration) * time.Minute
+	} else {
+		cmd.StartupTimeout = DefaultStartupTimeout
+	}
+
 	return cmd
 }
 
 func (cmd *Restart) Execute(c flags.FlagContext) {
 	app := cmd.appReq.GetApplication()
+
+	if c.String("strategy") == "rolling" {
+		batchSize := 1
+		if c.IsSet("batch-size") {
+			batchSize = c.Int("batch-size")
+		}
+		cmd.rollingRestart(app, batchSize)
+		return
+	}
+
 	cmd.ApplicationRestart(app, cmd.config.OrganizationFields().Name, cmd.config.SpaceFields().Name)
 }
 
@@ -93,3 +147,96 @@ func (cmd *Restart) ApplicationRestart(app models.Application, orgName, spaceNam
 		return
 	}
 }
+
+// rollingRestart restarts the instances of a running app batchSize at a
+// time, waiting for each batch to be running again before moving on to the
+// next one, so the app keeps serving requests throughout.
+func (cmd *Restart) rollingRestart(app models.Application, batchSize int) {
+	if app.State != "started" {
+		cmd.ui.Failed(T("App {{.AppName}} must be started to restart it with the rolling strategy",
+			map[string]interface{}{"AppName": app.Name}))
+	}
+
+	instances, err := cmd.appInstancesRepo.GetInstances(app.GUID)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	cmd.ui.Say(T("Restarting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}} in batches of {{.BatchSize}}...",
+		map[string]interface{}{
+			"AppName":     terminal.EntityNameColor(app.Name),
+			"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
+			"BatchSize":   batchSize,
+		}))
+	cmd.ui.Say("")
+
+	for first := 0; first < len(instances); first += batchSize {
+		last := first + batchSize
+		if last > len(instances) {
+			last = len(instances)
+		}
+
+		var indexes []string
+		for index := first; index < last; index++ {
+			indexes = append(indexes, strconv.Itoa(index))
+		}
+
+		cmd.ui.Say(T("Restarting instances {{.Instances}}...", map[string]interface{}{"Instances": strings.Join(indexes, ", ")}))
+
+		for index := first; index < last; index++ {
+			err = cmd.appInstancesRepo.DeleteInstance(app.GUID, index)
+			if err != nil {
+				cmd.ui.Failed(err.Error())
+			}
+		}
+
+		cmd.waitForRestartedInstances(app, instances, first, last)
+
+		cmd.ui.Ok()
+		cmd.ui.Say("")
+	}
+
+	cmd.ui.Say(T("App {{.AppName}} restarted", map[string]interface{}{"AppName": terminal.EntityNameColor(app.Name)}))
+}
+
+// waitForRestartedInstances polls the instances first to last until they
+// have all been running since after their restart, and fails as soon as one
+// of them is crashed or flapping.
+func (cmd *Restart) waitForRestartedInstances(app models.Application, previous []models.AppInstanceFields, first, last int) {
+	startTime := time.Now()
+
+	for {
+		instances, err := cmd.appInstancesRepo.GetInstances(app.GUID)
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+		}
+
+		running := 0
+		for index := first; index < last && index < len(instances); index++ {
+			instance := instances[index]
+
+			// an instance crashing on startup may still report the since
+			// of its previous run, so crashes count whatever since is
+			switch {
+			case instance.State == models.InstanceCrashed || instance.State == models.InstanceFlapping:
+				cmd.ui.Failed(T("Instance {{.Instance}} of app {{.AppName}} crashed after restarting, aborting the rolling restart\n{{.Details}}",
+					map[string]interface{}{"Instance": index, "AppName": app.Name, "Details": instance.Details}))
+			case instance.State == models.InstanceRunning && instance.Since.After(previous[index].Since):
+				running++
+			}
+		}
+
+		if running == last-first {
+			return
+		}
+
+		if time.Since(startTime) > cmd.StartupTimeout {
+			cmd.ui.Failed(T("Instances of app {{.AppName}} did not start within {{.Timeout}}, aborting the rolling restart",
+				map[string]interface{}{"AppName": app.Name, "Timeout": cmd.StartupTimeout}))
+		}
+
+		time.Sleep(cmd.PingerThrottle)
+	}
+}
@@ -1,8 +1,12 @@
 package application_test
 
 import (
+	"errors"
 	"os"
+	"time"
 
+	"github.com/cloudfoundry/cli/cf/api/appinstances/appinstancesfakes"
+	"github.com/cloudfoundry/cli/cf/commands/application"
 	"github.com/cloudfoundry/cli/cf/commands/application/applicationfakes"
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/trace/tracefakes"
@@ -29,17 +33,22 @@ var _ = Describe("restart command", func() {
 		originalStop        commandregistry.Command
 		originalStart       commandregistry.Command
 		deps                commandregistry.Dependency
+		appInstancesRepo    *appinstancesfakes.FakeAppInstancesRepository
 	)
 
 	updateCommandDependency := func(pluginCall bool) {
 		deps.UI = ui
 		deps.Config = config
+		deps.RepoLocator = deps.RepoLocator.SetAppInstancesRepository(appInstancesRepo)
 
 		//inject fake 'stopper and starter' into registry
 		commandregistry.Register(starter)
 		commandregistry.Register(stopper)
 
-		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("restart").SetDependency(deps, pluginCall))
+		restart := commandregistry.Commands.FindCommand("restart").SetDependency(deps, pluginCall).(*application.Restart)
+		restart.PingerThrottle = 0
+		restart.StartupTimeout = 100 * time.Millisecond
+		commandregistry.Commands.SetCommand(restart)
 	}
 
 	runCommand := func(args ...string) bool {
@@ -53,6 +62,7 @@ var _ = Describe("restart command", func() {
 		starter = new(applicationfakes.FakeApplicationStarter)
 		stopper = new(applicationfakes.FakeApplicationStopper)
 		config = testconfig.NewRepositoryWithDefaults()
+		appInstancesRepo = new(appinstancesfakes.FakeAppInstancesRepository)
 
 		app = models.Application{}
 		app.Name = "my-app"
@@ -128,4 +138,172 @@ var _ = Describe("restart command", func() {
 			Expect(requirementsFactory.ApplicationName).To(Equal("my-app"))
 		})
 	})
+
+	Context("with the rolling strategy", func() {
+		var (
+			before time.Time
+			after  time.Time
+		)
+
+		BeforeEach(func() {
+			app.State = "started"
+			requirementsFactory.Application = app
+			requirementsFactory.LoginSuccess = true
+			requirementsFactory.TargetedSpaceSuccess = true
+
+			before = time.Unix(1000, 0)
+			after = time.Unix(2000, 0)
+		})
+
+		instancesAt := func(states ...models.InstanceState) []models.AppInstanceFields {
+			instances := make([]models.AppInstanceFields, len(states))
+			for index, state := range states {
+				instances[index] = models.AppInstanceFields{State: state, Since: before}
+			}
+			return instances
+		}
+
+		Context("when the instances start again", func() {
+			BeforeEach(func() {
+				restarted := map[int]bool{}
+				appInstancesRepo.DeleteInstanceStub = func(_ string, index int) error {
+					restarted[index] = true
+					return nil
+				}
+				appInstancesRepo.GetInstancesStub = func(string) ([]models.AppInstanceFields, error) {
+					instances := instancesAt(models.InstanceRunning, models.InstanceRunning, models.InstanceRunning)
+					for index := range instances {
+						if restarted[index] {
+							instances[index].Since = after
+						}
+					}
+					return instances, nil
+				}
+			})
+
+			It("restarts the instances in batches", func() {
+				runCommand("--strategy", "rolling", "--batch-size", "2", "my-app")
+
+				Expect(stopper.ApplicationStopCallCount()).To(BeZero())
+				Expect(appInstancesRepo.DeleteInstanceCallCount()).To(Equal(3))
+				for index := 0; index < 3; index++ {
+					appGUID, instance := appInstancesRepo.DeleteInstanceArgsForCall(index)
+					Expect(appGUID).To(Equal("my-app-guid"))
+					Expect(instance).To(Equal(index))
+				}
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Restarting app", "my-app", "in batches of 2"},
+					[]string{"Restarting instances 0, 1..."},
+					[]string{"OK"},
+					[]string{"Restarting instances 2..."},
+					[]string{"OK"},
+					[]string{"App", "my-app", "restarted"},
+				))
+			})
+
+			It("restarts one instance at a time by default", func() {
+				runCommand("--strategy", "rolling", "my-app")
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Restarting instances 0..."},
+					[]string{"Restarting instances 1..."},
+					[]string{"Restarting instances 2..."},
+				))
+			})
+		})
+
+		Context("when an instance of a batch crashes", func() {
+			BeforeEach(func() {
+				appInstancesRepo.GetInstancesStub = func(string) ([]models.AppInstanceFields, error) {
+					instances := instancesAt(models.InstanceRunning, models.InstanceRunning)
+					if appInstancesRepo.DeleteInstanceCallCount() > 0 {
+						instances[0] = models.AppInstanceFields{State: models.InstanceCrashed, Since: after, Details: "out of memory"}
+					}
+					return instances, nil
+				}
+			})
+
+			It("aborts the rolling restart", func() {
+				runCommand("--strategy", "rolling", "my-app")
+
+				Expect(appInstancesRepo.DeleteInstanceCallCount()).To(Equal(1))
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Instance 0 of app my-app crashed", "aborting the rolling restart"},
+					[]string{"out of memory"},
+				))
+			})
+		})
+
+		Context("when an instance of a batch crashes before its since changes", func() {
+			BeforeEach(func() {
+				appInstancesRepo.GetInstancesStub = func(string) ([]models.AppInstanceFields, error) {
+					instances := instancesAt(models.InstanceRunning, models.InstanceRunning)
+					if appInstancesRepo.DeleteInstanceCallCount() > 0 {
+						instances[0] = models.AppInstanceFields{State: models.InstanceCrashed, Since: before, Details: "exited"}
+					}
+					return instances, nil
+				}
+			})
+
+			It("aborts the rolling restart", func() {
+				runCommand("--strategy", "rolling", "my-app")
+
+				Expect(appInstancesRepo.DeleteInstanceCallCount()).To(Equal(1))
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Instance 0 of app my-app crashed", "aborting the rolling restart"},
+					[]string{"exited"},
+				))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"did not start within"}))
+			})
+		})
+
+		Context("when a batch does not start in time", func() {
+			BeforeEach(func() {
+				appInstancesRepo.GetInstancesReturns(instancesAt(models.InstanceRunning), nil)
+			})
+
+			It("fails", func() {
+				runCommand("--strategy", "rolling", "my-app")
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"did not start within", "aborting the rolling restart"},
+				))
+			})
+		})
+
+		It("fails when the instances cannot be fetched", func() {
+			appInstancesRepo.GetInstancesReturns(nil, errors.New("instances-error"))
+
+			runCommand("--strategy", "rolling", "my-app")
+
+			Expect(appInstancesRepo.DeleteInstanceCallCount()).To(BeZero())
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"instances-error"}))
+		})
+
+		It("fails when the app is not started", func() {
+			app.State = "stopped"
+			requirementsFactory.Application = app
+
+			runCommand("--strategy", "rolling", "my-app")
+
+			Expect(appInstancesRepo.GetInstancesCallCount()).To(BeZero())
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"must be started"}))
+		})
+
+		It("fails with usage when the strategy is unknown", func() {
+			runCommand("--strategy", "sideways", "my-app")
+
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"Incorrect Usage", "--strategy must be 'rolling'"}))
+		})
+
+		It("fails with usage when the batch size is not positive", func() {
+			runCommand("--strategy", "rolling", "--batch-size", "0", "my-app")
+
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"Incorrect Usage", "--batch-size must be a positive number"}))
+		})
+	})
 })
@@ -0,0 +1,244 @@
+package application
+
+import (
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry/cli/cf/api/appevents"
+	"github.com/cloudfoundry/cli/cf/api/appinstances"
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/flags"
+)
+
+const (
+	DefaultWaitTimeout = 5 * time.Minute
+
+	WaitAppExitTimeout = 2
+	WaitAppExitCrashed = 3
+
+	waitStateRunning = "running"
+	waitStateStopped = "stopped"
+)
+
+type WaitApp struct {
+	ui               terminal.UI
+	config           coreconfig.Reader
+	appReq           requirements.ApplicationRequirement
+	appInstancesRepo appinstances.AppInstancesRepository
+	appEventsRepo    appevents.AppEventsRepository
+
+	state     string
+	instances int
+	timeout   time.Duration
+
+	PingerThrottle time.Duration
+}
+
+func init() {
+	commandregistry.Register(&WaitApp{})
+}
+
+func (cmd *WaitApp) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["state"] = &flags.StringFlag{Name: "state", Usage: T("State to wait for: running or stopped (Default: running)")}
+	fs["instances"] = &flags.IntFlag{Name: "instances", Usage: T("Number of instances that must be running (Default: all instances of the app)")}
+	fs["timeout"] = &flags.StringFlag{Name: "timeout", Usage: T("Time to wait, in seconds or as a duration such as 2m (Default: 5m)")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "wait-app",
+		Description: T("Wait until an app reaches a state. Exits with 2 on timeout and 3 when an instance crashes"),
+		Usage: []string{
+			T("CF_NAME wait-app APP_NAME [--state (running | stopped)] [--instances N] [--timeout TIMEOUT]"),
+		},
+		Examples: []string{
+			"CF_NAME wait-app my-app",
+			"CF_NAME wait-app my-app --instances 2 --timeout 90",
+			"CF_NAME wait-app my-app --state stopped",
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *WaitApp) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) []requirements.Requirement {
+	if len(fc.Args()) != 1 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires an argument\n\n") + commandregistry.Commands.CommandUsage("wait-app"))
+	}
+
+	cmd.state = waitStateRunning
+	if fc.IsSet("state") {
+		cmd.state = strings.ToLower(fc.String("state"))
+		if cmd.state != waitStateRunning && cmd.state != waitStateStopped {
+			cmd.ui.Failed(T("Incorrect Usage. --state must be running or stopped\n\n") + commandregistry.Commands.CommandUsage("wait-app"))
+		}
+	}
+
+	cmd.instances = 0
+	if fc.IsSet("instances") {
+		cmd.instances = fc.Int("instances")
+		if cmd.instances < 1 || cmd.state == waitStateStopped {
+			cmd.ui.Failed(T("Incorrect Usage. --instances must be a positive number and cannot be used with --state stopped\n\n") + commandregistry.Commands.CommandUsage("wait-app"))
+		}
+	}
+
+	cmd.timeout = DefaultWaitTimeout
+	if fc.IsSet("timeout") {
+		timeout, err := parseDuration(fc.String("timeout"))
+		if err != nil {
+			cmd.ui.Failed(T("Incorrect Usage. Invalid timeout {{.Timeout}}: {{.Err}}\n\n", map[string]interface{}{"Timeout": fc.String("timeout"), "Err": err.Error()}) + commandregistry.Commands.CommandUsage("wait-app"))
+		}
+		cmd.timeout = timeout
+	}
+
+	cmd.appReq = requirementsFactory.NewApplicationRequirement(fc.Args()[0])
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+		cmd.appReq,
+	}
+
+	return reqs
+}
+
+func (cmd *WaitApp) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.appInstancesRepo = deps.RepoLocator.GetAppInstancesRepository()
+	cmd.appEventsRepo = deps.RepoLocator.GetAppEventsRepository()
+
+	if cmd.PingerThrottle == 0 {
+		cmd.PingerThrottle = DefaultPingerThrottle
+	}
+	return cmd
+}
+
+func (cmd *WaitApp) Execute(c flags.FlagContext) {
+	app := cmd.appReq.GetApplication()
+
+	instances := cmd.instances
+	if instances == 0 {
+		instances = app.InstanceCount
+	}
+
+	if cmd.state == waitStateRunning {
+		cmd.ui.Say(T("Waiting for {{.Instances}} instances of app {{.AppName}} to be running in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"Instances": instances,
+				"AppName":   terminal.EntityNameColor(app.Name),
+				"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+				"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+				"Username":  terminal.EntityNameColor(cmd.config.Username())}))
+	} else {
+		cmd.ui.Say(T("Waiting for app {{.AppName}} to be stopped in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppName":   terminal.EntityNameColor(app.Name),
+				"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+				"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+				"Username":  terminal.EntityNameColor(cmd.config.Username())}))
+	}
+
+	startTime := time.Now()
+	for {
+		done, crashed := cmd.checkInstances(app, instances)
+		if crashed != nil {
+			cmd.reportCrash(app, crashed)
+			panic(terminal.QuietExit(WaitAppExitCrashed))
+		}
+		if done {
+			break
+		}
+
+		if time.Since(startTime) >= cmd.timeout {
+			cmd.ui.Say(terminal.FailureColor(T("FAILED")))
+			cmd.ui.Say(T("Timed out after {{.Timeout}} waiting for app {{.AppName}} to be {{.State}}",
+				map[string]interface{}{
+					"Timeout": cmd.timeout,
+					"AppName": app.Name,
+					"State":   cmd.state}))
+			panic(terminal.QuietExit(WaitAppExitTimeout))
+		}
+
+		time.Sleep(cmd.PingerThrottle)
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say(T("App {{.AppName}} is {{.State}}",
+		map[string]interface{}{
+			"AppName": terminal.EntityNameColor(app.Name),
+			"State":   cmd.state}))
+}
+
+// checkInstances reports whether the app is in the wanted state, or the
+// first crashed instance when waiting for it to run.
+func (cmd *WaitApp) checkInstances(app models.Application, wanted int) (bool, *models.AppInstanceFields) {
+	instances, err := cmd.appInstancesRepo.GetInstances(app.GUID)
+	if err != nil {
+		if !isStoppedError(err) {
+			cmd.ui.Failed(err.Error())
+		}
+		return cmd.state == waitStateStopped, nil
+	}
+
+	if cmd.state == waitStateStopped {
+		return len(instances) == 0, nil
+	}
+
+	running, starting := 0, 0
+	for i, instance := range instances {
+		switch instance.State {
+		case models.InstanceRunning:
+			running++
+		case models.InstanceStarting:
+			starting++
+		case models.InstanceCrashed, models.InstanceFlapping:
+			return false, &instances[i]
+		}
+	}
+
+	cmd.ui.Say(T("{{.Running}} of {{.Total}} instances running, {{.Starting}} starting",
+		map[string]interface{}{
+			"Running":  running,
+			"Total":    wanted,
+			"Starting": starting}))
+
+	return running >= wanted, nil
+}
+
+func (cmd *WaitApp) reportCrash(app models.Application, instance *models.AppInstanceFields) {
+	cmd.ui.Say(terminal.FailureColor(T("FAILED")))
+	cmd.ui.Say(T("An instance of app {{.AppName}} crashed: {{.Details}}",
+		map[string]interface{}{
+			"AppName": app.Name,
+			"Details": instance.Details}))
+
+	events, err := cmd.appEventsRepo.RecentEvents(app.GUID, 10)
+	if err != nil {
+		cmd.ui.Warn(T("Could not get recent events: {{.Err}}", map[string]interface{}{"Err": err.Error()}))
+		return
+	}
+
+	crashes := []models.EventFields{}
+	for _, event := range events {
+		if strings.Contains(event.Name, "crash") {
+			crashes = append(crashes, event)
+		}
+	}
+
+	if len(crashes) == 0 {
+		return
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.Say(T("Recent crash events:"))
+
+	table := cmd.ui.Table([]string{T("time"), T("event"), T("description")})
+	for _, event := range crashes {
+		table.Add(event.Timestamp.Local().Format("2006-01-02T15:04:05.00-0700"), event.Name, event.Description)
+	}
+	table.Print()
+}
@@ -0,0 +1,236 @@
+package application_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/cloudfoundry/cli/cf/api"
+	"github.com/cloudfoundry/cli/cf/api/appevents/appeventsfakes"
+	"github.com/cloudfoundry/cli/cf/api/appinstances/appinstancesfakes"
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/commands/application"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig/coreconfigfakes"
+	cferrors "github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/requirements/requirementsfakes"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/flags"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("wait-app command", func() {
+	var (
+		ui               *testterm.FakeUI
+		config           *coreconfigfakes.FakeRepository
+		appInstancesRepo *appinstancesfakes.FakeAppInstancesRepository
+		appEventsRepo    *appeventsfakes.FakeAppEventsRepository
+		deps             commandregistry.Dependency
+		flagContext      flags.FlagContext
+
+		reqFactory             *requirementsfakes.FakeFactory
+		applicationRequirement *requirementsfakes.FakeApplicationRequirement
+
+		cmd *application.WaitApp
+	)
+
+	BeforeEach(func() {
+		cmd = &application.WaitApp{PingerThrottle: time.Millisecond}
+
+		ui = new(testterm.FakeUI)
+		config = new(coreconfigfakes.FakeRepository)
+		config.OrganizationFieldsReturns(models.OrganizationFields{Name: "my-org"})
+		config.SpaceFieldsReturns(models.SpaceFields{Name: "my-space"})
+		config.UsernameReturns("my-user")
+
+		appInstancesRepo = new(appinstancesfakes.FakeAppInstancesRepository)
+		appEventsRepo = new(appeventsfakes.FakeAppEventsRepository)
+
+		deps = commandregistry.Dependency{
+			UI:     ui,
+			Config: config,
+			RepoLocator: api.RepositoryLocator{}.
+				SetAppInstancesRepository(appInstancesRepo).
+				SetAppEventsRepository(appEventsRepo),
+		}
+		cmd.SetDependency(deps, false)
+
+		flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+
+		reqFactory = new(requirementsfakes.FakeFactory)
+		reqFactory.NewLoginRequirementReturns(&passingRequirement{Name: "login-requirement"})
+		reqFactory.NewTargetedSpaceRequirementReturns(&passingRequirement{Name: "targeted-space-requirement"})
+		applicationRequirement = new(requirementsfakes.FakeApplicationRequirement)
+		applicationRequirement.GetApplicationReturns(models.Application{
+			ApplicationFields: models.ApplicationFields{Name: "my-app", GUID: "my-app-guid", InstanceCount: 2},
+		})
+		reqFactory.NewApplicationRequirementReturns(applicationRequirement)
+	})
+
+	runCommand := func(args ...string) {
+		Expect(flagContext.Parse(args...)).To(Succeed())
+		cmd.Requirements(reqFactory, flagContext)
+		cmd.Execute(flagContext)
+	}
+
+	exitCodeOf := func(args ...string) (code terminal.QuietExit) {
+		defer func() {
+			code, _ = recover().(terminal.QuietExit)
+		}()
+		runCommand(args...)
+		return 0
+	}
+
+	instancesInStates := func(states ...models.InstanceState) []models.AppInstanceFields {
+		instances := []models.AppInstanceFields{}
+		for _, state := range states {
+			instances = append(instances, models.AppInstanceFields{State: state, Details: "exited with status 1"})
+		}
+		return instances
+	}
+
+	Describe("Requirements", func() {
+		It("fails with usage when not provided exactly one argument", func() {
+			Expect(flagContext.Parse("too", "many")).To(Succeed())
+			Expect(func() { cmd.Requirements(reqFactory, flagContext) }).To(Panic())
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Incorrect Usage. Requires an argument"},
+			))
+		})
+
+		It("fails with usage when the state is unknown", func() {
+			Expect(flagContext.Parse("my-app", "--state", "crashed")).To(Succeed())
+			Expect(func() { cmd.Requirements(reqFactory, flagContext) }).To(Panic())
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Incorrect Usage. --state must be running or stopped"},
+			))
+		})
+
+		It("fails with usage when the timeout is invalid", func() {
+			Expect(flagContext.Parse("my-app", "--timeout", "later")).To(Succeed())
+			Expect(func() { cmd.Requirements(reqFactory, flagContext) }).To(Panic())
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Incorrect Usage. Invalid timeout later"},
+			))
+		})
+
+		It("returns login, targeted space and application requirements", func() {
+			Expect(flagContext.Parse("my-app")).To(Succeed())
+			reqs := cmd.Requirements(reqFactory, flagContext)
+			Expect(reqs).To(HaveLen(3))
+			Expect(reqFactory.NewApplicationRequirementArgsForCall(0)).To(Equal("my-app"))
+		})
+	})
+
+	Describe("waiting for the app to run", func() {
+		It("succeeds once all instances are running", func() {
+			responses := [][]models.AppInstanceFields{
+				instancesInStates(models.InstanceStarting, models.InstanceStarting),
+				instancesInStates(models.InstanceRunning, models.InstanceStarting),
+				instancesInStates(models.InstanceRunning, models.InstanceRunning),
+			}
+			appInstancesRepo.GetInstancesStub = func(string) ([]models.AppInstanceFields, error) {
+				response := responses[0]
+				if len(responses) > 1 {
+					responses = responses[1:]
+				}
+				return response, nil
+			}
+
+			runCommand("my-app")
+
+			Expect(appInstancesRepo.GetInstancesCallCount()).To(Equal(3))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Waiting for 2 instances of app my-app to be running in org my-org / space my-space as my-user..."},
+				[]string{"0 of 2 instances running, 2 starting"},
+				[]string{"1 of 2 instances running, 1 starting"},
+				[]string{"2 of 2 instances running, 0 starting"},
+				[]string{"OK"},
+				[]string{"App my-app is running"},
+			))
+		})
+
+		It("only waits for the requested number of instances", func() {
+			appInstancesRepo.GetInstancesReturns(instancesInStates(models.InstanceRunning, models.InstanceStarting), nil)
+
+			runCommand("my-app", "--instances", "1")
+
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"App my-app is running"}))
+		})
+
+		It("exits with the timeout code when the app does not start in time", func() {
+			appInstancesRepo.GetInstancesReturns(instancesInStates(models.InstanceStarting, models.InstanceStarting), nil)
+
+			Expect(exitCodeOf("my-app", "--timeout", "10ms")).To(Equal(terminal.QuietExit(application.WaitAppExitTimeout)))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Timed out after 10ms waiting for app my-app to be running"},
+			))
+		})
+
+		It("exits with the crash code and shows recent crash events when an instance crashes", func() {
+			appInstancesRepo.GetInstancesReturns(instancesInStates(models.InstanceRunning, models.InstanceCrashed), nil)
+			appEventsRepo.RecentEventsReturns([]models.EventFields{
+				{Name: "audit.app.update", Description: "instances: 2"},
+				{Name: "app.crash", Description: "index: 1, reason: CRASHED, exit_description: out of memory"},
+			}, nil)
+
+			Expect(exitCodeOf("my-app")).To(Equal(terminal.QuietExit(application.WaitAppExitCrashed)))
+			guid, _ := appEventsRepo.RecentEventsArgsForCall(0)
+			Expect(guid).To(Equal("my-app-guid"))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"An instance of app my-app crashed: exited with status 1"},
+				[]string{"Recent crash events:"},
+				[]string{"app.crash", "out of memory"},
+			))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"audit.app.update"}))
+		})
+
+		It("keeps waiting while the app is not staged", func() {
+			calls := 0
+			appInstancesRepo.GetInstancesStub = func(string) ([]models.AppInstanceFields, error) {
+				calls++
+				if calls == 1 {
+					return nil, cferrors.NewHTTPError(400, cferrors.NotStaged, "not staged")
+				}
+				return instancesInStates(models.InstanceRunning, models.InstanceRunning), nil
+			}
+
+			runCommand("my-app")
+
+			Expect(calls).To(Equal(2))
+		})
+
+		It("fails when getting the instances fails", func() {
+			appInstancesRepo.GetInstancesReturns(nil, errors.New("api down"))
+
+			Expect(func() { runCommand("my-app") }).To(Panic())
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"api down"}))
+		})
+	})
+
+	Describe("waiting for the app to stop", func() {
+		It("succeeds once the app has no instances", func() {
+			calls := 0
+			appInstancesRepo.GetInstancesStub = func(string) ([]models.AppInstanceFields, error) {
+				calls++
+				if calls == 1 {
+					return instancesInStates(models.InstanceRunning), nil
+				}
+				return nil, cferrors.NewHTTPError(400, cferrors.InstancesError, "app stopped")
+			}
+
+			runCommand("my-app", "--state", "stopped")
+
+			Expect(calls).To(Equal(2))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Waiting for app my-app to be stopped"},
+				[]string{"App my-app is stopped"},
+			))
+		})
+	})
+})
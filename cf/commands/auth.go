@@ -22,17 +22,22 @@ func init() {
 }
 
 func (cmd *Authenticate) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["client-credentials"] = &flags.BoolFlag{Name: "client-credentials", Usage: T("Authenticate as a client with the client_credentials grant, instead of as a user")}
+
 	return commandregistry.CommandMetadata{
 		Name:        "auth",
 		Description: T("Authenticate user non-interactively"),
 		Usage: []string{
-			T("CF_NAME auth USERNAME PASSWORD\n\n"),
+			T("CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n\n"),
 			terminal.WarningColor(T("WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history")),
 		},
 		Examples: []string{
 			T("CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)"),
 			T("CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"),
+			T("CF_NAME auth my-client my-client-secret --client-credentials (authenticate as the client my-client)"),
 		},
+		Flags: fs,
 	}
 }
 
@@ -63,7 +68,12 @@ func (cmd *Authenticate) Execute(c flags.FlagContext) {
 		map[string]interface{}{"APIEndpoint": terminal.EntityNameColor(cmd.config.APIEndpoint())}))
 	cmd.ui.Say(T("Authenticating..."))
 
-	apiErr := cmd.authenticator.Authenticate(map[string]string{"username": c.Args()[0], "password": c.Args()[1]})
+	var apiErr error
+	if c.Bool("client-credentials") {
+		apiErr = cmd.authenticator.AuthenticateClientCredentials(c.Args()[0], c.Args()[1])
+	} else {
+		apiErr = cmd.authenticator.Authenticate(map[string]string{"username": c.Args()[0], "password": c.Args()[1]})
+	}
 	if apiErr != nil {
 		cmd.ui.Failed(apiErr.Error())
 		return
@@ -107,6 +107,18 @@ var _ = Describe("auth command", func() {
 			Expect(authRepo.GetLoginPromptsAndSaveUAAServerURLCallCount()).To(Equal(1))
 		})
 
+		It("authenticates as a client with --client-credentials", func() {
+			testcmd.RunCLICommand("auth", []string{"my-client", "my-client-secret", "--client-credentials"}, requirementsFactory, updateCommandDependency, false)
+
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"OK"}))
+			Expect(authRepo.AuthenticateCallCount()).To(BeZero())
+			Expect(authRepo.AuthenticateClientCredentialsCallCount()).To(Equal(1))
+
+			clientID, clientSecret := authRepo.AuthenticateClientCredentialsArgsForCall(0)
+			Expect(clientID).To(Equal("my-client"))
+			Expect(clientSecret).To(Equal("my-client-secret"))
+		})
+
 		Describe("when authentication fails", func() {
 			BeforeEach(func() {
 				authRepo.AuthenticateReturns(errors.New("Error authenticating."))
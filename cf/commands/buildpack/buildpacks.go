@@ -13,6 +13,17 @@ import (
 	"github.com/cloudfoundry/cli/cf/terminal"
 )
 
+// buildpackRecord is the machine readable form of a buildpack printed by
+// the buildpacks command.
+type buildpackRecord struct {
+	Name     string `json:"name" yaml:"name"`
+	GUID     string `json:"guid" yaml:"guid"`
+	Position *int   `json:"position" yaml:"position"`
+	Enabled  *bool  `json:"enabled" yaml:"enabled"`
+	Locked   *bool  `json:"locked" yaml:"locked"`
+	Filename string `json:"filename" yaml:"filename"`
+}
+
 type ListBuildpacks struct {
 	ui            terminal.UI
 	buildpackRepo api.BuildpackRepository
@@ -29,6 +40,7 @@ func (cmd *ListBuildpacks) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			T("CF_NAME buildpacks"),
 		},
+		Records: true,
 	}
 }
 
@@ -59,8 +71,18 @@ func (cmd *ListBuildpacks) Execute(c flags.FlagContext) {
 
 	table := cmd.ui.Table([]string{"buildpack", T("position"), T("enabled"), T("locked"), T("filename")})
 	noBuildpacks := true
+	records := []buildpackRecord{}
 
 	apiErr := cmd.buildpackRepo.ListBuildpacks(func(buildpack models.Buildpack) bool {
+		records = append(records, buildpackRecord{
+			Name:     buildpack.Name,
+			GUID:     buildpack.GUID,
+			Position: buildpack.Position,
+			Enabled:  buildpack.Enabled,
+			Locked:   buildpack.Locked,
+			Filename: buildpack.Filename,
+		})
+
 		position := ""
 		if buildpack.Position != nil {
 			position = strconv.Itoa(*buildpack.Position)
@@ -83,12 +105,19 @@ func (cmd *ListBuildpacks) Execute(c flags.FlagContext) {
 		noBuildpacks = false
 		return true
 	})
-	table.Print()
 
 	if apiErr != nil {
+		table.Print()
 		cmd.ui.Failed(T("Failed fetching buildpacks.\n{{.Error}}", map[string]interface{}{"Error": apiErr.Error()}))
 	}
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		cmd.ui.PrintStructured(records)
+		return
+	}
+
+	table.Print()
+
 	if noBuildpacks {
 		cmd.ui.Say(T("No buildpacks found"))
 	}
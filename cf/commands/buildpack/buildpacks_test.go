@@ -1,9 +1,12 @@
 package buildpack_test
 
 import (
+	"encoding/json"
+
 	"github.com/cloudfoundry/cli/cf/api/apifakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
@@ -94,6 +97,29 @@ var _ = Describe("ListBuildpacks", func() {
 			))
 		})
 
+		It("prints the buildpacks as records with --output json", func() {
+			p1 := 5
+			t := true
+			f := false
+
+			buildpackRepo.Buildpacks = []models.Buildpack{
+				{Name: "Buildpack-1", GUID: "buildpack-1-guid", Position: &p1, Enabled: &t, Locked: &f, Filename: "bp1.zip"},
+				{Name: "Buildpack-2", GUID: "buildpack-2-guid"},
+			}
+			ui.Format = terminal.JSONOutput
+
+			runCommand()
+
+			Expect(ui.StructuredOutputs).To(HaveLen(1))
+			encoded, err := json.Marshal(ui.StructuredOutputs[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encoded).To(MatchJSON(`[
+				{"name": "Buildpack-1", "guid": "buildpack-1-guid", "position": 5, "enabled": true, "locked": false, "filename": "bp1.zip"},
+				{"name": "Buildpack-2", "guid": "buildpack-2-guid", "position": null, "enabled": null, "locked": null, "filename": ""}
+			]`))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"buildpack", "position", "enabled"}))
+		})
+
 		It("tells the user if no build packs exist", func() {
 			runCommand()
 			Expect(ui.Outputs).To(ContainSubstrings(
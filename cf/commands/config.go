@@ -25,6 +25,8 @@ func (cmd *ConfigCommands) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["async-timeout"] = &flags.IntFlag{Name: "async-timeout", Usage: T("Timeout for async HTTP requests")}
 	fs["trace"] = &flags.StringFlag{Name: "trace", Usage: T("Trace HTTP requests")}
+	fs["retries"] = &flags.IntFlag{Name: "retries", Usage: T("Number of times to retry HTTP requests that are safe to repeat after a connection error or a 502, 503 or 504 response")}
+	fs["http-cache-ttl"] = &flags.IntFlag{Name: "http-cache-ttl", Usage: T("Number of seconds to cache lookups of orgs, spaces, domains and stacks by name on disk, 0 disables the cache")}
 	fs["color"] = &flags.StringFlag{Name: "color", Usage: T("Enable or disable color")}
 	fs["locale"] = &flags.StringFlag{Name: "locale", Usage: T("Set default locale. If LOCALE is 'CLEAR', previous locale is deleted.")}
 
@@ -32,7 +34,7 @@ func (cmd *ConfigCommands) MetaData() commandregistry.CommandMetadata {
 		Name:        "config",
 		Description: T("Write default values to the config"),
 		Usage: []string{
-			T("CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"),
+			T("CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--retries RETRIES] [--http-cache-ttl SECONDS] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"),
 		},
 		Flags: fs,
 	}
@@ -50,7 +52,7 @@ func (cmd *ConfigCommands) SetDependency(deps commandregistry.Dependency, plugin
 }
 
 func (cmd *ConfigCommands) Execute(context flags.FlagContext) {
-	if !context.IsSet("trace") && !context.IsSet("async-timeout") && !context.IsSet("color") && !context.IsSet("locale") {
+	if !context.IsSet("trace") && !context.IsSet("async-timeout") && !context.IsSet("retries") && !context.IsSet("http-cache-ttl") && !context.IsSet("color") && !context.IsSet("locale") {
 		cmd.ui.Failed(T("Incorrect Usage") + "\n\n" + commandregistry.Commands.CommandUsage("config"))
 		return
 	}
@@ -64,6 +66,24 @@ func (cmd *ConfigCommands) Execute(context flags.FlagContext) {
 		cmd.config.SetAsyncTimeout(uint(asyncTimeout))
 	}
 
+	if context.IsSet("retries") {
+		retries := context.Int("retries")
+		if retries < 0 {
+			cmd.ui.Failed(T("Incorrect Usage") + "\n\n" + commandregistry.Commands.CommandUsage("config"))
+		}
+
+		cmd.config.SetRequestRetries(uint(retries))
+	}
+
+	if context.IsSet("http-cache-ttl") {
+		ttl := context.Int("http-cache-ttl")
+		if ttl < 0 {
+			cmd.ui.Failed(T("Incorrect Usage") + "\n\n" + commandregistry.Commands.CommandUsage("config"))
+		}
+
+		cmd.config.SetHTTPCacheTTL(uint(ttl))
+	}
+
 	if context.IsSet("trace") {
 		cmd.config.SetTrace(context.String("trace"))
 	}
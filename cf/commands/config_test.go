@@ -66,6 +66,42 @@ var _ = Describe("config command", func() {
 		})
 	})
 
+	Context("--retries flag", func() {
+		It("stores the number of retries when the --retries flag is provided", func() {
+			runCommand("--retries", "3")
+			Expect(configRepo.RequestRetries()).Should(Equal(uint(3)))
+
+			runCommand("--retries", "0")
+			Expect(configRepo.RequestRetries()).Should(Equal(uint(0)))
+		})
+
+		It("fails with usage when a negative number of retries is passed", func() {
+			runCommand("--retries", "-2")
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Incorrect Usage"},
+			))
+			Expect(configRepo.RequestRetries()).To(Equal(uint(0)))
+		})
+	})
+
+	Context("--http-cache-ttl flag", func() {
+		It("stores the cache TTL when the --http-cache-ttl flag is provided", func() {
+			runCommand("--http-cache-ttl", "30")
+			Expect(configRepo.HTTPCacheTTL()).Should(Equal(uint(30)))
+
+			runCommand("--http-cache-ttl", "0")
+			Expect(configRepo.HTTPCacheTTL()).Should(Equal(uint(0)))
+		})
+
+		It("fails with usage when a negative TTL is passed", func() {
+			runCommand("--http-cache-ttl", "-1")
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Incorrect Usage"},
+			))
+			Expect(configRepo.HTTPCacheTTL()).To(Equal(uint(0)))
+		})
+	})
+
 	Context("--trace flag", func() {
 		It("stores the trace value when --trace flag is provided", func() {
 			runCommand("--trace", "true")
@@ -394,23 +394,41 @@ var _ = Describe("CreateAppManifest", func() {
 							Domain: models.DomainFields{
 								Name: "domain-2-name",
 							},
+							Path: "/path",
+						},
+						{
+							Domain: models.DomainFields{
+								Name: "tcp-domain-name",
+							},
+							Port: 1234,
 						},
 					}
 				})
 
-				It("sets the domains", func() {
+				It("sets the routes", func() {
 					cmd.Execute(flagContext)
-					Expect(fakeManifest.DomainCallCount()).To(Equal(2))
+					Expect(fakeManifest.RouteCallCount()).To(Equal(3))
 
-					name, host, domainName := fakeManifest.DomainArgsForCall(0)
+					name, host, domainName, path, port := fakeManifest.RouteArgsForCall(0)
 					Expect(name).To(Equal("app-name"))
 					Expect(host).To(Equal("route-1-host"))
 					Expect(domainName).To(Equal("domain-1-name"))
+					Expect(path).To(BeEmpty())
+					Expect(port).To(BeZero())
 
-					name, host, domainName = fakeManifest.DomainArgsForCall(1)
+					name, host, domainName, path, port = fakeManifest.RouteArgsForCall(1)
 					Expect(name).To(Equal("app-name"))
 					Expect(host).To(Equal("route-2-host"))
 					Expect(domainName).To(Equal("domain-2-name"))
+					Expect(path).To(Equal("/path"))
+					Expect(port).To(BeZero())
+
+					name, host, domainName, path, port = fakeManifest.RouteArgsForCall(2)
+					Expect(name).To(Equal("app-name"))
+					Expect(host).To(BeEmpty())
+					Expect(domainName).To(Equal("tcp-domain-name"))
+					Expect(path).To(BeEmpty())
+					Expect(port).To(Equal(1234))
 				})
 			})
 
@@ -11,6 +11,15 @@ import (
 	"github.com/cloudfoundry/cli/flags"
 )
 
+// domainRecord is the machine readable form of a domain printed by the
+// domains command.
+type domainRecord struct {
+	Name            string `json:"name" yaml:"name"`
+	GUID            string `json:"guid" yaml:"guid"`
+	Shared          bool   `json:"shared" yaml:"shared"`
+	RouterGroupType string `json:"router_group_type,omitempty" yaml:"router_group_type,omitempty"`
+}
+
 type ListDomains struct {
 	ui             terminal.UI
 	config         coreconfig.Reader
@@ -29,6 +38,7 @@ func (cmd *ListDomains) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			"CF_NAME domains",
 		},
+		Records: true,
 	}
 }
 
@@ -71,6 +81,20 @@ func (cmd *ListDomains) Execute(c flags.FlagContext) {
 		cmd.ui.Failed(T("Failed fetching domains.\n{{.Error}}", map[string]interface{}{"Error": err.Error()}))
 	}
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		records := make([]domainRecord, 0, len(domains))
+		for _, domain := range domains {
+			records = append(records, domainRecord{
+				Name:            domain.Name,
+				GUID:            domain.GUID,
+				Shared:          domain.Shared,
+				RouterGroupType: domain.RouterGroupType,
+			})
+		}
+		cmd.ui.PrintStructured(records)
+		return
+	}
+
 	table := cmd.ui.Table([]string{T("name"), T("status"), T("type")})
 
 	for _, domain := range domains {
@@ -1,6 +1,7 @@
 package domain_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/cloudfoundry/cli/cf/commandregistry"
@@ -8,6 +9,7 @@ import (
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/requirements/requirementsfakes"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 
 	"github.com/cloudfoundry/cli/cf/api/apifakes"
@@ -214,6 +216,22 @@ var _ = Describe("ListDomains", func() {
 					[]string{"Private-domain2", "owned", "tcp"},
 				))
 			})
+
+			It("prints the domains as records with --output json", func() {
+				ui.Format = terminal.JSONOutput
+				cmd.Execute(flagContext)
+
+				Expect(ui.StructuredOutputs).To(HaveLen(1))
+				encoded, err := json.Marshal(ui.StructuredOutputs[0])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(encoded).To(MatchJSON(`[
+					{"name": "Private-domain1", "guid": "", "shared": false},
+					{"name": "Private-domain2", "guid": "", "shared": false, "router_group_type": "tcp"},
+					{"name": "Shared-domain1", "guid": "", "shared": true},
+					{"name": "Shared-domain2", "guid": "", "shared": true, "router_group_type": "foobar"}
+				]`))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"name", "status", "type"}))
+			})
 		})
 	})
 })
@@ -5,11 +5,27 @@ import (
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 )
 
+// environmentVariableRecord is the machine readable form of a variable
+// printed by the running- and staging-environment-variable-group commands.
+type environmentVariableRecord struct {
+	Name  string `json:"name" yaml:"name"`
+	Value string `json:"value" yaml:"value"`
+}
+
+func newEnvironmentVariableRecords(envVars []models.EnvironmentVariable) []environmentVariableRecord {
+	records := make([]environmentVariableRecord, 0, len(envVars))
+	for _, envVar := range envVars {
+		records = append(records, environmentVariableRecord{Name: envVar.Name, Value: envVar.Value})
+	}
+	return records
+}
+
 type RunningEnvironmentVariableGroup struct {
 	ui                           terminal.UI
 	config                       coreconfig.ReadWriter
@@ -28,6 +44,7 @@ func (cmd *RunningEnvironmentVariableGroup) MetaData() commandregistry.CommandMe
 		Usage: []string{
 			T("CF_NAME running-environment-variable-group"),
 		},
+		Records: true,
 	}
 }
 
@@ -62,6 +79,11 @@ func (cmd *RunningEnvironmentVariableGroup) Execute(c flags.FlagContext) {
 		cmd.ui.Failed(err.Error())
 	}
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		cmd.ui.PrintStructured(newEnvironmentVariableRecords(runningEnvVars))
+		return
+	}
+
 	cmd.ui.Ok()
 
 	table := cmd.ui.Table([]string{T("Variable Name"), T("Assigned Value")})
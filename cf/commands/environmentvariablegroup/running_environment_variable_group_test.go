@@ -1,11 +1,14 @@
 package environmentvariablegroup_test
 
 import (
+	"encoding/json"
+
 	"github.com/cloudfoundry/cli/cf/api/environmentvariablegroups/environmentvariablegroupsfakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/commands/environmentvariablegroup"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
@@ -93,5 +96,20 @@ var _ = Describe("running-environment-variable-group command", func() {
 				[]string{"def", "456"},
 			))
 		})
+
+		It("prints the running environment variable group as records with --output json", func() {
+			ui.Format = terminal.JSONOutput
+
+			runCommand()
+
+			Expect(ui.StructuredOutputs).To(HaveLen(1))
+			encoded, err := json.Marshal(ui.StructuredOutputs[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encoded).To(MatchJSON(`[
+				{"name": "abc", "value": "123"},
+				{"name": "def", "value": "456"}
+			]`))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Variable Name", "Assigned Value"}))
+		})
 	})
 })
@@ -28,6 +28,7 @@ func (cmd *StagingEnvironmentVariableGroup) MetaData() commandregistry.CommandMe
 		Usage: []string{
 			T("CF_NAME staging-environment-variable-group"),
 		},
+		Records: true,
 	}
 }
 
@@ -62,6 +63,11 @@ func (cmd *StagingEnvironmentVariableGroup) Execute(c flags.FlagContext) {
 		cmd.ui.Failed(err.Error())
 	}
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		cmd.ui.PrintStructured(newEnvironmentVariableRecords(stagingEnvVars))
+		return
+	}
+
 	cmd.ui.Ok()
 
 	table := cmd.ui.Table([]string{T("Variable Name"), T("Assigned Value")})
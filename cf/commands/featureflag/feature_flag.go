@@ -27,6 +27,7 @@ func (cmd *ShowFeatureFlag) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			T("CF_NAME feature-flag FEATURE_NAME"),
 		},
+		Records: true,
 	}
 }
 
@@ -62,6 +63,11 @@ func (cmd *ShowFeatureFlag) Execute(c flags.FlagContext) {
 		return
 	}
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		cmd.ui.PrintStructured(newFeatureFlagRecord(flag))
+		return
+	}
+
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
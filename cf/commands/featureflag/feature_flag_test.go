@@ -1,12 +1,14 @@
 package featureflag_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/cloudfoundry/cli/cf/api/featureflags/featureflagsfakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
 	. "github.com/cloudfoundry/cli/testhelpers/matchers"
@@ -73,6 +75,18 @@ var _ = Describe("feature-flag command", func() {
 			))
 		})
 
+		It("prints the feature flag as a record with --output json", func() {
+			ui.Format = terminal.JSONOutput
+
+			runCommand("route_creation")
+
+			Expect(ui.StructuredOutputs).To(HaveLen(1))
+			encoded, err := json.Marshal(ui.StructuredOutputs[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encoded).To(MatchJSON(`{"name": "route_creation", "enabled": false}`))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Feature", "State"}))
+		})
+
 		Context("when an error occurs", func() {
 			BeforeEach(func() {
 				flagRepo.FindByNameReturns(models.FeatureFlag{}, errors.New("An error occurred."))
@@ -5,11 +5,23 @@ import (
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 )
 
+// featureFlagRecord is the machine readable form of a feature flag
+// printed by the feature-flags and feature-flag commands.
+type featureFlagRecord struct {
+	Name    string `json:"name" yaml:"name"`
+	Enabled bool   `json:"enabled" yaml:"enabled"`
+}
+
+func newFeatureFlagRecord(flag models.FeatureFlag) featureFlagRecord {
+	return featureFlagRecord{Name: flag.Name, Enabled: flag.Enabled}
+}
+
 type ListFeatureFlags struct {
 	ui       terminal.UI
 	config   coreconfig.ReadWriter
@@ -27,6 +39,7 @@ func (cmd *ListFeatureFlags) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			T("CF_NAME feature-flags"),
 		},
+		Records: true,
 	}
 }
 
@@ -63,6 +76,15 @@ func (cmd *ListFeatureFlags) Execute(c flags.FlagContext) {
 		return
 	}
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		records := make([]featureFlagRecord, 0, len(flags))
+		for _, flag := range flags {
+			records = append(records, newFeatureFlagRecord(flag))
+		}
+		cmd.ui.PrintStructured(records)
+		return
+	}
+
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
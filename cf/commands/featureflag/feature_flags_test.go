@@ -1,6 +1,7 @@
 package featureflag_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/cloudfoundry/cli/cf/api/featureflags/featureflagsfakes"
@@ -8,6 +9,7 @@ import (
 	"github.com/cloudfoundry/cli/cf/commands/featureflag"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
@@ -115,6 +117,24 @@ var _ = Describe("feature-flags command", func() {
 			))
 		})
 
+		It("prints the feature flags as records with --output json", func() {
+			ui.Format = terminal.JSONOutput
+
+			runCommand()
+
+			Expect(ui.StructuredOutputs).To(HaveLen(1))
+			encoded, err := json.Marshal(ui.StructuredOutputs[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encoded).To(MatchJSON(`[
+				{"name": "user_org_creation", "enabled": true},
+				{"name": "private_domain_creation", "enabled": false},
+				{"name": "app_bits_upload", "enabled": true},
+				{"name": "app_scaling", "enabled": true},
+				{"name": "route_creation", "enabled": false}
+			]`))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Feature", "State"}))
+		})
+
 		Context("when an error occurs", func() {
 			BeforeEach(func() {
 				flagRepo.ListReturns(nil, errors.New("An error occurred."))
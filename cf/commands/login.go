@@ -40,6 +40,8 @@ func (cmd *Login) MetaData() commandregistry.CommandMetadata {
 	fs["o"] = &flags.StringFlag{ShortName: "o", Usage: T("Org")}
 	fs["s"] = &flags.StringFlag{ShortName: "s", Usage: T("Space")}
 	fs["sso"] = &flags.BoolFlag{Name: "sso", Usage: T("Use a one-time password to login")}
+	fs["client-id"] = &flags.StringFlag{Name: "client-id", Usage: T("Client ID to login as a client with the client_credentials grant, instead of as a user")}
+	fs["client-secret"] = &flags.StringFlag{Name: "client-secret", Usage: T("Client secret, prompted for when not given")}
 	fs["skip-ssl-validation"] = &flags.BoolFlag{Name: "skip-ssl-validation", Usage: T("Skip verification of the API endpoint. Not recommended!")}
 
 	return commandregistry.CommandMetadata{
@@ -47,7 +49,7 @@ func (cmd *Login) MetaData() commandregistry.CommandMetadata {
 		ShortName:   "l",
 		Description: T("Log user in"),
 		Usage: []string{
-			T("CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE]\n\n"),
+			T("CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE]\n   CF_NAME login [-a API_URL] --client-id CLIENT_ID [--client-secret CLIENT_SECRET] [-o ORG] [-s SPACE]\n\n"),
 			terminal.WarningColor(T("WARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history")),
 		},
 		Examples: []string{
@@ -56,12 +58,21 @@ func (cmd *Login) MetaData() commandregistry.CommandMetadata {
 			T("CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)"),
 			T("CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)"),
 			T("CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time password to login)"),
+			T("CF_NAME login --client-id my-client --client-secret my-client-secret (login as the client my-client)"),
 		},
 		Flags: fs,
 	}
 }
 
 func (cmd *Login) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) []requirements.Requirement {
+	if fc.IsSet("client-secret") && !fc.IsSet("client-id") {
+		cmd.ui.Failed(T("Incorrect Usage. The --client-secret flag requires --client-id.\n\n") + commandregistry.Commands.CommandUsage("login"))
+	}
+
+	if fc.IsSet("client-id") && (fc.IsSet("u") || fc.IsSet("p") || fc.Bool("sso")) {
+		cmd.ui.Failed(T("Incorrect Usage. The --client-id flag cannot be used with -u, -p or --sso.\n\n") + commandregistry.Commands.CommandUsage("login"))
+	}
+
 	reqs := []requirements.Requirement{}
 	return reqs
 }
@@ -102,7 +113,9 @@ func (cmd *Login) Execute(c flags.FlagContext) {
 	//   EITHER   username and password
 	//   OR       a one-time passcode
 
-	if c.Bool("sso") {
+	if c.IsSet("client-id") {
+		cmd.authenticateClientCredentials(c)
+	} else if c.Bool("sso") {
 		cmd.authenticateSSO(c)
 	} else {
 		cmd.authenticate(c)
@@ -162,6 +175,28 @@ func (cmd Login) authenticateSSO(c flags.FlagContext) {
 	}
 }
 
+func (cmd Login) authenticateClientCredentials(c flags.FlagContext) {
+	_, err := cmd.authenticator.GetLoginPromptsAndSaveUAAServerURL()
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	clientSecret := c.String("client-secret")
+	if clientSecret == "" {
+		clientSecret = cmd.ui.AskForPassword(T("Client secret"))
+	}
+
+	cmd.ui.Say(T("Authenticating..."))
+	err = cmd.authenticator.AuthenticateClientCredentials(c.String("client-id"), clientSecret)
+	if err != nil {
+		cmd.ui.Say(err.Error())
+		cmd.ui.Failed(T("Unable to authenticate."))
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+}
+
 func (cmd Login) authenticate(c flags.FlagContext) {
 	usernameFlagValue := c.String("u")
 	passwordFlagValue := c.String("p")
@@ -396,6 +396,82 @@ var _ = Describe("Login Command", func() {
 			})
 		})
 
+		Describe("logging in with client credentials", func() {
+			BeforeEach(func() {
+				authRepo.AuthenticateClientCredentialsStub = func(clientID, clientSecret string) error {
+					Config.SetAccessToken("my_client_access_token")
+					return nil
+				}
+				orgRepo.FindByNameReturns(org, nil)
+
+				space := models.Space{}
+				space.GUID = "my-space-guid"
+				space.Name = "my-space"
+				spaceRepo.FindByNameReturns(space, nil)
+			})
+
+			It("authenticates with the client id and secret", func() {
+				Flags = []string{"-a", "api.example.com", "--client-id", "my-client", "--client-secret", "my-client-secret", "-o", "my-new-org", "-s", "my-space"}
+				testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false)
+
+				Expect(authRepo.AuthenticateCallCount()).To(BeZero())
+				Expect(authRepo.AuthenticateClientCredentialsCallCount()).To(Equal(1))
+				clientID, clientSecret := authRepo.AuthenticateClientCredentialsArgsForCall(0)
+				Expect(clientID).To(Equal("my-client"))
+				Expect(clientSecret).To(Equal("my-client-secret"))
+
+				Expect(ui.Prompts).To(BeEmpty())
+				Expect(ui.PasswordPrompts).To(BeEmpty())
+				Expect(Config.AccessToken()).To(Equal("my_client_access_token"))
+				Expect(Config.OrganizationFields().GUID).To(Equal("my-new-org-guid"))
+				Expect(Config.SpaceFields().GUID).To(Equal("my-space-guid"))
+			})
+
+			It("prompts for the client secret when it is not given", func() {
+				Flags = []string{"-a", "api.example.com", "--client-id", "my-client", "-o", "my-new-org", "-s", "my-space"}
+				ui.Inputs = []string{"my-client-secret"}
+				testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false)
+
+				Expect(ui.PasswordPrompts).To(ContainSubstrings([]string{"Client secret"}))
+				_, clientSecret := authRepo.AuthenticateClientCredentialsArgsForCall(0)
+				Expect(clientSecret).To(Equal("my-client-secret"))
+			})
+
+			It("fails when the client credentials are rejected", func() {
+				authRepo.AuthenticateClientCredentialsStub = nil
+				authRepo.AuthenticateClientCredentialsReturns(errors.New("Credentials were rejected, please try again."))
+
+				Flags = []string{"-a", "api.example.com", "--client-id", "my-client", "--client-secret", "bad-secret"}
+				testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false)
+
+				Expect(authRepo.AuthenticateClientCredentialsCallCount()).To(Equal(1))
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Credentials were rejected"},
+					[]string{"FAILED"},
+					[]string{"Unable to authenticate"},
+				))
+			})
+
+			It("fails with usage when the client id is combined with a username", func() {
+				Flags = []string{"--client-id", "my-client", "-u", "user@example.com"}
+				testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false)
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Incorrect Usage", "--client-id flag cannot be used with -u, -p or --sso"},
+				))
+				Expect(authRepo.AuthenticateClientCredentialsCallCount()).To(BeZero())
+			})
+
+			It("fails with usage when the client secret is given without a client id", func() {
+				Flags = []string{"--client-secret", "my-client-secret"}
+				testcmd.RunCLICommand("login", Flags, nil, updateCommandDependency, false)
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Incorrect Usage", "--client-secret flag requires --client-id"},
+				))
+			})
+		})
+
 		Describe("login prompts", func() {
 			BeforeEach(func() {
 				authRepo.GetLoginPromptsAndSaveUAAServerURLReturns(map[string]coreconfig.AuthPrompt{
@@ -17,6 +17,17 @@ import (
 	"github.com/cloudfoundry/cli/plugin/models"
 )
 
+// orgDetailsRecord is the machine readable form of an org printed by the
+// org command.
+type orgDetailsRecord struct {
+	Name        string   `json:"name" yaml:"name"`
+	GUID        string   `json:"guid" yaml:"guid"`
+	Quota       string   `json:"quota" yaml:"quota"`
+	Domains     []string `json:"domains" yaml:"domains"`
+	Spaces      []string `json:"spaces" yaml:"spaces"`
+	SpaceQuotas []string `json:"space_quotas" yaml:"space_quotas"`
+}
+
 type ShowOrg struct {
 	ui          terminal.UI
 	config      coreconfig.Reader
@@ -38,7 +49,8 @@ func (cmd *ShowOrg) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			T("CF_NAME org ORG"),
 		},
-		Flags: fs,
+		Flags:   fs,
+		Records: true,
 	}
 }
 
@@ -114,6 +126,15 @@ func (cmd *ShowOrg) Execute(c flags.FlagContext) {
 
 		if cmd.pluginCall {
 			cmd.populatePluginModel(org, quota)
+		} else if cmd.ui.OutputFormat().IsStructured() {
+			cmd.ui.PrintStructured(orgDetailsRecord{
+				Name:        org.Name,
+				GUID:        org.GUID,
+				Quota:       quota.Name,
+				Domains:     domains,
+				Spaces:      spaces,
+				SpaceQuotas: spaceQuotas,
+			})
 		} else {
 			table.Add("", T("domains:"), terminal.EntityNameColor(strings.Join(domains, ", ")))
 			table.Add("", T("quota:"), terminal.EntityNameColor(orgQuota))
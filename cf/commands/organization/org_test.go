@@ -1,11 +1,13 @@
 package organization_test
 
 import (
+	"encoding/json"
 	"os"
 
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/cf/trace/tracefakes"
 	"github.com/cloudfoundry/cli/plugin/models"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
@@ -115,6 +117,20 @@ var _ = Describe("org command", func() {
 			))
 		})
 
+		It("prints the org as a record with --output json", func() {
+			ui.Format = terminal.JSONOutput
+
+			runCommand("my-org")
+
+			Expect(ui.StructuredOutputs).To(HaveLen(1))
+			encoded, err := json.Marshal(ui.StructuredOutputs[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encoded).To(MatchJSON(`{"name": "my-org", "guid": "my-org-guid", "quota": "cantina-quota",
+				"domains": ["cfapps.io", "cf-app.com"], "spaces": ["development", "staging"],
+				"space_quotas": ["space-quota-1", "space-quota-2"]}`))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"domains:"}))
+		})
+
 		Context("when the guid flag is provided", func() {
 			It("shows only the org guid", func() {
 				runCommand("--guid", "my-org")
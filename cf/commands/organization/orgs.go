@@ -14,6 +14,13 @@ import (
 
 const orgLimit = 0
 
+// orgRecord is the machine readable form of an org printed by the orgs
+// command.
+type orgRecord struct {
+	Name string `json:"name" yaml:"name"`
+	GUID string `json:"guid" yaml:"guid"`
+}
+
 type ListOrgs struct {
 	ui              terminal.UI
 	config          coreconfig.Reader
@@ -34,6 +41,7 @@ func (cmd *ListOrgs) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			"CF_NAME orgs",
 		},
+		Records: true,
 	}
 }
 
@@ -73,6 +81,20 @@ func (cmd ListOrgs) Execute(fc flags.FlagContext) {
 	if apiErr != nil {
 		cmd.ui.Failed(apiErr.Error())
 	}
+
+	if cmd.pluginCall {
+		cmd.populatePluginModel(orgs)
+	}
+
+	if cmd.ui.OutputFormat().IsStructured() {
+		records := make([]orgRecord, 0, len(orgs))
+		for _, org := range orgs {
+			records = append(records, orgRecord{Name: org.Name, GUID: org.GUID})
+		}
+		cmd.ui.PrintStructured(records)
+		return
+	}
+
 	for _, org := range orgs {
 		table.Add(org.Name)
 		noOrgs = false
@@ -89,11 +111,6 @@ func (cmd ListOrgs) Execute(fc flags.FlagContext) {
 	if noOrgs {
 		cmd.ui.Say(T("No orgs found"))
 	}
-
-	if cmd.pluginCall {
-		cmd.populatePluginModel(orgs)
-	}
-
 }
 
 func (cmd *ListOrgs) populatePluginModel(orgs []models.Organization) {
@@ -1,12 +1,14 @@
 package organization_test
 
 import (
+	"encoding/json"
 	"os"
 
 	"github.com/cloudfoundry/cli/cf/api/organizations/organizationsfakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/cf/trace/tracefakes"
 	"github.com/cloudfoundry/cli/flags"
 	"github.com/cloudfoundry/cli/plugin/models"
@@ -89,6 +91,7 @@ var _ = Describe("orgs command", func() {
 			org1 := models.Organization{}
 			org1.Name = "Organization-1"
 			org1.GUID = "org-1-guid"
+			org1.GUID = "org-1-guid"
 
 			org2 := models.Organization{}
 			org2.Name = "Organization-2"
@@ -115,6 +118,7 @@ var _ = Describe("orgs command", func() {
 		BeforeEach(func() {
 			org1 := models.Organization{}
 			org1.Name = "Organization-1"
+			org1.GUID = "org-1-guid"
 
 			org2 := models.Organization{}
 			org2.Name = "Organization-2"
@@ -141,6 +145,22 @@ var _ = Describe("orgs command", func() {
 				[]string{"Organization-3"},
 			))
 		})
+
+		It("prints the orgs as records with --output json", func() {
+			ui.Format = terminal.JSONOutput
+
+			runCommand()
+
+			Expect(ui.StructuredOutputs).To(HaveLen(1))
+			encoded, err := json.Marshal(ui.StructuredOutputs[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encoded).To(MatchJSON(`[
+				{"name": "Organization-1", "guid": "org-1-guid"},
+				{"name": "Organization-2", "guid": ""},
+				{"name": "Organization-3", "guid": ""}
+			]`))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Organization-1"}))
+		})
 	})
 
 	It("tells the user when no orgs were found", func() {
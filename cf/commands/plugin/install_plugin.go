@@ -2,10 +2,12 @@ package plugin
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/rpc"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 
 	"github.com/cloudfoundry/cli/cf/actors/plugininstaller"
 	"github.com/cloudfoundry/cli/cf/actors/pluginrepo"
@@ -129,7 +131,7 @@ func (cmd *PluginInstall) Execute(c flags.FlagContext) {
 
 	pluginMetadata := cmd.runBinaryAndObtainPluginMetadata(pluginSourceFilepath)
 
-	cmd.ensurePluginIsSafeForInstallation(pluginMetadata, pluginDestinationFilepath, pluginSourceFilepath)
+	cmd.ensurePluginIsSafeForInstallation(pluginMetadata, pluginDestinationFilepath, pluginSourceFilepath, "")
 
 	cmd.installPlugin(pluginMetadata, pluginDestinationFilepath, pluginSourceFilepath)
 
@@ -153,8 +155,23 @@ func (cmd *PluginInstall) ensurePluginBinaryWithSameFileNameDoesNotAlreadyExist(
 	}
 }
 
-func (cmd *PluginInstall) ensurePluginIsSafeForInstallation(pluginMetadata *plugin.PluginMetadata, pluginDestinationFilepath string, pluginSourceFilepath string) {
-	plugins := cmd.pluginConfig.Plugins()
+// ensurePluginIsSafeForInstallation fails when the plugin has no name, or
+// when its name or commands are taken. The plugin named replacing, which is
+// being updated, does not count.
+func (cmd *PluginInstall) ensurePluginIsSafeForInstallation(pluginMetadata *plugin.PluginMetadata, pluginDestinationFilepath string, pluginSourceFilepath string, replacing string) {
+	plugins := map[string]pluginconfig.PluginMetadata{}
+	for name, metadata := range cmd.pluginConfig.Plugins() {
+		if name != replacing {
+			plugins[name] = metadata
+		}
+	}
+
+	cmd.ensurePluginIsSafeAmong(plugins, pluginMetadata, pluginSourceFilepath)
+}
+
+// ensurePluginIsSafeAmong fails when the plugin has no name, or when its name
+// or commands are taken by the plugins given.
+func (cmd *PluginInstall) ensurePluginIsSafeAmong(plugins map[string]pluginconfig.PluginMetadata, pluginMetadata *plugin.PluginMetadata, pluginSourceFilepath string) {
 	if pluginMetadata.Name == "" {
 		cmd.ui.Failed(fmt.Sprintf(T("Unable to obtain plugin name for executable {{.Executable}}", map[string]interface{}{"Executable": pluginSourceFilepath})))
 	}
@@ -211,11 +228,113 @@ func (cmd *PluginInstall) installPlugin(pluginMetadata *plugin.PluginMetadata, p
 		Location: pluginDestinationFilepath,
 		Version:  pluginMetadata.Version,
 		Commands: pluginMetadata.Commands,
+		Pinned:   cmd.pluginConfig.Plugins()[pluginMetadata.Name].Pinned,
 	}
 
 	cmd.pluginConfig.SetPlugin(pluginMetadata.Name, configMetadata)
 }
 
+// repoDownload is a plugin binary downloaded from a repo, with the metadata
+// it reported.
+type repoDownload struct {
+	dir            string
+	sourceFilepath string
+	metadata       *plugin.PluginMetadata
+}
+
+// installFromRepo downloads the plugin from the repo offering it and installs
+// it as the plugin named name, replacing the installed plugin of that name if
+// there is one. The download must match the repo checksum and, unless empty,
+// sha1.
+func (cmd *PluginInstall) installFromRepo(name string, available repoPlugin, sha1 string) *plugin.PluginMetadata {
+	download := cmd.downloadFromRepo(name, available, sha1)
+	defer cmd.removeDownload(download)
+
+	cmd.installDownload(name, download)
+
+	return download.metadata
+}
+
+// downloadFromRepo downloads the plugin from the repo offering it into a
+// directory of its own, and fails unless the download matches the repo
+// checksum and, unless empty, sha1, and is the plugin named name, in any case.
+func (cmd *PluginInstall) downloadFromRepo(name string, available repoPlugin, sha1 string) repoDownload {
+	dir, err := ioutil.TempDir("", "cf-plugin-")
+	if err != nil {
+		cmd.ui.Failed(T("Unexpected error has occurred:\n{{.Error}}", map[string]interface{}{"Error": err.Error()}))
+	}
+	download := repoDownload{dir: dir}
+
+	//the download is only kept when it passes the checks
+	checked := false
+	defer func() {
+		if !checked {
+			cmd.removeDownload(download)
+		}
+	}()
+
+	//installing from a repo verifies the checksum of the downloaded binary
+	installer := plugininstaller.NewPluginInstaller(&plugininstaller.Context{
+		Checksummer:    cmd.checksum,
+		GetPluginRepos: cmd.config.PluginRepos,
+		FileDownloader: downloader.NewDownloader(dir),
+		PluginRepo:     cmd.pluginRepo,
+		RepoName:       available.RepoName,
+		UI:             cmd.ui,
+	})
+	download.sourceFilepath = installer.Install(available.Plugin.Name)
+
+	if sha1 != "" {
+		cmd.checksum.SetFilePath(download.sourceFilepath)
+		if !cmd.checksum.CheckSha1(sha1) {
+			cmd.ui.Failed(T("Downloaded plugin binary's checksum does not match {{.Checksum}}", map[string]interface{}{"Checksum": sha1}))
+		}
+	}
+
+	download.metadata = cmd.runBinaryAndObtainPluginMetadata(download.sourceFilepath)
+	if !strings.EqualFold(download.metadata.Name, name) {
+		cmd.ui.Failed(T("The plugin downloaded from repository '{{.RepoName}}' is named {{.NewName}} instead of {{.PluginName}}",
+			map[string]interface{}{"RepoName": available.RepoName, "NewName": download.metadata.Name, "PluginName": name}))
+	}
+
+	checked = true
+	return download
+}
+
+func (cmd *PluginInstall) removeDownload(download repoDownload) {
+	err := os.RemoveAll(download.dir)
+	if err != nil {
+		cmd.ui.Say(T("Problem removing downloaded binary in temp directory: ") + err.Error())
+	}
+}
+
+// installDownload installs the downloaded plugin, replacing the installed
+// plugin named name if there is one.
+func (cmd *PluginInstall) installDownload(name string, download repoDownload) {
+	installed, replacing := cmd.pluginConfig.Plugins()[name]
+
+	_, pluginExecutableName := filepath.Split(download.sourceFilepath)
+	pluginDestinationFilepath := filepath.Join(cmd.pluginConfig.GetPluginPath(), pluginExecutableName)
+	if !replacing || pluginDestinationFilepath != installed.Location {
+		cmd.ensurePluginBinaryWithSameFileNameDoesNotAlreadyExist(pluginDestinationFilepath, pluginExecutableName)
+	}
+
+	cmd.ensurePluginIsSafeForInstallation(download.metadata, pluginDestinationFilepath, download.sourceFilepath, name)
+	cmd.installPlugin(download.metadata, pluginDestinationFilepath, download.sourceFilepath)
+
+	//the plugin config is keyed by the exact name the plugin reports
+	if replacing && download.metadata.Name != name {
+		cmd.pluginConfig.RemovePlugin(name)
+	}
+
+	if replacing && pluginDestinationFilepath != installed.Location {
+		err := os.Remove(installed.Location)
+		if err != nil && !os.IsNotExist(err) {
+			cmd.ui.Warn(T("Error removing old plugin binary: ") + err.Error())
+		}
+	}
+}
+
 func (cmd *PluginInstall) runBinaryAndObtainPluginMetadata(pluginSourceFilepath string) *plugin.PluginMetadata {
 	err := cmd.rpcService.Start()
 	if err != nil {
@@ -0,0 +1,61 @@
+package plugin
+
+import (
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/pluginconfig"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/flags"
+)
+
+type PluginPin struct {
+	ui     terminal.UI
+	config pluginconfig.PluginConfiguration
+}
+
+func init() {
+	commandregistry.Register(&PluginPin{})
+}
+
+func (cmd *PluginPin) MetaData() commandregistry.CommandMetadata {
+	return commandregistry.CommandMetadata{
+		Name:        "pin-plugin",
+		Description: T("Keep an installed plugin at its current version when updating all plugins"),
+		Usage: []string{
+			T("CF_NAME pin-plugin PLUGIN_NAME"),
+		},
+	}
+}
+
+func (cmd *PluginPin) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) []requirements.Requirement {
+	if len(fc.Args()) != 1 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires an argument\n\n") + commandregistry.Commands.CommandUsage("pin-plugin"))
+	}
+
+	reqs := []requirements.Requirement{}
+	return reqs
+}
+
+func (cmd *PluginPin) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.PluginConfig
+	return cmd
+}
+
+func (cmd *PluginPin) Execute(c flags.FlagContext) {
+	pluginName := c.Args()[0]
+
+	cmd.ui.Say(T("Pinning plugin {{.PluginName}}...", map[string]interface{}{"PluginName": pluginName}))
+
+	metadata, ok := cmd.config.Plugins()[pluginName]
+	if !ok {
+		cmd.ui.Failed(T("Plugin name {{.PluginName}} does not exist", map[string]interface{}{"PluginName": pluginName}))
+	}
+
+	metadata.Pinned = true
+	cmd.config.SetPlugin(pluginName, metadata)
+
+	cmd.ui.Ok()
+	cmd.ui.Say(T("Plugin {{.PluginName}} is pinned at version {{.Version}}.", map[string]interface{}{"PluginName": pluginName, "Version": formatPluginVersion(metadata.Version)}))
+}
@@ -0,0 +1,88 @@
+package plugin_test
+
+import (
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/pluginconfig"
+	"github.com/cloudfoundry/cli/cf/configuration/pluginconfig/pluginconfigfakes"
+	"github.com/cloudfoundry/cli/plugin"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("pin-plugin and unpin-plugin", func() {
+	var (
+		ui                  *testterm.FakeUI
+		requirementsFactory *testreq.FakeReqFactory
+		config              *pluginconfigfakes.FakePluginConfiguration
+		deps                commandregistry.Dependency
+	)
+
+	runCommand := func(name string, args ...string) bool {
+		return testcmd.RunCLICommand(name, args, requirementsFactory, func(pluginCall bool) {
+			deps.UI = ui
+			deps.PluginConfig = config
+			commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand(name).SetDependency(deps, pluginCall))
+		}, false)
+	}
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		requirementsFactory = &testreq.FakeReqFactory{}
+		config = new(pluginconfigfakes.FakePluginConfiguration)
+		config.PluginsReturns(map[string]pluginconfig.PluginMetadata{
+			"Test1": {
+				Location: "path/to/plugin",
+				Version:  plugin.VersionType{Major: 1, Minor: 2, Build: 3},
+			},
+		})
+	})
+
+	It("fails with usage when not provided a plugin name", func() {
+		runCommand("pin-plugin")
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"Incorrect Usage", "Requires an argument"}))
+	})
+
+	It("fails when the plugin is not installed", func() {
+		runCommand("pin-plugin", "Missing")
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"Plugin name Missing does not exist"},
+		))
+		Expect(config.SetPluginCallCount()).To(Equal(0))
+	})
+
+	It("pins the plugin", func() {
+		runCommand("pin-plugin", "Test1")
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Pinning plugin Test1..."},
+			[]string{"OK"},
+			[]string{"Plugin Test1 is pinned at version 1.2.3."},
+		))
+
+		Expect(config.SetPluginCallCount()).To(Equal(1))
+		name, metadata := config.SetPluginArgsForCall(0)
+		Expect(name).To(Equal("Test1"))
+		Expect(metadata.Pinned).To(BeTrue())
+		Expect(metadata.Location).To(Equal("path/to/plugin"))
+	})
+
+	It("unpins the plugin", func() {
+		runCommand("unpin-plugin", "Test1")
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Unpinning plugin Test1..."},
+			[]string{"OK"},
+			[]string{"Plugin Test1 is no longer pinned."},
+		))
+
+		Expect(config.SetPluginCallCount()).To(Equal(1))
+		_, metadata := config.SetPluginArgsForCall(0)
+		Expect(metadata.Pinned).To(BeFalse())
+	})
+})
@@ -2,19 +2,31 @@ package plugin
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/blang/semver"
+	clipr "github.com/cloudfoundry-incubator/cli-plugin-repo/models"
+	"github.com/cloudfoundry/cli/cf"
+	"github.com/cloudfoundry/cli/cf/actors/pluginrepo"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/configuration/pluginconfig"
 	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
+	"github.com/cloudfoundry/cli/plugin"
 	"github.com/cloudfoundry/cli/utils"
 )
 
 type Plugins struct {
-	ui     terminal.UI
-	config pluginconfig.PluginConfiguration
+	ui         terminal.UI
+	config     pluginconfig.PluginConfiguration
+	coreConfig coreconfig.Reader
+	pluginRepo pluginrepo.PluginRepo
 }
 
 func init() {
@@ -24,12 +36,13 @@ func init() {
 func (cmd *Plugins) MetaData() commandregistry.CommandMetadata {
 	fs := make(map[string]flags.FlagSet)
 	fs["checksum"] = &flags.BoolFlag{Name: "checksum", Usage: T("Compute and show the sha1 value of the plugin binary file")}
+	fs["outdated"] = &flags.BoolFlag{Name: "outdated", Usage: T("Search the plugin repositories for newer versions of installed plugins")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "plugins",
 		Description: T("List all available plugin commands"),
 		Usage: []string{
-			T("CF_NAME plugins"),
+			T("CF_NAME plugins [--checksum | --outdated]"),
 		},
 		Flags: fs,
 	}
@@ -43,8 +56,16 @@ func (cmd *Plugins) Requirements(requirementsFactory requirements.Factory, fc fl
 		},
 	)
 
+	flagsReq := requirements.NewUsageRequirement(commandregistry.CLICommandUsagePresenter(cmd),
+		T("--checksum and --outdated cannot be used together"),
+		func() bool {
+			return fc.Bool("checksum") && fc.Bool("outdated")
+		},
+	)
+
 	reqs := []requirements.Requirement{
 		usageReq,
+		flagsReq,
 	}
 	return reqs
 }
@@ -52,11 +73,16 @@ func (cmd *Plugins) Requirements(requirementsFactory requirements.Factory, fc fl
 func (cmd *Plugins) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
 	cmd.ui = deps.UI
 	cmd.config = deps.PluginConfig
+	cmd.coreConfig = deps.Config
+	cmd.pluginRepo = deps.PluginRepo
 	return cmd
 }
 
 func (cmd *Plugins) Execute(c flags.FlagContext) {
-	var version string
+	if c.Bool("outdated") {
+		cmd.listOutdatedPlugins()
+		return
+	}
 
 	cmd.ui.Say(T("Listing Installed Plugins..."))
 
@@ -71,10 +97,9 @@ func (cmd *Plugins) Execute(c flags.FlagContext) {
 	}
 
 	for pluginName, metadata := range plugins {
-		if metadata.Version.Major == 0 && metadata.Version.Minor == 0 && metadata.Version.Build == 0 {
-			version = "N/A"
-		} else {
-			version = fmt.Sprintf("%d.%d.%d", metadata.Version.Major, metadata.Version.Minor, metadata.Version.Build)
+		version := formatPluginVersion(metadata.Version)
+		if metadata.Pinned {
+			version += " " + T("(pinned)")
 		}
 
 		for _, command := range metadata.Commands {
@@ -106,3 +131,140 @@ func (cmd *Plugins) Execute(c flags.FlagContext) {
 
 	table.Print()
 }
+
+func (cmd *Plugins) listOutdatedPlugins() {
+	cmd.ui.Say(T("Searching the plugin repositories for newer versions of installed plugins..."))
+
+	newest, repoErrors := newestRepoPlugins(cmd.pluginRepo, cmd.coreConfig.PluginRepos())
+
+	plugins := cmd.config.Plugins()
+	names := []string{}
+	for name := range plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	table := cmd.ui.Table([]string{T("Plugin Name"), T("Version"), T("Latest Version"), T("Repository"), T("Pinned")})
+	outdated := 0
+	for _, name := range names {
+		metadata := plugins[name]
+		available, found := newest[strings.ToLower(name)]
+		if !found || compareVersions(available.Version, metadata.Version) <= 0 {
+			continue
+		}
+
+		pinned := ""
+		if metadata.Pinned {
+			pinned = T("yes")
+		}
+
+		table.Add(name, formatPluginVersion(metadata.Version), formatPluginVersion(available.Version), available.RepoName, pinned)
+		outdated++
+	}
+
+	for _, repoError := range repoErrors {
+		cmd.ui.Warn(repoError)
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	if outdated == 0 {
+		cmd.ui.Say(T("All installed plugins are up to date."))
+		return
+	}
+
+	table.Print()
+	cmd.ui.Say("")
+	cmd.ui.Say(T("Use '{{.Command}}' to update them.", map[string]interface{}{"Command": terminal.CommandColor(cf.Name + " update-plugin --all")}))
+}
+
+// repoPlugin is a plugin offered by one of the registered repos.
+type repoPlugin struct {
+	RepoName string
+	Plugin   clipr.Plugin
+	Version  plugin.VersionType
+}
+
+// newestRepoPlugins returns the newest version offered by any of the repos of
+// every plugin, by lower case plugin name, and the errors of the repos that
+// could not be listed.
+func newestRepoPlugins(pluginRepo pluginrepo.PluginRepo, repos []models.PluginRepo) (map[string]repoPlugin, []string) {
+	available, repoErrors := listRepoPlugins(pluginRepo, repos)
+
+	newest := map[string]repoPlugin{}
+	for _, p := range available {
+		name := strings.ToLower(p.Plugin.Name)
+		if current, found := newest[name]; found && compareVersions(p.Version, current.Version) <= 0 {
+			continue
+		}
+		newest[name] = p
+	}
+
+	return newest, repoErrors
+}
+
+// listRepoPlugins returns the plugins offered by the repos, in the order of
+// the repos, and the errors of the repos that could not be listed. Plugins
+// with versions that cannot be parsed are left out.
+func listRepoPlugins(pluginRepo pluginrepo.PluginRepo, repos []models.PluginRepo) ([]repoPlugin, []string) {
+	for i := range repos {
+		if repos[i].URL == "http://plugins.cloudfoundry.org" {
+			repos[i].URL = "https://plugins.cloudfoundry.org"
+		}
+	}
+
+	repoPlugins, repoErrors := pluginRepo.GetPlugins(repos)
+
+	available := []repoPlugin{}
+	for _, repo := range repos {
+		for _, p := range repoPlugins[repo.Name] {
+			version, ok := parsePluginVersion(p.Version)
+			if !ok {
+				continue
+			}
+			available = append(available, repoPlugin{RepoName: repo.Name, Plugin: p, Version: version})
+		}
+	}
+
+	return available, repoErrors
+}
+
+// parsePluginVersion parses versions such as 1, 1.2 and 1.2.3 as published by
+// plugin repos.
+func parsePluginVersion(value string) (plugin.VersionType, bool) {
+	parts := strings.Split(strings.TrimPrefix(strings.TrimSpace(value), "v"), ".")
+	if len(parts) > 3 {
+		return plugin.VersionType{}, false
+	}
+
+	numbers := []int{0, 0, 0}
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return plugin.VersionType{}, false
+		}
+		numbers[i] = number
+	}
+
+	return plugin.VersionType{Major: numbers[0], Minor: numbers[1], Build: numbers[2]}, true
+}
+
+func compareVersions(a, b plugin.VersionType) int {
+	return semverOf(a).Compare(semverOf(b))
+}
+
+func semverOf(version plugin.VersionType) semver.Version {
+	return semver.Version{
+		Major: uint64(version.Major),
+		Minor: uint64(version.Minor),
+		Patch: uint64(version.Build),
+	}
+}
+
+func formatPluginVersion(version plugin.VersionType) string {
+	if version.Major == 0 && version.Minor == 0 && version.Build == 0 {
+		return "N/A"
+	}
+	return fmt.Sprintf("%d.%d.%d", version.Major, version.Minor, version.Build)
+}
@@ -0,0 +1,447 @@
+package plugin
+
+import (
+	"io/ioutil"
+	"sort"
+	"strings"
+
+	"github.com/cloudfoundry/cli/cf/actors/plugininstaller"
+	"github.com/cloudfoundry/cli/cf/actors/pluginrepo"
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/configuration/pluginconfig"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/flags"
+	"github.com/cloudfoundry/cli/plugin"
+	"github.com/cloudfoundry/cli/utils"
+	"gopkg.in/yaml.v2"
+)
+
+type PluginsSync struct {
+	ui           terminal.UI
+	config       coreconfig.Reader
+	pluginConfig pluginconfig.PluginConfiguration
+	pluginRepo   pluginrepo.PluginRepo
+	checksum     utils.Sha1Checksum
+	installer    *PluginInstall
+	uninstaller  *PluginUninstall
+}
+
+// pluginsManifest is the plugins manifest read by plugins-sync.
+type pluginsManifest struct {
+	Plugins []manifestPlugin `yaml:"plugins"`
+}
+
+type manifestPlugin struct {
+	Name    string            `yaml:"name"`
+	Repo    string            `yaml:"repo"`
+	Version string            `yaml:"version"`
+	SHA1    manifestChecksums `yaml:"sha1"`
+
+	version plugin.VersionType
+	sha1    string
+}
+
+// manifestChecksums holds the SHA1 of the plugin binary for all platforms,
+// under "", or by platform name.
+type manifestChecksums map[string]string
+
+func (checksums *manifestChecksums) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var sha1 string
+	if err := unmarshal(&sha1); err == nil {
+		*checksums = manifestChecksums{"": sha1}
+		return nil
+	}
+
+	var byPlatform map[string]string
+	if err := unmarshal(&byPlatform); err != nil {
+		return err
+	}
+	*checksums = manifestChecksums(byPlatform)
+	return nil
+}
+
+func (checksums manifestChecksums) forPlatform(platform string) string {
+	if sha1, ok := checksums[platform]; ok {
+		return sha1
+	}
+	return checksums[""]
+}
+
+// pluginChange is a difference between an installed plugin and the manifest.
+// Installed is nil for plugins to install and wanted is nil for plugins to
+// uninstall.
+type pluginChange struct {
+	name      string
+	installed *pluginconfig.PluginMetadata
+	wanted    *manifestPlugin
+	action    string
+}
+
+func init() {
+	commandregistry.Register(&PluginsSync{})
+}
+
+func (cmd *PluginsSync) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["f"] = &flags.StringFlag{ShortName: "f", Usage: T("Path to the plugins manifest")}
+	fs["dry-run"] = &flags.BoolFlag{Name: "dry-run", Usage: T("Report how the installed plugins differ from the manifest without changing them")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "plugins-sync",
+		Description: T("Install, update and uninstall plugins to match a plugins manifest"),
+		Usage: []string{
+			T(`CF_NAME plugins-sync -f PLUGINS_MANIFEST [--dry-run]
+
+   The plugins manifest lists the plugins to install from registered plugin repositories, with the SHA1 of their binaries:
+
+   plugins:
+   - name: CLI-Recorder
+     repo: CF-Community
+     version: 1.0.1
+     sha1: 2a087d5cddcfb057fbda91e611c33f46dd2c6a3f
+
+   Instead of a single SHA1, sha1 can map the platforms osx, linux32, linux64, win32 and win64 to the SHA1 of their binaries. Installed plugins that are not listed are uninstalled.`),
+		},
+		Examples: []string{
+			"CF_NAME plugins-sync -f plugins.yml",
+			"CF_NAME plugins-sync -f plugins.yml --dry-run",
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *PluginsSync) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) []requirements.Requirement {
+	if fc.String("f") == "" || len(fc.Args()) != 0 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires a plugins manifest provided with -f\n\n") + commandregistry.Commands.CommandUsage("plugins-sync"))
+	}
+
+	reqs := []requirements.Requirement{}
+	return reqs
+}
+
+func (cmd *PluginsSync) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.pluginConfig = deps.PluginConfig
+	cmd.pluginRepo = deps.PluginRepo
+	cmd.checksum = deps.ChecksumUtil
+
+	//the plugin binaries are checked and installed the way install-plugin does,
+	//the uninstaller shares its rpc service as each can only be registered once
+	cmd.installer = new(PluginInstall).SetDependency(deps, pluginCall).(*PluginInstall)
+	cmd.uninstaller = &PluginUninstall{
+		ui:         deps.UI,
+		config:     deps.PluginConfig,
+		rpcService: cmd.installer.rpcService,
+	}
+
+	return cmd
+}
+
+func (cmd *PluginsSync) Execute(c flags.FlagContext) {
+	manifestPath := c.String("f")
+	manifest := cmd.readManifest(manifestPath)
+
+	cmd.ui.Say(T("Comparing installed plugins with plugins manifest {{.Path}}...", map[string]interface{}{"Path": terminal.EntityNameColor(manifestPath)}))
+
+	changes := cmd.pluginChanges(manifest)
+
+	if c.Bool("dry-run") {
+		cmd.reportChanges(changes, manifestPath)
+		return
+	}
+
+	if len(changes) != 0 {
+		cmd.applyChanges(changes)
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say(T("Installed plugins match the plugins manifest."))
+}
+
+func (cmd *PluginsSync) readManifest(path string) pluginsManifest {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		cmd.ui.Failed(T("Error reading plugins manifest: {{.Error}}", map[string]interface{}{"Error": err.Error()}))
+	}
+
+	var manifest pluginsManifest
+	err = yaml.Unmarshal(contents, &manifest)
+	if err != nil {
+		cmd.ui.Failed(T("Error reading plugins manifest: {{.Error}}", map[string]interface{}{"Error": err.Error()}))
+	}
+
+	platform := plugininstaller.Platform()
+	names := map[string]bool{}
+	for i := range manifest.Plugins {
+		p := &manifest.Plugins[i]
+		errorMap := map[string]interface{}{"Index": i + 1, "PluginName": p.Name, "Platform": platform}
+
+		if p.Name == "" || p.Repo == "" || p.Version == "" {
+			cmd.ui.Failed(T("Plugin {{.Index}} in the plugins manifest requires a name, repo, version and sha1", errorMap))
+		}
+
+		if names[strings.ToLower(p.Name)] {
+			cmd.ui.Failed(T("Plugin {{.PluginName}} is listed more than once in the plugins manifest", errorMap))
+		}
+		names[strings.ToLower(p.Name)] = true
+
+		var ok bool
+		p.version, ok = parsePluginVersion(p.Version)
+		if !ok {
+			cmd.ui.Failed(T("Plugin {{.PluginName}} in the plugins manifest has an invalid version", errorMap))
+		}
+
+		p.sha1 = strings.ToLower(p.SHA1.forPlatform(platform))
+		if p.sha1 == "" {
+			cmd.ui.Failed(T("Plugin {{.PluginName}} in the plugins manifest has no sha1 for platform {{.Platform}}", errorMap))
+		}
+	}
+
+	return manifest
+}
+
+// pluginChanges lists the plugins to uninstall, in name order, followed by the
+// plugins to install, in manifest order. Plugin names are compared ignoring
+// case, and installed plugins keep the name they were installed with.
+func (cmd *PluginsSync) pluginChanges(manifest pluginsManifest) []pluginChange {
+	plugins := cmd.pluginConfig.Plugins()
+	changes := []pluginChange{}
+
+	installedNames := map[string]string{}
+	for name := range plugins {
+		installedNames[strings.ToLower(name)] = name
+	}
+
+	listed := map[string]bool{}
+	for _, p := range manifest.Plugins {
+		listed[strings.ToLower(p.Name)] = true
+	}
+
+	extraNames := []string{}
+	for name := range plugins {
+		if !listed[strings.ToLower(name)] {
+			extraNames = append(extraNames, name)
+		}
+	}
+	sort.Strings(extraNames)
+
+	for _, name := range extraNames {
+		installed := plugins[name]
+		changes = append(changes, pluginChange{name: name, installed: &installed, action: T("uninstall")})
+	}
+
+	for i := range manifest.Plugins {
+		wanted := &manifest.Plugins[i]
+		name, found := installedNames[strings.ToLower(wanted.Name)]
+		if !found {
+			name = wanted.Name
+		}
+		installed := plugins[name]
+
+		var action string
+		switch {
+		case !found:
+			action = T("install")
+		case compareVersions(installed.Version, wanted.version) < 0:
+			action = T("update")
+		case compareVersions(installed.Version, wanted.version) > 0:
+			action = T("downgrade")
+		case !cmd.installedChecksumMatches(installed, wanted.sha1):
+			action = T("reinstall, checksum differs")
+		default:
+			continue
+		}
+
+		change := pluginChange{name: name, wanted: wanted, action: action}
+		if found {
+			change.installed = &installed
+		}
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+func (cmd *PluginsSync) installedChecksumMatches(installed pluginconfig.PluginMetadata, sha1 string) bool {
+	cmd.checksum.SetFilePath(installed.Location)
+	return cmd.checksum.CheckSha1(sha1)
+}
+
+func (cmd *PluginsSync) reportChanges(changes []pluginChange, manifestPath string) {
+	if len(changes) == 0 {
+		cmd.ui.Ok()
+		cmd.ui.Say(T("Installed plugins match the plugins manifest."))
+		return
+	}
+
+	cmd.ui.Say("")
+
+	table := cmd.ui.Table([]string{T("Plugin Name"), T("Installed Version"), T("Manifest Version"), T("Action")})
+	for _, change := range changes {
+		installedVersion, wantedVersion := "", ""
+		if change.installed != nil {
+			installedVersion = formatPluginVersion(change.installed.Version)
+		}
+		if change.wanted != nil {
+			wantedVersion = formatPluginVersion(change.wanted.version)
+		}
+		table.Add(change.name, installedVersion, wantedVersion, change.action)
+	}
+	table.Print()
+
+	cmd.ui.Say("")
+	cmd.ui.Say(T("Run 'cf plugins-sync -f {{.Path}}' to make the installed plugins match the manifest.", map[string]interface{}{"Path": manifestPath}))
+}
+
+func (cmd *PluginsSync) applyChanges(changes []pluginChange) {
+	//download and check every plugin to install before changing anything
+	downloads := cmd.downloadRepoPlugins(changes, cmd.findRepoPlugins(changes))
+	defer func() {
+		for _, download := range downloads {
+			cmd.installer.removeDownload(download)
+		}
+	}()
+
+	for _, change := range changes {
+		cmd.ui.Say("")
+
+		if change.wanted == nil {
+			cmd.ui.Say(T("Uninstalling plugin {{.PluginName}}...", map[string]interface{}{"PluginName": change.name}))
+			cmd.uninstaller.removePlugin(change.name, *change.installed)
+			continue
+		}
+
+		if change.installed == nil {
+			cmd.ui.Say(T("Installing plugin {{.PluginName}} {{.Version}} from repository {{.RepoName}}...",
+				map[string]interface{}{
+					"PluginName": change.name,
+					"Version":    formatPluginVersion(change.wanted.version),
+					"RepoName":   change.wanted.Repo,
+				}))
+		} else {
+			cmd.ui.Say(T("Replacing plugin {{.PluginName}} {{.OldVersion}} with {{.NewVersion}} from repository {{.RepoName}}...",
+				map[string]interface{}{
+					"PluginName": change.name,
+					"OldVersion": formatPluginVersion(change.installed.Version),
+					"NewVersion": formatPluginVersion(change.wanted.version),
+					"RepoName":   change.wanted.Repo,
+				}))
+		}
+
+		cmd.installer.installDownload(change.name, downloads[change.name])
+	}
+}
+
+// downloadRepoPlugins downloads the plugins to install for the changes, by
+// plugin name. It fails unless each download matches the manifest and can be
+// installed once the plugins it replaces and the plugins to uninstall are gone.
+func (cmd *PluginsSync) downloadRepoPlugins(changes []pluginChange, available map[string]repoPlugin) map[string]repoDownload {
+	remaining := map[string]pluginconfig.PluginMetadata{}
+	for name, metadata := range cmd.pluginConfig.Plugins() {
+		remaining[name] = metadata
+	}
+	for _, change := range changes {
+		if change.installed != nil {
+			delete(remaining, change.name)
+		}
+	}
+
+	//the downloads so far are removed when one fails
+	downloads := map[string]repoDownload{}
+	checked := false
+	defer func() {
+		if !checked {
+			for _, download := range downloads {
+				cmd.installer.removeDownload(download)
+			}
+		}
+	}()
+
+	for _, change := range changes {
+		if change.wanted == nil {
+			continue
+		}
+
+		download := cmd.installer.downloadFromRepo(change.name, available[change.name], change.wanted.sha1)
+		downloads[change.name] = download
+		cmd.installer.ensurePluginIsSafeAmong(remaining, download.metadata, download.sourceFilepath)
+		remaining[download.metadata.Name] = pluginconfig.PluginMetadata{Commands: download.metadata.Commands}
+	}
+
+	checked = true
+	return downloads
+}
+
+// findRepoPlugins returns the repo plugins to install for the changes, by
+// plugin name. It fails unless each repo offers the version in the manifest.
+func (cmd *PluginsSync) findRepoPlugins(changes []pluginChange) map[string]repoPlugin {
+	registered := map[string]models.PluginRepo{}
+	for _, repo := range cmd.config.PluginRepos() {
+		registered[strings.ToLower(repo.Name)] = repo
+	}
+
+	repos := []models.PluginRepo{}
+	requested := map[string]bool{}
+	for _, change := range changes {
+		if change.wanted == nil {
+			continue
+		}
+
+		repoName := strings.ToLower(change.wanted.Repo)
+		repo, ok := registered[repoName]
+		if !ok {
+			cmd.ui.Failed(T("Plugin repo named {{.RepoName}} is not registered", map[string]interface{}{"RepoName": change.wanted.Repo}) + "\n" + T("Tip: use 'add-plugin-repo' to register the repo"))
+		}
+		if !requested[repoName] {
+			repos = append(repos, repo)
+			requested[repoName] = true
+		}
+	}
+
+	repoPlugins, repoErrors := listRepoPlugins(cmd.pluginRepo, repos)
+	if len(repoErrors) != 0 {
+		cmd.ui.Failed(T("Error getting plugin metadata from repo: ") + repoErrors[0])
+	}
+
+	available := map[string]repoPlugin{}
+	for _, change := range changes {
+		if change.wanted == nil {
+			continue
+		}
+
+		errorMap := map[string]interface{}{
+			"PluginName": change.name,
+			"RepoName":   change.wanted.Repo,
+			"Version":    formatPluginVersion(change.wanted.version),
+		}
+
+		found := false
+		for _, p := range repoPlugins {
+			if !strings.EqualFold(p.RepoName, change.wanted.Repo) || !strings.EqualFold(p.Plugin.Name, change.name) {
+				continue
+			}
+
+			found = true
+			if compareVersions(p.Version, change.wanted.version) == 0 {
+				available[change.name] = p
+			} else {
+				errorMap["RepoVersion"] = formatPluginVersion(p.Version)
+			}
+		}
+
+		if _, ok := available[change.name]; ok {
+			continue
+		}
+		if !found {
+			cmd.ui.Failed(T("Plugin {{.PluginName}} is not available in repository {{.RepoName}}", errorMap))
+		}
+		cmd.ui.Failed(T("Repository {{.RepoName}} offers plugin {{.PluginName}} {{.RepoVersion}} instead of {{.Version}}", errorMap))
+	}
+
+	return available
+}
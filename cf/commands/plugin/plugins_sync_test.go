@@ -0,0 +1,373 @@
+package plugin_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/cloudfoundry/cli/cf/actors/pluginrepo/pluginrepofakes"
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/configuration/pluginconfig"
+	"github.com/cloudfoundry/cli/cf/configuration/pluginconfig/pluginconfigfakes"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/plugin"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+	"github.com/cloudfoundry/cli/utils/utilsfakes"
+
+	clipr "github.com/cloudfoundry-incubator/cli-plugin-repo/models"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("plugins-sync", func() {
+	var (
+		ui                  *testterm.FakeUI
+		requirementsFactory *testreq.FakeReqFactory
+		config              coreconfig.Repository
+		pluginConfig        *pluginconfigfakes.FakePluginConfiguration
+		fakePluginRepo      *pluginrepofakes.FakePluginRepo
+		fakeChecksum        *utilsfakes.FakeSha1Checksum
+		deps                commandregistry.Dependency
+
+		homeDir      string
+		pluginDir    string
+		manifestPath string
+		otherBinary  string
+		testServer   *httptest.Server
+	)
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.Config = config
+		deps.PluginConfig = pluginConfig
+		deps.PluginRepo = fakePluginRepo
+		deps.ChecksumUtil = fakeChecksum
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("plugins-sync").SetDependency(deps, pluginCall))
+	}
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("plugins-sync", args, requirementsFactory, updateCommandDependency, false)
+	}
+
+	writeManifest := func(contents string) {
+		Expect(ioutil.WriteFile(manifestPath, []byte(contents), 0600)).To(Succeed())
+	}
+
+	repoPlugin := func(name string, version string) clipr.Plugin {
+		p := clipr.Plugin{Name: name, Version: version}
+		for _, platform := range []string{"osx", "win32", "win64", "linux32", "linux64"} {
+			p.Binaries = append(p.Binaries, clipr.Binary{Platform: platform, Url: testServer.URL + "/test_1.exe", Checksum: "repo-sha1"})
+		}
+		return p
+	}
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		requirementsFactory = &testreq.FakeReqFactory{}
+		config = testconfig.NewRepositoryWithDefaults()
+		pluginConfig = new(pluginconfigfakes.FakePluginConfiguration)
+		fakePluginRepo = new(pluginrepofakes.FakePluginRepo)
+		fakeChecksum = new(utilsfakes.FakeSha1Checksum)
+		fakeChecksum.CheckSha1Returns(true)
+
+		var err error
+		homeDir, err = ioutil.TempDir(os.TempDir(), "plugins")
+		Expect(err).ToNot(HaveOccurred())
+		pluginDir = filepath.Join(homeDir, ".cf", "plugins")
+		pluginConfig.GetPluginPathReturns(pluginDir)
+		manifestPath = filepath.Join(homeDir, "plugins.yml")
+
+		Expect(os.MkdirAll(pluginDir, 0700)).To(Succeed())
+		otherBinary = filepath.Join(pluginDir, "other.exe")
+		Expect(ioutil.WriteFile(otherBinary, []byte("other"), 0600)).To(Succeed())
+
+		dir, err := os.Getwd()
+		Expect(err).ToNot(HaveOccurred())
+		test1 := filepath.Join(dir, "..", "..", "..", "fixtures", "plugins", "test_1.exe")
+		if runtime.GOOS != "windows" {
+			Expect(os.Chmod(test1, 0700)).To(Succeed())
+		}
+
+		testServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.ServeFile(w, r, test1)
+		}))
+
+		config.SetPluginRepo(models.PluginRepo{Name: "repo1", URL: "http://repo1.example.com"})
+		fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{
+			"repo1": {repoPlugin("Test1", "1.2.4")},
+		}, nil)
+
+		pluginConfig.PluginsReturns(map[string]pluginconfig.PluginMetadata{
+			"Other": {
+				Location: otherBinary,
+				Version:  plugin.VersionType{Major: 2},
+			},
+		})
+
+		writeManifest(`---
+plugins:
+- name: Test1
+  repo: repo1
+  version: 1.2.4
+  sha1: manifest-sha1
+`)
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(homeDir)
+	})
+
+	Describe("requirements", func() {
+		It("fails with usage when not provided a plugins manifest", func() {
+			runCommand()
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"Incorrect Usage", "Requires a plugins manifest provided with -f"}))
+		})
+
+		It("fails with usage when provided an argument", func() {
+			runCommand("-f", manifestPath, "Test1")
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"Incorrect Usage"}))
+		})
+	})
+
+	Describe("reading the plugins manifest", func() {
+		It("fails when the manifest does not exist", func() {
+			runCommand("-f", filepath.Join(homeDir, "missing.yml"))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Error reading plugins manifest"},
+			))
+		})
+
+		It("fails when a plugin has no sha1", func() {
+			writeManifest(`---
+plugins:
+- name: Test1
+  repo: repo1
+  version: 1.2.4
+`)
+
+			runCommand("-f", manifestPath)
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Plugin Test1 in the plugins manifest has no sha1 for platform"},
+			))
+		})
+
+		It("fails when a plugin is listed twice", func() {
+			writeManifest(`---
+plugins:
+- {name: Test1, repo: repo1, version: 1.2.4, sha1: manifest-sha1}
+- {name: test1, repo: repo1, version: 1.2.4, sha1: manifest-sha1}
+`)
+
+			runCommand("-f", manifestPath)
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"Plugin test1 is listed more than once in the plugins manifest"}))
+		})
+
+		It("uses the sha1 of the platform the CLI runs on", func() {
+			writeManifest(`---
+plugins:
+- name: Other
+  repo: repo1
+  version: 2
+  sha1:
+    osx: platform-sha1
+    linux32: platform-sha1
+    linux64: platform-sha1
+    win32: platform-sha1
+    win64: platform-sha1
+`)
+
+			runCommand("-f", manifestPath, "--dry-run")
+			Expect(fakeChecksum.SetFilePathArgsForCall(0)).To(Equal(otherBinary))
+			Expect(fakeChecksum.CheckSha1ArgsForCall(0)).To(Equal("platform-sha1"))
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"Installed plugins match the plugins manifest."}))
+		})
+	})
+
+	Context("with --dry-run", func() {
+		It("reports how the installed plugins differ from the manifest", func() {
+			pluginConfig.PluginsReturns(map[string]pluginconfig.PluginMetadata{
+				"Other": {Location: otherBinary, Version: plugin.VersionType{Major: 2}},
+				"Newer": {Location: "newer.exe", Version: plugin.VersionType{Major: 3}},
+				"Same":  {Location: "same.exe", Version: plugin.VersionType{Major: 1}},
+			})
+			fakeChecksum.CheckSha1Returns(false)
+			writeManifest(`---
+plugins:
+- {name: Test1, repo: repo1, version: 1.2.4, sha1: manifest-sha1}
+- {name: Newer, repo: repo1, version: 2.0.0, sha1: manifest-sha1}
+- {name: Same, repo: repo1, version: 1.0.0, sha1: manifest-sha1}
+`)
+
+			runCommand("-f", manifestPath, "--dry-run")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Comparing installed plugins with plugins manifest"},
+				[]string{"Plugin Name", "Installed Version", "Manifest Version", "Action"},
+				[]string{"Other", "2.0.0", "uninstall"},
+				[]string{"Test1", "1.2.4", "install"},
+				[]string{"Newer", "3.0.0", "2.0.0", "downgrade"},
+				[]string{"Same", "1.0.0", "1.0.0", "reinstall, checksum differs"},
+				[]string{"Run 'cf plugins-sync -f " + manifestPath + "'"},
+			))
+
+			Expect(fakePluginRepo.GetPluginsCallCount()).To(Equal(0))
+			Expect(pluginConfig.SetPluginCallCount()).To(Equal(0))
+			Expect(pluginConfig.RemovePluginCallCount()).To(Equal(0))
+			Expect(otherBinary).To(BeAnExistingFile())
+		})
+	})
+
+	It("installs, updates and uninstalls plugins to match the manifest", func() {
+		runCommand("-f", manifestPath)
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Uninstalling plugin Other..."},
+			[]string{"Installing plugin Test1 1.2.4 from repository repo1..."},
+			[]string{"Looking up 'Test1' from repository 'repo1'"},
+			[]string{"OK"},
+			[]string{"Installed plugins match the plugins manifest."},
+		))
+
+		Expect(pluginConfig.RemovePluginCallCount()).To(Equal(1))
+		Expect(pluginConfig.RemovePluginArgsForCall(0)).To(Equal("Other"))
+		Expect(otherBinary).NotTo(BeAnExistingFile())
+
+		Expect(fakeChecksum.CheckSha1CallCount()).To(Equal(2))
+		Expect(fakeChecksum.CheckSha1ArgsForCall(0)).To(Equal("repo-sha1"))
+		Expect(fakeChecksum.CheckSha1ArgsForCall(1)).To(Equal("manifest-sha1"))
+
+		Expect(pluginConfig.SetPluginCallCount()).To(Equal(1))
+		name, metadata := pluginConfig.SetPluginArgsForCall(0)
+		Expect(name).To(Equal("Test1"))
+		Expect(metadata.Version).To(Equal(plugin.VersionType{Major: 1, Minor: 2, Build: 4}))
+		Expect(filepath.Join(pluginDir, "test_1.exe")).To(BeAnExistingFile())
+	})
+
+	It("fails when the download does not match the sha1 in the manifest", func() {
+		fakeChecksum.CheckSha1Stub = func(sha1 string) bool {
+			return sha1 != "manifest-sha1"
+		}
+
+		runCommand("-f", manifestPath)
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"Downloaded plugin binary's checksum does not match manifest-sha1"},
+		))
+		Expect(pluginConfig.SetPluginCallCount()).To(Equal(0))
+		Expect(pluginConfig.RemovePluginCallCount()).To(Equal(0))
+		Expect(otherBinary).To(BeAnExistingFile())
+	})
+
+	It("matches installed plugins with the manifest ignoring case", func() {
+		oldBinary := filepath.Join(pluginDir, "old_test_1.exe")
+		Expect(ioutil.WriteFile(oldBinary, []byte("old"), 0600)).To(Succeed())
+		pluginConfig.PluginsReturns(map[string]pluginconfig.PluginMetadata{
+			"Test1": {Location: oldBinary, Version: plugin.VersionType{Major: 1}},
+		})
+		writeManifest(`---
+plugins:
+- {name: test1, repo: repo1, version: 1.2.4, sha1: manifest-sha1}
+`)
+
+		runCommand("-f", manifestPath)
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Replacing plugin Test1 1.0.0 with 1.2.4 from repository repo1..."},
+			[]string{"Installed plugins match the plugins manifest."},
+		))
+		Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Uninstalling plugin"}))
+
+		Expect(pluginConfig.RemovePluginCallCount()).To(Equal(0))
+		Expect(pluginConfig.SetPluginCallCount()).To(Equal(1))
+		name, _ := pluginConfig.SetPluginArgsForCall(0)
+		Expect(name).To(Equal("Test1"))
+		Expect(oldBinary).NotTo(BeAnExistingFile())
+	})
+
+	Context("when a plugin to install has a command of an installed plugin", func() {
+		var installed map[string]pluginconfig.PluginMetadata
+
+		BeforeEach(func() {
+			installed = map[string]pluginconfig.PluginMetadata{
+				"Other": {
+					Location: otherBinary,
+					Version:  plugin.VersionType{Major: 2},
+					Commands: []plugin.Command{{Name: "test_1_cmd1"}},
+				},
+			}
+			pluginConfig.PluginsStub = func() map[string]pluginconfig.PluginMetadata {
+				return installed
+			}
+			pluginConfig.RemovePluginStub = func(name string) {
+				delete(installed, name)
+			}
+		})
+
+		It("installs it when that plugin is uninstalled", func() {
+			runCommand("-f", manifestPath)
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Uninstalling plugin Other..."},
+				[]string{"Installing plugin Test1 1.2.4 from repository repo1..."},
+				[]string{"Installed plugins match the plugins manifest."},
+			))
+			Expect(pluginConfig.SetPluginCallCount()).To(Equal(1))
+		})
+
+		It("fails without uninstalling anything when that plugin stays", func() {
+			writeManifest(`---
+plugins:
+- {name: Other, repo: repo1, version: 2.0.0, sha1: manifest-sha1}
+- {name: Test1, repo: repo1, version: 1.2.4, sha1: manifest-sha1}
+`)
+			installed["Stale"] = pluginconfig.PluginMetadata{Location: filepath.Join(pluginDir, "stale.exe")}
+
+			runCommand("-f", manifestPath)
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Command `test_1_cmd1` is a command/alias in plugin 'Other'"},
+			))
+			Expect(pluginConfig.RemovePluginCallCount()).To(Equal(0))
+			Expect(pluginConfig.SetPluginCallCount()).To(Equal(0))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Uninstalling plugin"}))
+		})
+	})
+
+	It("fails without changing anything when the repo offers another version", func() {
+		fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{
+			"repo1": {repoPlugin("Test1", "1.3.0")},
+		}, nil)
+
+		runCommand("-f", manifestPath)
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"Repository repo1 offers plugin Test1 1.3.0 instead of 1.2.4"},
+		))
+		Expect(pluginConfig.RemovePluginCallCount()).To(Equal(0))
+		Expect(otherBinary).To(BeAnExistingFile())
+	})
+
+	It("fails when the repo is not registered", func() {
+		writeManifest(`---
+plugins:
+- {name: Test1, repo: unknown, version: 1.2.4, sha1: manifest-sha1}
+`)
+
+		runCommand("-f", manifestPath)
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"Plugin repo named unknown is not registered"},
+		))
+		Expect(pluginConfig.RemovePluginCallCount()).To(Equal(0))
+	})
+})
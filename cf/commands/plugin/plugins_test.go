@@ -3,17 +3,23 @@ package plugin_test
 import (
 	"net/rpc"
 
+	"github.com/cloudfoundry/cli/cf/actors/pluginrepo/pluginrepofakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	plugincmd "github.com/cloudfoundry/cli/cf/commands/plugin"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/configuration/pluginconfig"
 	"github.com/cloudfoundry/cli/cf/configuration/pluginconfig/pluginconfigfakes"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/flags"
 	"github.com/cloudfoundry/cli/plugin"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
 	. "github.com/cloudfoundry/cli/testhelpers/matchers"
 	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
 	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
 
+	clipr "github.com/cloudfoundry-incubator/cli-plugin-repo/models"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -23,12 +29,16 @@ var _ = Describe("Plugins", func() {
 		ui                  *testterm.FakeUI
 		requirementsFactory *testreq.FakeReqFactory
 		config              *pluginconfigfakes.FakePluginConfiguration
+		coreConfig          coreconfig.Repository
+		fakePluginRepo      *pluginrepofakes.FakePluginRepo
 		deps                commandregistry.Dependency
 	)
 
 	updateCommandDependency := func(pluginCall bool) {
 		deps.UI = ui
 		deps.PluginConfig = config
+		deps.Config = coreConfig
+		deps.PluginRepo = fakePluginRepo
 		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("plugins").SetDependency(deps, pluginCall))
 	}
 
@@ -36,6 +46,8 @@ var _ = Describe("Plugins", func() {
 		ui = &testterm.FakeUI{}
 		requirementsFactory = &testreq.FakeReqFactory{}
 		config = new(pluginconfigfakes.FakePluginConfiguration)
+		coreConfig = testconfig.NewRepositoryWithDefaults()
+		fakePluginRepo = new(pluginrepofakes.FakePluginRepo)
 
 		rpc.DefaultServer = rpc.NewServer()
 	})
@@ -168,4 +180,70 @@ var _ = Describe("Plugins", func() {
 			[]string{"Test2", "test_2_cmd1", "help text for test_2_cmd1"},
 		))
 	})
+
+	It("marks pinned plugins", func() {
+		config.PluginsReturns(map[string]pluginconfig.PluginMetadata{
+			"Test1": {
+				Version:  plugin.VersionType{Major: 1, Minor: 2, Build: 3},
+				Commands: []plugin.Command{{Name: "test_1_cmd1"}},
+				Pinned:   true,
+			},
+		})
+
+		runCommand()
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Test1", "1.2.3 (pinned)", "test_1_cmd1"},
+		))
+	})
+
+	Context("when --outdated is provided", func() {
+		BeforeEach(func() {
+			coreConfig.SetPluginRepo(models.PluginRepo{Name: "repo1", URL: "http://repo1.example.com"})
+			coreConfig.SetPluginRepo(models.PluginRepo{Name: "repo2", URL: "http://repo2.example.com"})
+
+			config.PluginsReturns(map[string]pluginconfig.PluginMetadata{
+				"Test1":   {Version: plugin.VersionType{Major: 1, Minor: 2, Build: 3}},
+				"Test2":   {Version: plugin.VersionType{Major: 2}, Pinned: true},
+				"Current": {Version: plugin.VersionType{Major: 3}},
+				"Local":   {Version: plugin.VersionType{Major: 1}},
+			})
+		})
+
+		It("lists the plugins with a newer version in any repo", func() {
+			fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{
+				"repo1": {{Name: "Test1", Version: "1.3.0"}, {Name: "Current", Version: "3.0.0"}},
+				"repo2": {{Name: "test1", Version: "1.10"}, {Name: "Test2", Version: "2.0.1"}},
+			}, []string{"Error requesting from 'repo3'"})
+
+			runCommand("--outdated")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Searching the plugin repositories for newer versions of installed plugins..."},
+				[]string{"Error requesting from 'repo3'"},
+				[]string{"OK"},
+				[]string{"Plugin Name", "Version", "Latest Version", "Repository", "Pinned"},
+				[]string{"Test1", "1.2.3", "1.10.0", "repo2"},
+				[]string{"Test2", "2.0.0", "2.0.1", "repo2", "yes"},
+				[]string{"update-plugin --all"},
+			))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Current"}))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Local"}))
+		})
+
+		It("says when all plugins are up to date", func() {
+			fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{
+				"repo1": {{Name: "Test1", Version: "1.2.3"}},
+			}, nil)
+
+			runCommand("--outdated")
+
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"All installed plugins are up to date."}))
+		})
+
+		It("cannot be used with --checksum", func() {
+			Expect(runCommand("--outdated", "--checksum")).To(BeFalse())
+			Expect(fakePluginRepo.GetPluginsCallCount()).To(Equal(0))
+		})
+	})
 })
@@ -75,8 +75,13 @@ func (cmd *PluginUninstall) Execute(c flags.FlagContext) {
 		cmd.ui.Failed(fmt.Sprintf(T("Plugin name {{.PluginName}} does not exist", pluginNameMap)))
 	}
 
-	pluginMetadata := plugins[pluginName]
+	cmd.removePlugin(pluginName, plugins[pluginName])
 
+	cmd.ui.Ok()
+	cmd.ui.Say(fmt.Sprintf(T("Plugin {{.PluginName}} successfully uninstalled.", pluginNameMap)))
+}
+
+func (cmd *PluginUninstall) removePlugin(pluginName string, pluginMetadata pluginconfig.PluginMetadata) {
 	err := cmd.notifyPluginUninstalling(pluginMetadata)
 	if err != nil {
 		cmd.ui.Say("Error invoking plugin: " + err.Error() + ". Process to uninstall ...")
@@ -90,9 +95,6 @@ func (cmd *PluginUninstall) Execute(c flags.FlagContext) {
 	}
 
 	cmd.config.RemovePlugin(pluginName)
-
-	cmd.ui.Ok()
-	cmd.ui.Say(fmt.Sprintf(T("Plugin {{.PluginName}} successfully uninstalled.", pluginNameMap)))
 }
 
 func (cmd *PluginUninstall) notifyPluginUninstalling(meta pluginconfig.PluginMetadata) error {
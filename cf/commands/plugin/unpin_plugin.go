@@ -0,0 +1,61 @@
+package plugin
+
+import (
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/pluginconfig"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/flags"
+)
+
+type PluginUnpin struct {
+	ui     terminal.UI
+	config pluginconfig.PluginConfiguration
+}
+
+func init() {
+	commandregistry.Register(&PluginUnpin{})
+}
+
+func (cmd *PluginUnpin) MetaData() commandregistry.CommandMetadata {
+	return commandregistry.CommandMetadata{
+		Name:        "unpin-plugin",
+		Description: T("Let update-plugin --all update a pinned plugin again"),
+		Usage: []string{
+			T("CF_NAME unpin-plugin PLUGIN_NAME"),
+		},
+	}
+}
+
+func (cmd *PluginUnpin) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) []requirements.Requirement {
+	if len(fc.Args()) != 1 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires an argument\n\n") + commandregistry.Commands.CommandUsage("unpin-plugin"))
+	}
+
+	reqs := []requirements.Requirement{}
+	return reqs
+}
+
+func (cmd *PluginUnpin) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.PluginConfig
+	return cmd
+}
+
+func (cmd *PluginUnpin) Execute(c flags.FlagContext) {
+	pluginName := c.Args()[0]
+
+	cmd.ui.Say(T("Unpinning plugin {{.PluginName}}...", map[string]interface{}{"PluginName": pluginName}))
+
+	metadata, ok := cmd.config.Plugins()[pluginName]
+	if !ok {
+		cmd.ui.Failed(T("Plugin name {{.PluginName}} does not exist", map[string]interface{}{"PluginName": pluginName}))
+	}
+
+	metadata.Pinned = false
+	cmd.config.SetPlugin(pluginName, metadata)
+
+	cmd.ui.Ok()
+	cmd.ui.Say(T("Plugin {{.PluginName}} is no longer pinned.", map[string]interface{}{"PluginName": pluginName}))
+}
@@ -0,0 +1,145 @@
+package plugin
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/cloudfoundry/cli/cf/actors/pluginrepo"
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/configuration/pluginconfig"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/flags"
+)
+
+type PluginUpdate struct {
+	ui           terminal.UI
+	config       coreconfig.Reader
+	pluginConfig pluginconfig.PluginConfiguration
+	pluginRepo   pluginrepo.PluginRepo
+	installer    *PluginInstall
+}
+
+func init() {
+	commandregistry.Register(&PluginUpdate{})
+}
+
+func (cmd *PluginUpdate) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["all"] = &flags.BoolFlag{Name: "all", Usage: T("Update all installed plugins that are not pinned")}
+	fs["f"] = &flags.BoolFlag{ShortName: "f", Usage: T("Force update of plugins without confirmation")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "update-plugin",
+		Description: T("Update installed CLI plugins to the newest version in the plugin repositories"),
+		Usage: []string{
+			T(`CF_NAME update-plugin (PLUGIN_NAME | --all) [-f]
+
+   Prompts for confirmation unless '-f' is provided. Plugins pinned with 'CF_NAME pin-plugin' are only updated when named.`),
+		},
+		Examples: []string{
+			"CF_NAME update-plugin CLI-Recorder",
+			"CF_NAME update-plugin --all -f",
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *PluginUpdate) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) []requirements.Requirement {
+	if fc.Bool("all") && len(fc.Args()) != 0 {
+		cmd.ui.Failed(T("Incorrect Usage. No argument required with --all\n\n") + commandregistry.Commands.CommandUsage("update-plugin"))
+	}
+	if !fc.Bool("all") && len(fc.Args()) != 1 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires an argument\n\n") + commandregistry.Commands.CommandUsage("update-plugin"))
+	}
+
+	reqs := []requirements.Requirement{}
+	return reqs
+}
+
+func (cmd *PluginUpdate) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.pluginConfig = deps.PluginConfig
+	cmd.pluginRepo = deps.PluginRepo
+
+	//the plugin binaries are checked and installed the way install-plugin does
+	cmd.installer = new(PluginInstall).SetDependency(deps, pluginCall).(*PluginInstall)
+
+	return cmd
+}
+
+func (cmd *PluginUpdate) Execute(c flags.FlagContext) {
+	plugins := cmd.pluginConfig.Plugins()
+
+	var names []string
+	if c.Bool("all") {
+		for name := range plugins {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	} else {
+		names = []string{c.Args()[0]}
+		if _, ok := plugins[names[0]]; !ok {
+			cmd.ui.Failed(T("Plugin name {{.PluginName}} does not exist", map[string]interface{}{"PluginName": names[0]}))
+		}
+	}
+
+	cmd.ui.Say(T("Searching the plugin repositories for newer versions of installed plugins..."))
+
+	newest, repoErrors := newestRepoPlugins(cmd.pluginRepo, cmd.config.PluginRepos())
+	for _, repoError := range repoErrors {
+		cmd.ui.Warn(repoError)
+	}
+
+	updates := []string{}
+	for _, name := range names {
+		metadata := plugins[name]
+		available, found := newest[strings.ToLower(name)]
+		pluginNameMap := map[string]interface{}{"PluginName": name, "Version": formatPluginVersion(metadata.Version)}
+
+		switch {
+		case c.Bool("all") && metadata.Pinned:
+			cmd.ui.Say(T("Skipping plugin {{.PluginName}}, which is pinned at version {{.Version}}.", pluginNameMap))
+		case !found && c.Bool("all"):
+			continue
+		case !found:
+			cmd.ui.Failed(T("Plugin {{.PluginName}} is not available in any registered plugin repository", pluginNameMap) + "\n" + T("Tip: use 'add-plugin-repo' to register the repo"))
+		case compareVersions(available.Version, metadata.Version) <= 0:
+			cmd.ui.Say(T("Plugin {{.PluginName}} {{.Version}} is up to date.", pluginNameMap))
+		default:
+			updates = append(updates, name)
+		}
+	}
+
+	if len(updates) == 0 {
+		cmd.ui.Ok()
+		return
+	}
+
+	if !c.Bool("f") && !cmd.ui.Confirm(T("**Attention: Plugins are binaries written by potentially untrusted authors. Install and use plugins at your own risk.**\n\nDo you want to update the plugins {{.Plugins}}? (y or n)", map[string]interface{}{"Plugins": strings.Join(updates, ", ")})) {
+		cmd.ui.Failed(T("Plugin update cancelled"))
+	}
+
+	for _, name := range updates {
+		cmd.updatePlugin(name, plugins[name], newest[strings.ToLower(name)])
+	}
+
+	cmd.ui.Ok()
+}
+
+func (cmd *PluginUpdate) updatePlugin(name string, installed pluginconfig.PluginMetadata, available repoPlugin) {
+	cmd.ui.Say("")
+	cmd.ui.Say(T("Updating plugin {{.PluginName}} from {{.OldVersion}} to {{.NewVersion}}...",
+		map[string]interface{}{
+			"PluginName": name,
+			"OldVersion": formatPluginVersion(installed.Version),
+			"NewVersion": formatPluginVersion(available.Version),
+		}))
+
+	pluginMetadata := cmd.installer.installFromRepo(name, available, "")
+
+	cmd.ui.Say(T("Plugin {{.PluginName}} {{.Version}} successfully updated.", map[string]interface{}{"PluginName": name, "Version": formatPluginVersion(pluginMetadata.Version)}))
+}
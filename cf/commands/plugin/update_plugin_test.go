@@ -0,0 +1,216 @@
+package plugin_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/cloudfoundry/cli/cf/actors/pluginrepo/pluginrepofakes"
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/configuration/pluginconfig"
+	"github.com/cloudfoundry/cli/cf/configuration/pluginconfig/pluginconfigfakes"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/plugin"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+	"github.com/cloudfoundry/cli/utils/utilsfakes"
+
+	clipr "github.com/cloudfoundry-incubator/cli-plugin-repo/models"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("update-plugin", func() {
+	var (
+		ui                  *testterm.FakeUI
+		requirementsFactory *testreq.FakeReqFactory
+		config              coreconfig.Repository
+		pluginConfig        *pluginconfigfakes.FakePluginConfiguration
+		fakePluginRepo      *pluginrepofakes.FakePluginRepo
+		fakeChecksum        *utilsfakes.FakeSha1Checksum
+		deps                commandregistry.Dependency
+
+		homeDir    string
+		pluginDir  string
+		oldBinary  string
+		testServer *httptest.Server
+	)
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.Config = config
+		deps.PluginConfig = pluginConfig
+		deps.PluginRepo = fakePluginRepo
+		deps.ChecksumUtil = fakeChecksum
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("update-plugin").SetDependency(deps, pluginCall))
+	}
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("update-plugin", args, requirementsFactory, updateCommandDependency, false)
+	}
+
+	repoPlugin := func(name string, version string) clipr.Plugin {
+		p := clipr.Plugin{Name: name, Version: version}
+		for _, platform := range []string{"osx", "win32", "win64", "linux32", "linux64"} {
+			p.Binaries = append(p.Binaries, clipr.Binary{Platform: platform, Url: testServer.URL + "/test_1.exe"})
+		}
+		return p
+	}
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		requirementsFactory = &testreq.FakeReqFactory{}
+		config = testconfig.NewRepositoryWithDefaults()
+		pluginConfig = new(pluginconfigfakes.FakePluginConfiguration)
+		fakePluginRepo = new(pluginrepofakes.FakePluginRepo)
+		fakeChecksum = new(utilsfakes.FakeSha1Checksum)
+		fakeChecksum.CheckSha1Returns(true)
+
+		var err error
+		homeDir, err = ioutil.TempDir(os.TempDir(), "plugins")
+		Expect(err).ToNot(HaveOccurred())
+		pluginDir = filepath.Join(homeDir, ".cf", "plugins")
+		pluginConfig.GetPluginPathReturns(pluginDir)
+
+		Expect(os.MkdirAll(pluginDir, 0700)).To(Succeed())
+		oldBinary = filepath.Join(pluginDir, "test_1_old.exe")
+		Expect(ioutil.WriteFile(oldBinary, []byte("old"), 0700)).To(Succeed())
+
+		dir, err := os.Getwd()
+		Expect(err).ToNot(HaveOccurred())
+		test1 := filepath.Join(dir, "..", "..", "..", "fixtures", "plugins", "test_1.exe")
+		if runtime.GOOS != "windows" {
+			Expect(os.Chmod(test1, 0700)).To(Succeed())
+		}
+
+		testServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.ServeFile(w, r, test1)
+		}))
+
+		config.SetPluginRepo(models.PluginRepo{Name: "repo1", URL: "http://repo1.example.com"})
+		config.SetPluginRepo(models.PluginRepo{Name: "repo2", URL: "http://repo2.example.com"})
+		fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{
+			"repo1": {repoPlugin("Test1", "1.0.0")},
+			"repo2": {repoPlugin("test1", "1.2.4"), repoPlugin("Other", "2.0")},
+		}, nil)
+
+		pluginConfig.PluginsReturns(map[string]pluginconfig.PluginMetadata{
+			"Test1": {
+				Location: oldBinary,
+				Version:  plugin.VersionType{Major: 1, Minor: 0, Build: 0},
+				Commands: []plugin.Command{{Name: "test_1_cmd1"}},
+			},
+			"Other": {
+				Location: filepath.Join(pluginDir, "other.exe"),
+				Version:  plugin.VersionType{Major: 2},
+				Pinned:   true,
+			},
+		})
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+		os.RemoveAll(homeDir)
+	})
+
+	Describe("requirements", func() {
+		It("fails with usage when not provided a plugin name or --all", func() {
+			runCommand()
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"Incorrect Usage", "Requires an argument"}))
+		})
+
+		It("fails with usage when provided both a plugin name and --all", func() {
+			runCommand("Test1", "--all")
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"Incorrect Usage", "No argument required with --all"}))
+		})
+	})
+
+	It("fails when the plugin is not installed", func() {
+		runCommand("Missing", "-f")
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"Plugin name Missing does not exist"},
+		))
+	})
+
+	It("fails when no repo has the plugin", func() {
+		fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{}, nil)
+
+		runCommand("Test1", "-f")
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"Plugin Test1 is not available in any registered plugin repository"},
+		))
+	})
+
+	It("does nothing when the plugin is up to date", func() {
+		runCommand("Other", "-f")
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"Plugin Other 2.0.0 is up to date."}))
+		Expect(pluginConfig.SetPluginCallCount()).To(Equal(0))
+	})
+
+	It("updates the plugin from the repo with the newest version", func() {
+		runCommand("Test1", "-f")
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Updating plugin Test1 from 1.0.0 to 1.2.4"},
+			[]string{"Looking up 'test1' from repository 'repo2'"},
+			[]string{"Plugin Test1 1.2.4 successfully updated."},
+			[]string{"OK"},
+		))
+		Expect(fakeChecksum.CheckSha1CallCount()).To(Equal(1))
+
+		Expect(pluginConfig.SetPluginCallCount()).To(Equal(1))
+		name, metadata := pluginConfig.SetPluginArgsForCall(0)
+		Expect(name).To(Equal("Test1"))
+		Expect(metadata.Version).To(Equal(plugin.VersionType{Major: 1, Minor: 2, Build: 4}))
+		Expect(metadata.Location).To(Equal(filepath.Join(pluginDir, "test_1.exe")))
+
+		Expect(filepath.Join(pluginDir, "test_1.exe")).To(BeAnExistingFile())
+		Expect(oldBinary).NotTo(BeAnExistingFile())
+	})
+
+	It("fails when the checksum of the download does not match the repo", func() {
+		fakeChecksum.CheckSha1Returns(false)
+
+		runCommand("Test1", "-f")
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"checksum does not match"},
+		))
+		Expect(pluginConfig.SetPluginCallCount()).To(Equal(0))
+		Expect(oldBinary).To(BeAnExistingFile())
+	})
+
+	It("asks for confirmation unless -f is provided", func() {
+		ui.Inputs = []string{"n"}
+
+		runCommand("Test1")
+		Expect(ui.Prompts).To(ContainSubstrings([]string{"Do you want to update the plugins Test1?"}))
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"Plugin update cancelled"}))
+		Expect(pluginConfig.SetPluginCallCount()).To(Equal(0))
+	})
+
+	Context("with --all", func() {
+		It("skips pinned plugins", func() {
+			fakePluginRepo.GetPluginsReturns(map[string][]clipr.Plugin{
+				"repo2": {repoPlugin("Test1", "1.2.4"), repoPlugin("Other", "3.0")},
+			}, nil)
+
+			runCommand("--all", "-f")
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Skipping plugin Other, which is pinned at version 2.0.0."},
+				[]string{"Plugin Test1 1.2.4 successfully updated."},
+			))
+			Expect(pluginConfig.SetPluginCallCount()).To(Equal(1))
+		})
+	})
+})
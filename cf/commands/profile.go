@@ -0,0 +1,104 @@
+package commands
+
+import (
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/flags"
+)
+
+type Profile struct {
+	ui          terminal.UI
+	config      coreconfig.ReadWriter
+	profileRepo coreconfig.ProfileRepository
+}
+
+func init() {
+	commandregistry.Register(&Profile{})
+}
+
+func (cmd *Profile) MetaData() commandregistry.CommandMetadata {
+	return commandregistry.CommandMetadata{
+		Name:        "profile",
+		Description: T("Save, switch to or delete a named target profile"),
+		Usage: []string{
+			T("CF_NAME profile (save | use | delete) PROFILE_NAME"),
+		},
+		Examples: []string{
+			"CF_NAME profile save production",
+			"CF_NAME profile use production",
+			"CF_NAME --profile production apps",
+		},
+	}
+}
+
+func (cmd *Profile) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) []requirements.Requirement {
+	if len(fc.Args()) != 2 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires an action and a profile name as arguments\n\n") + commandregistry.Commands.CommandUsage("profile"))
+	}
+
+	reqs := []requirements.Requirement{}
+	return reqs
+}
+
+func (cmd *Profile) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.profileRepo = deps.ProfileRepo
+	return cmd
+}
+
+func (cmd *Profile) Execute(c flags.FlagContext) {
+	action, name := c.Args()[0], c.Args()[1]
+
+	switch action {
+	case "save":
+		cmd.save(name)
+	case "use":
+		cmd.use(name)
+	case "delete":
+		cmd.delete(name)
+	default:
+		cmd.ui.Failed(T("Incorrect Usage. Unknown action {{.Action}}\n\n", map[string]interface{}{"Action": action}) + commandregistry.Commands.CommandUsage("profile"))
+	}
+}
+
+func (cmd *Profile) save(name string) {
+	cmd.ui.Say(T("Saving current target as profile {{.Name}}...", map[string]interface{}{"Name": terminal.EntityNameColor(name)}))
+
+	err := cmd.profileRepo.Save(name, cmd.config)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+}
+
+func (cmd *Profile) use(name string) {
+	cmd.ui.Say(T("Switching to profile {{.Name}}...", map[string]interface{}{"Name": terminal.EntityNameColor(name)}))
+
+	err := cmd.profileRepo.Use(name, cmd.config)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+	cmd.ui.ShowConfiguration(cmd.config)
+}
+
+func (cmd *Profile) delete(name string) {
+	cmd.ui.Say(T("Deleting profile {{.Name}}...", map[string]interface{}{"Name": terminal.EntityNameColor(name)}))
+
+	err := cmd.profileRepo.Delete(name)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+}
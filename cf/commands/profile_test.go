@@ -0,0 +1,134 @@
+package commands_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig/coreconfigfakes"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("profile command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		configRepo          coreconfig.Repository
+		profileRepo         *coreconfigfakes.FakeProfileRepository
+		requirementsFactory *testreq.FakeReqFactory
+		deps                commandregistry.Dependency
+	)
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.Config = configRepo
+		deps.ProfileRepo = profileRepo
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("profile").SetDependency(deps, pluginCall))
+	}
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		profileRepo = new(coreconfigfakes.FakeProfileRepository)
+		requirementsFactory = &testreq.FakeReqFactory{}
+	})
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("profile", args, requirementsFactory, updateCommandDependency, false)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage when not given an action and a name", func() {
+			runCommand("save")
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "Requires an action and a profile name"},
+			))
+		})
+
+		It("fails with usage when given an unknown action", func() {
+			runCommand("rename", "production")
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "Unknown action rename"},
+			))
+		})
+
+		It("does not require a login", func() {
+			Expect(runCommand("save", "production")).To(BeTrue())
+		})
+	})
+
+	Describe("save", func() {
+		It("saves the current config as the profile", func() {
+			runCommand("save", "production")
+
+			Expect(profileRepo.SaveCallCount()).To(Equal(1))
+			name, config := profileRepo.SaveArgsForCall(0)
+			Expect(name).To(Equal("production"))
+			Expect(config).To(Equal(configRepo))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Saving current target as profile", "production"},
+				[]string{"OK"},
+			))
+		})
+
+		It("fails when the profile cannot be saved", func() {
+			profileRepo.SaveReturns(errors.New("disk full"))
+			runCommand("save", "production")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"disk full"},
+			))
+		})
+	})
+
+	Describe("use", func() {
+		It("switches the config to the profile", func() {
+			profileRepo.UseStub = func(name string, config coreconfig.ReadWriter) error {
+				config.SetAPIEndpoint("https://api.production.example.com")
+				return nil
+			}
+
+			runCommand("use", "production")
+
+			Expect(profileRepo.UseCallCount()).To(Equal(1))
+			name, _ := profileRepo.UseArgsForCall(0)
+			Expect(name).To(Equal("production"))
+			Expect(configRepo.APIEndpoint()).To(Equal("https://api.production.example.com"))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Switching to profile", "production"},
+				[]string{"OK"},
+			))
+			Expect(ui.ShowConfigurationCalled).To(BeTrue())
+		})
+
+		It("fails when the profile does not exist", func() {
+			profileRepo.UseReturns(errors.New("Profile production not found"))
+			runCommand("use", "production")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Profile production not found"},
+			))
+		})
+	})
+
+	Describe("delete", func() {
+		It("deletes the profile", func() {
+			runCommand("delete", "production")
+
+			Expect(profileRepo.DeleteCallCount()).To(Equal(1))
+			Expect(profileRepo.DeleteArgsForCall(0)).To(Equal("production"))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Deleting profile", "production"},
+				[]string{"OK"},
+			))
+		})
+	})
+})
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/flags"
+)
+
+type ListProfiles struct {
+	ui          terminal.UI
+	profileRepo coreconfig.ProfileRepository
+}
+
+func init() {
+	commandregistry.Register(&ListProfiles{})
+}
+
+func (cmd *ListProfiles) MetaData() commandregistry.CommandMetadata {
+	return commandregistry.CommandMetadata{
+		Name:        "profiles",
+		Description: T("List saved target profiles"),
+		Usage: []string{
+			T("CF_NAME profiles"),
+		},
+	}
+}
+
+func (cmd *ListProfiles) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) []requirements.Requirement {
+	usageReq := requirements.NewUsageRequirement(commandregistry.CLICommandUsagePresenter(cmd),
+		T("No argument required"),
+		func() bool {
+			return len(fc.Args()) != 0
+		},
+	)
+
+	reqs := []requirements.Requirement{
+		usageReq,
+	}
+
+	return reqs
+}
+
+func (cmd *ListProfiles) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.profileRepo = deps.ProfileRepo
+	return cmd
+}
+
+func (cmd *ListProfiles) Execute(c flags.FlagContext) {
+	cmd.ui.Say(T("Getting profiles..."))
+
+	names, err := cmd.profileRepo.List()
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	if len(names) == 0 {
+		cmd.ui.Say(T("No profiles found"))
+		return
+	}
+
+	table := cmd.ui.Table([]string{T("name"), T("api endpoint"), T("org"), T("space"), T("user")})
+
+	for _, name := range names {
+		profile, err := cmd.profileRepo.Get(name)
+		if err != nil {
+			cmd.ui.Warn(T("Could not read profile {{.Name}}: {{.Err}}", map[string]interface{}{"Name": name, "Err": err.Error()}))
+			continue
+		}
+
+		table.Add(name, profile.APIEndpoint(), profile.OrganizationFields().Name, profile.SpaceFields().Name, profile.Username())
+	}
+
+	table.Print()
+}
@@ -0,0 +1,73 @@
+package commands_test
+
+import (
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig/coreconfigfakes"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("profiles command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		profileRepo         *coreconfigfakes.FakeProfileRepository
+		requirementsFactory *testreq.FakeReqFactory
+		deps                commandregistry.Dependency
+	)
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.ProfileRepo = profileRepo
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("profiles").SetDependency(deps, pluginCall))
+	}
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		profileRepo = new(coreconfigfakes.FakeProfileRepository)
+		requirementsFactory = &testreq.FakeReqFactory{}
+	})
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("profiles", args, requirementsFactory, updateCommandDependency, false)
+	}
+
+	It("lists the saved profiles with their targets", func() {
+		production := testconfig.NewRepositoryWithDefaults()
+		production.SetAPIEndpoint("https://api.production.example.com")
+		production.SetOrganizationFields(models.OrganizationFields{Name: "prod-org"})
+		production.SetSpaceFields(models.SpaceFields{Name: "prod-space"})
+
+		profileRepo.ListReturns([]string{"production"}, nil)
+		profileRepo.GetStub = func(name string) (coreconfig.Reader, error) {
+			return production, nil
+		}
+
+		runCommand()
+
+		Expect(profileRepo.GetArgsForCall(0)).To(Equal("production"))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Getting profiles..."},
+			[]string{"OK"},
+			[]string{"name", "api endpoint", "org", "space", "user"},
+			[]string{"production", "https://api.production.example.com", "prod-org", "prod-space"},
+		))
+	})
+
+	It("tells the user when there are no profiles", func() {
+		profileRepo.ListReturns([]string{}, nil)
+
+		runCommand()
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"No profiles found"},
+		))
+	})
+})
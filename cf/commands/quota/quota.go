@@ -33,6 +33,7 @@ func (cmd *showQuota) MetaData() commandregistry.CommandMetadata {
 			T("CF_NAME quota QUOTA"),
 		},
 		Description: T("Show quota info"),
+		Records:     true,
 	}
 }
 
@@ -64,6 +65,11 @@ func (cmd *showQuota) Execute(c flags.FlagContext) {
 		cmd.ui.Failed(err.Error())
 	}
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		cmd.ui.PrintStructured(newQuotaRecord(quota))
+		return
+	}
+
 	cmd.ui.Ok()
 
 	var megabytes string
@@ -1,6 +1,8 @@
 package quota_test
 
 import (
+	"encoding/json"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -9,6 +11,7 @@ import (
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/errors"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
 	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
@@ -95,6 +98,20 @@ var _ = Describe("quota", func() {
 						[]string{"Reserved Route Ports", "5"},
 					))
 				})
+
+				It("prints the quota as a record with --output json", func() {
+					ui.Format = terminal.JSONOutput
+
+					runCommand("muh-muh-muh-my-qua-quota")
+
+					Expect(ui.StructuredOutputs).To(HaveLen(1))
+					encoded, err := json.Marshal(ui.StructuredOutputs[0])
+					Expect(err).NotTo(HaveOccurred())
+					Expect(encoded).To(MatchJSON(`{"name": "muh-muh-muh-my-qua-quota", "guid": "my-quota-guid", "memory_limit_in_mb": 512,
+						"instance_memory_limit_in_mb": 5, "total_routes": 2000, "total_services": 47, "paid_service_plans_allowed": true,
+						"app_instance_limit": 7, "total_reserved_route_ports": "5"}`))
+					Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Total Memory"}))
+				})
 			})
 
 			Context("when the app instance limit is -1", func() {
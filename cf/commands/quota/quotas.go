@@ -12,10 +12,39 @@ import (
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/formatters"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
 )
 
+// quotaRecord is the machine readable form of a quota printed by the
+// quotas and quota commands. Limits of -1 are unlimited.
+type quotaRecord struct {
+	Name                    string `json:"name" yaml:"name"`
+	GUID                    string `json:"guid" yaml:"guid"`
+	MemoryLimit             int64  `json:"memory_limit_in_mb" yaml:"memory_limit_in_mb"`
+	InstanceMemoryLimit     int64  `json:"instance_memory_limit_in_mb" yaml:"instance_memory_limit_in_mb"`
+	RoutesLimit             int    `json:"total_routes" yaml:"total_routes"`
+	ServicesLimit           int    `json:"total_services" yaml:"total_services"`
+	NonBasicServicesAllowed bool   `json:"paid_service_plans_allowed" yaml:"paid_service_plans_allowed"`
+	AppInstanceLimit        int    `json:"app_instance_limit" yaml:"app_instance_limit"`
+	ReservedRoutePorts      string `json:"total_reserved_route_ports,omitempty" yaml:"total_reserved_route_ports,omitempty"`
+}
+
+func newQuotaRecord(quota models.QuotaFields) quotaRecord {
+	return quotaRecord{
+		Name:                    quota.Name,
+		GUID:                    quota.GUID,
+		MemoryLimit:             quota.MemoryLimit,
+		InstanceMemoryLimit:     quota.InstanceMemoryLimit,
+		RoutesLimit:             quota.RoutesLimit,
+		ServicesLimit:           quota.ServicesLimit,
+		NonBasicServicesAllowed: quota.NonBasicServicesAllowed,
+		AppInstanceLimit:        quota.AppInstanceLimit,
+		ReservedRoutePorts:      string(quota.ReservedRoutePorts),
+	}
+}
+
 type ListQuotas struct {
 	ui        terminal.UI
 	config    coreconfig.Reader
@@ -33,6 +62,7 @@ func (cmd *ListQuotas) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			T("CF_NAME quotas"),
 		},
+		Records: true,
 	}
 }
 
@@ -71,6 +101,15 @@ func (cmd *ListQuotas) Execute(c flags.FlagContext) {
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		records := make([]quotaRecord, 0, len(quotas))
+		for _, quota := range quotas {
+			records = append(records, newQuotaRecord(quota))
+		}
+		cmd.ui.PrintStructured(records)
+		return
+	}
+
 	table := cmd.ui.Table([]string{
 		T("name"),
 		T("total memory"),
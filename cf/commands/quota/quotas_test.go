@@ -1,6 +1,8 @@
 package quota_test
 
 import (
+	"encoding/json"
+
 	"github.com/cloudfoundry/cli/cf/api/quotas/quotasfakes"
 	"github.com/cloudfoundry/cli/cf/commands/quota"
 	"github.com/cloudfoundry/cli/cf/errors"
@@ -121,6 +123,25 @@ var _ = Describe("quotas command", func() {
 			Expect(terminal.Decolorize(ui.Outputs[6])).To(MatchRegexp("quota-unlimited-routes\\s*434M\\s*1M\\s*unlimited\\s*2\\s*disallowed\\s*10\\s*4"))
 		})
 
+		It("prints the quotas as records with --output json", func() {
+			ui.Format = terminal.JSONOutput
+
+			Expect(runCommand()).To(HavePassedRequirements())
+
+			Expect(ui.StructuredOutputs).To(HaveLen(1))
+			encoded, err := json.Marshal(ui.StructuredOutputs[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encoded).To(MatchJSON(`[
+				{"name": "quota-name", "guid": "", "memory_limit_in_mb": 1024, "instance_memory_limit_in_mb": 512, "total_routes": 111,
+				 "total_services": 222, "paid_service_plans_allowed": true, "app_instance_limit": -1, "total_reserved_route_ports": "4"},
+				{"name": "quota-non-basic-not-allowed", "guid": "", "memory_limit_in_mb": 434, "instance_memory_limit_in_mb": -1, "total_routes": 1,
+				 "total_services": 2, "paid_service_plans_allowed": false, "app_instance_limit": 10, "total_reserved_route_ports": "4"},
+				{"name": "quota-unlimited-routes", "guid": "", "memory_limit_in_mb": 434, "instance_memory_limit_in_mb": 1, "total_routes": -1,
+				 "total_services": 2, "paid_service_plans_allowed": false, "app_instance_limit": 10, "total_reserved_route_ports": "4"}
+			]`))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"quota-name"}))
+		})
+
 		It("displays unlimited services properly", func() {
 			quotaRepo.FindAllReturns([]models.QuotaFields{
 				{
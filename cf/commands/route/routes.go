@@ -15,6 +15,20 @@ import (
 	"github.com/cloudfoundry/cli/cf/terminal"
 )
 
+// routeRecord is the machine readable form of a route printed by the
+// routes command.
+type routeRecord struct {
+	GUID            string   `json:"guid" yaml:"guid"`
+	Space           string   `json:"space" yaml:"space"`
+	Host            string   `json:"host" yaml:"host"`
+	Domain          string   `json:"domain" yaml:"domain"`
+	Port            int      `json:"port,omitempty" yaml:"port,omitempty"`
+	Path            string   `json:"path" yaml:"path"`
+	Type            string   `json:"type" yaml:"type"`
+	Apps            []string `json:"apps" yaml:"apps"`
+	ServiceInstance string   `json:"service" yaml:"service"`
+}
+
 type ListRoutes struct {
 	ui         terminal.UI
 	routeRepo  api.RouteRepository
@@ -37,7 +51,8 @@ func (cmd *ListRoutes) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			"CF_NAME routes [--orglevel]",
 		},
-		Flags: fs,
+		Flags:   fs,
+		Records: true,
 	}
 }
 
@@ -96,6 +111,7 @@ func (cmd *ListRoutes) Execute(c flags.FlagContext) {
 	}
 
 	var routesFound bool
+	records := []routeRecord{}
 	cb := func(route models.Route) bool {
 		routesFound = true
 		appNames := []string{}
@@ -110,6 +126,18 @@ func (cmd *ListRoutes) Execute(c flags.FlagContext) {
 
 		domain := d[route.Domain.GUID]
 
+		records = append(records, routeRecord{
+			GUID:            route.GUID,
+			Space:           route.Space.Name,
+			Host:            route.Host,
+			Domain:          route.Domain.Name,
+			Port:            route.Port,
+			Path:            route.Path,
+			Type:            domain.RouterGroupType,
+			Apps:            appNames,
+			ServiceInstance: route.ServiceInstance.Name,
+		})
+
 		table.Add(
 			route.Space.Name,
 			route.Host,
@@ -129,11 +157,18 @@ func (cmd *ListRoutes) Execute(c flags.FlagContext) {
 		err = cmd.routeRepo.ListRoutes(cb)
 	}
 
-	table.Print()
 	if err != nil {
+		table.Print()
 		cmd.ui.Failed(T("Failed fetching routes.\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
 	}
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		cmd.ui.PrintStructured(records)
+		return
+	}
+
+	table.Print()
+
 	if !routesFound {
 		cmd.ui.Say(T("No routes found"))
 	}
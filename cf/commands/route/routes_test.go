@@ -1,6 +1,7 @@
 package route_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/cloudfoundry/cli/cf/api/apifakes"
@@ -160,6 +161,25 @@ var _ = Describe("routes command", func() {
 			Expect(terminal.Decolorize(ui.Outputs[5])).To(MatchRegexp(`^my-space\s+cookieclicker\.co\s+9090\s+tcp\s+dora,bora\s*$`))
 
 		})
+
+		It("prints the routes as records with --output json", func() {
+			ui.Format = terminal.JSONOutput
+
+			runCommand()
+
+			Expect(ui.StructuredOutputs).To(HaveLen(1))
+			encoded, err := json.Marshal(ui.StructuredOutputs[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encoded).To(MatchJSON(`[
+				{"guid": "", "space": "my-space", "host": "hostname-1", "domain": "example.com", "path": "", "type": "",
+				 "apps": ["dora"], "service": "test-service"},
+				{"guid": "", "space": "my-space", "host": "hostname-2", "domain": "cookieclicker.co", "path": "/foo", "type": "",
+				 "apps": ["dora", "bora"], "service": ""},
+				{"guid": "", "space": "my-space", "host": "", "domain": "cookieclicker.co", "port": 9090, "path": "", "type": "tcp",
+				 "apps": ["dora", "bora"], "service": ""}
+			]`))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"hostname-1"}))
+		})
 	})
 
 	Context("when there are routes in different spaces", func() {
@@ -11,6 +11,14 @@ import (
 	"github.com/cloudfoundry/cli/flags"
 )
 
+// routerGroupRecord is the machine readable form of a router group
+// printed by the router-groups command.
+type routerGroupRecord struct {
+	Name string `json:"name" yaml:"name"`
+	GUID string `json:"guid" yaml:"guid"`
+	Type string `json:"type" yaml:"type"`
+}
+
 type RouterGroups struct {
 	ui             terminal.UI
 	routingAPIRepo api.RoutingAPIRepository
@@ -28,6 +36,7 @@ func (cmd *RouterGroups) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			"CF_NAME router-groups",
 		},
+		Records: true,
 	}
 }
 
@@ -59,8 +68,10 @@ func (cmd *RouterGroups) Execute(c flags.FlagContext) {
 	table := cmd.ui.Table([]string{T("name"), T("type")})
 
 	noRouterGroups := true
+	records := []routerGroupRecord{}
 	cb := func(group models.RouterGroup) bool {
 		noRouterGroups = false
+		records = append(records, routerGroupRecord{Name: group.Name, GUID: group.GUID, Type: group.Type})
 		table.Add(group.Name, group.Type)
 		return true
 	}
@@ -71,6 +82,11 @@ func (cmd *RouterGroups) Execute(c flags.FlagContext) {
 		return
 	}
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		cmd.ui.PrintStructured(records)
+		return
+	}
+
 	if noRouterGroups {
 		cmd.ui.Say(T("No router groups found"))
 	}
@@ -1,6 +1,7 @@
 package routergroups_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/cloudfoundry/cli/cf/api"
@@ -9,6 +10,7 @@ import (
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements/requirementsfakes"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
 	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
@@ -120,6 +122,18 @@ var _ = Describe("RouterGroups", func() {
 				[]string{"default-router-group", "tcp"},
 			))
 		})
+
+		It("prints the router groups as records with --output json", func() {
+			ui.Format = terminal.JSONOutput
+
+			runCommand()
+
+			Expect(ui.StructuredOutputs).To(HaveLen(1))
+			encoded, err := json.Marshal(ui.StructuredOutputs[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encoded).To(MatchJSON(`[{"name": "default-router-group", "guid": "guid-0001", "type": "tcp"}]`))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"default-router-group", "tcp"}))
+		})
 	})
 
 	Context("when there are no router groups", func() {
@@ -31,6 +31,7 @@ func (cmd *ShowSecurityGroup) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			T("CF_NAME security-group SECURITY_GROUP"),
 		},
+		Records: true,
 	}
 }
 
@@ -67,6 +68,11 @@ func (cmd *ShowSecurityGroup) Execute(c flags.FlagContext) {
 		cmd.ui.Failed(err.Error())
 	}
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		cmd.ui.PrintStructured(newSecurityGroupRecord(securityGroup))
+		return
+	}
+
 	jsonEncodedBytes, encodingErr := json.MarshalIndent(securityGroup.Rules, "\t", "\t")
 	if encodingErr != nil {
 		cmd.ui.Failed(encodingErr.Error())
@@ -1,10 +1,13 @@
 package securitygroup_test
 
 import (
+	"encoding/json"
+
 	"github.com/cloudfoundry/cli/cf/api/securitygroups/securitygroupsfakes"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/errors"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
 	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
@@ -108,6 +111,22 @@ var _ = Describe("security-group command", func() {
 				))
 			})
 
+			It("prints the group as a record with --output json", func() {
+				ui.Format = terminal.JSONOutput
+
+				runCommand("my-group")
+
+				Expect(ui.StructuredOutputs).To(HaveLen(1))
+				encoded, err := json.Marshal(ui.StructuredOutputs[0])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(encoded).To(MatchJSON(`{"name": "my-group", "guid": "group-guid",
+					"rules": [{"just-pretend": "that-this-is-correct"}], "spaces": [
+						{"organization": "org-1", "space": "space-1"},
+						{"organization": "org-2", "space": "space-2"}
+					]}`))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"#0", "org-1", "space-1"}))
+			})
+
 			It("tells the user if no spaces are assigned", func() {
 				securityGroup := models.SecurityGroup{
 					SecurityGroupFields: models.SecurityGroupFields{
@@ -14,6 +14,36 @@ import (
 	"github.com/cloudfoundry/cli/cf/terminal"
 )
 
+// securityGroupRecord is the machine readable form of a security group
+// printed by the security-groups and security-group commands.
+type securityGroupRecord struct {
+	Name   string                     `json:"name" yaml:"name"`
+	GUID   string                     `json:"guid" yaml:"guid"`
+	Rules  []map[string]interface{}   `json:"rules" yaml:"rules"`
+	Spaces []securityGroupSpaceRecord `json:"spaces" yaml:"spaces"`
+}
+
+type securityGroupSpaceRecord struct {
+	Organization string `json:"organization" yaml:"organization"`
+	Space        string `json:"space" yaml:"space"`
+}
+
+func newSecurityGroupRecord(securityGroup models.SecurityGroup) securityGroupRecord {
+	record := securityGroupRecord{
+		Name:   securityGroup.Name,
+		GUID:   securityGroup.GUID,
+		Rules:  securityGroup.Rules,
+		Spaces: []securityGroupSpaceRecord{},
+	}
+	for _, space := range securityGroup.Spaces {
+		record.Spaces = append(record.Spaces, securityGroupSpaceRecord{
+			Organization: space.Organization.Name,
+			Space:        space.Name,
+		})
+	}
+	return record
+}
+
 type SecurityGroups struct {
 	ui                terminal.UI
 	securityGroupRepo security_groups.SecurityGroupRepo
@@ -31,6 +61,7 @@ func (cmd *SecurityGroups) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			"CF_NAME security-groups",
 		},
+		Records: true,
 	}
 }
 
@@ -70,6 +101,15 @@ func (cmd *SecurityGroups) Execute(c flags.FlagContext) {
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		records := make([]securityGroupRecord, 0, len(securityGroups))
+		for _, securityGroup := range securityGroups {
+			records = append(records, newSecurityGroupRecord(securityGroup))
+		}
+		cmd.ui.PrintStructured(records)
+		return
+	}
+
 	if len(securityGroups) == 0 {
 		cmd.ui.Say(T("No security groups"))
 		return
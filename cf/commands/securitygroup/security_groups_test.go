@@ -1,11 +1,14 @@
 package securitygroup_test
 
 import (
+	"encoding/json"
+
 	"github.com/cloudfoundry/cli/cf/api/securitygroups/securitygroupsfakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/errors"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
@@ -150,6 +153,21 @@ var _ = Describe("list-security-groups command", func() {
 						[]string{"#0", "my-group", "org-2", "space-2"},
 					))
 				})
+
+				It("prints the security groups as records with --output json", func() {
+					ui.Format = terminal.JSONOutput
+
+					runCommand()
+
+					Expect(ui.StructuredOutputs).To(HaveLen(1))
+					encoded, err := json.Marshal(ui.StructuredOutputs[0])
+					Expect(err).NotTo(HaveOccurred())
+					Expect(encoded).To(MatchJSON(`[{"name": "my-group", "guid": "group-guid", "rules": null, "spaces": [
+						{"organization": "org-1", "space": "space-1"},
+						{"organization": "org-2", "space": "space-2"}
+					]}]`))
+					Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"#0", "my-group"}))
+				})
 			})
 
 			Describe("Where there are no spaces assigned", func() {
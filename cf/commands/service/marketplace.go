@@ -38,7 +38,8 @@ func (cmd *MarketplaceServices) MetaData() commandregistry.CommandMetadata {
 			"CF_NAME marketplace ",
 			fmt.Sprintf("[-s %s] ", T("SERVICE")),
 		},
-		Flags: fs,
+		Flags:   fs,
+		Records: true,
 	}
 }
 
@@ -105,10 +106,18 @@ func (cmd MarketplaceServices) marketplaceByService(serviceName string) {
 	cmd.ui.Say("")
 
 	if serviceOffering.GUID == "" {
+		if cmd.ui.OutputFormat().IsStructured() {
+			cmd.ui.Failed(T("Service offering not found"))
+		}
 		cmd.ui.Say(T("Service offering not found"))
 		return
 	}
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		cmd.ui.PrintStructured(newServiceOfferingRecord(serviceOffering))
+		return
+	}
+
 	table := cmd.ui.Table([]string{T("service plan"), T("description"), T("free or paid")})
 	for _, plan := range serviceOffering.Plans {
 		var freeOrPaid string
@@ -152,6 +161,17 @@ func (cmd MarketplaceServices) marketplace() {
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
+	sort.Sort(serviceOfferings)
+
+	if cmd.ui.OutputFormat().IsStructured() {
+		records := make([]serviceOfferingRecord, 0, len(serviceOfferings))
+		for _, offering := range serviceOfferings {
+			records = append(records, newServiceOfferingRecord(offering))
+		}
+		cmd.ui.PrintStructured(records)
+		return
+	}
+
 	if len(serviceOfferings) == 0 {
 		cmd.ui.Say(T("No service offerings found"))
 		return
@@ -159,7 +179,6 @@ func (cmd MarketplaceServices) marketplace() {
 
 	table := cmd.ui.Table([]string{T("service"), T("plans"), T("description")})
 
-	sort.Sort(serviceOfferings)
 	var paidPlanExists bool
 	for _, offering := range serviceOfferings {
 		planNames := ""
@@ -187,3 +206,41 @@ func (cmd MarketplaceServices) marketplace() {
 	}
 	cmd.ui.Say(T("\nTIP:  Use 'cf marketplace -s SERVICE' to view descriptions of individual plans of a given service."))
 }
+
+// serviceOfferingRecord is the machine readable form of a marketplace
+// service offering.
+type serviceOfferingRecord struct {
+	Service     string              `json:"service" yaml:"service"`
+	GUID        string              `json:"guid" yaml:"guid"`
+	Description string              `json:"description" yaml:"description"`
+	Plans       []servicePlanRecord `json:"plans" yaml:"plans"`
+}
+
+type servicePlanRecord struct {
+	Name        string `json:"name" yaml:"name"`
+	GUID        string `json:"guid" yaml:"guid"`
+	Description string `json:"description" yaml:"description"`
+	Free        bool   `json:"free" yaml:"free"`
+}
+
+func newServiceOfferingRecord(offering models.ServiceOffering) serviceOfferingRecord {
+	plans := make([]servicePlanRecord, 0, len(offering.Plans))
+	for _, plan := range offering.Plans {
+		if plan.Name == "" {
+			continue
+		}
+		plans = append(plans, servicePlanRecord{
+			Name:        plan.Name,
+			GUID:        plan.GUID,
+			Description: plan.Description,
+			Free:        plan.Free,
+		})
+	}
+
+	return serviceOfferingRecord{
+		Service:     offering.Label,
+		GUID:        offering.GUID,
+		Description: offering.Description,
+		Plans:       plans,
+	}
+}
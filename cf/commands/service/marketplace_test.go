@@ -1,10 +1,13 @@
 package service_test
 
 import (
+	"encoding/json"
+
 	"github.com/cloudfoundry/cli/cf/actors/servicebuilder/servicebuilderfakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
@@ -125,6 +128,27 @@ var _ = Describe("marketplace command", func() {
 				))
 			})
 
+			It("prints the service offerings as records with --output json", func() {
+				ui.Format = terminal.JSONOutput
+
+				testcmd.RunCLICommand("marketplace", []string{}, requirementsFactory, updateCommandDependency, false)
+
+				Expect(ui.StructuredOutputs).To(HaveLen(1))
+				encoded, err := json.Marshal(ui.StructuredOutputs[0])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(encoded).To(MatchJSON(`[
+					{"service": "aaa-my-service-offering", "guid": "", "description": "service offering 2 description", "plans": [
+						{"name": "service-plan-c", "guid": "", "description": "", "free": true},
+						{"name": "service-plan-d", "guid": "", "description": "", "free": true}
+					]},
+					{"service": "zzz-my-service-offering", "guid": "service-1-guid", "description": "service offering 1 description", "plans": [
+						{"name": "service-plan-a", "guid": "", "description": "service-plan-a description", "free": true},
+						{"name": "service-plan-b", "guid": "", "description": "service-plan-b description", "free": false}
+					]}
+				]`))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"TIP:"}))
+			})
+
 			Context("when there are no paid plans", func() {
 				BeforeEach(func() {
 					serviceBuilder.GetServicesForSpaceWithPlansReturns([]models.ServiceOffering{service2}, nil)
@@ -161,6 +185,22 @@ var _ = Describe("marketplace command", func() {
 					))
 				})
 
+				It("prints the service offering as a record with --output json", func() {
+					serviceBuilder.GetServiceByNameForSpaceWithPlansReturns(serviceWithAPaidPlan, nil)
+					ui.Format = terminal.JSONOutput
+
+					testcmd.RunCLICommand("marketplace", []string{"-s", "zzz-my-service-offering"}, requirementsFactory, updateCommandDependency, false)
+
+					Expect(ui.StructuredOutputs).To(HaveLen(1))
+					encoded, err := json.Marshal(ui.StructuredOutputs[0])
+					Expect(err).NotTo(HaveOccurred())
+					Expect(encoded).To(MatchJSON(`{"service": "zzz-my-service-offering", "guid": "service-1-guid",
+						"description": "service offering 1 description", "plans": [
+							{"name": "service-plan-a", "guid": "", "description": "service-plan-a description", "free": true},
+							{"name": "service-plan-b", "guid": "", "description": "service-plan-b description", "free": false}
+						]}`))
+				})
+
 				It("informs the user if the service cannot be found", func() {
 					testcmd.RunCLICommand("marketplace", []string{"-s", "aaa-my-service-offering"}, requirementsFactory, updateCommandDependency, false)
 
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cloudfoundry/cli/cf/api"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
 )
@@ -34,6 +35,7 @@ func (cmd *ListServices) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			"CF_NAME services",
 		},
+		Records: true,
 	}
 }
 
@@ -81,6 +83,15 @@ func (cmd *ListServices) Execute(fc flags.FlagContext) {
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		records := make([]serviceInstanceRecord, 0, len(serviceInstances))
+		for _, instance := range serviceInstances {
+			records = append(records, newServiceInstanceRecord(instance))
+		}
+		cmd.ui.PrintStructured(records)
+		return
+	}
+
 	if len(serviceInstances) == 0 {
 		cmd.ui.Say(T("No services found"))
 		return
@@ -132,3 +143,34 @@ func (cmd *ListServices) Execute(fc flags.FlagContext) {
 
 	table.Print()
 }
+
+// serviceInstanceRecord is the machine readable form of a service
+// instance printed by the services command.
+type serviceInstanceRecord struct {
+	Name          string   `json:"name" yaml:"name"`
+	GUID          string   `json:"guid" yaml:"guid"`
+	Service       string   `json:"service" yaml:"service"`
+	Plan          string   `json:"plan" yaml:"plan"`
+	UserProvided  bool     `json:"user_provided" yaml:"user_provided"`
+	BoundApps     []string `json:"bound_apps" yaml:"bound_apps"`
+	LastOperation string   `json:"last_operation" yaml:"last_operation"`
+}
+
+func newServiceInstanceRecord(instance models.ServiceInstance) serviceInstanceRecord {
+	record := serviceInstanceRecord{
+		Name:          instance.Name,
+		GUID:          instance.GUID,
+		Service:       instance.ServiceOffering.Label,
+		Plan:          instance.ServicePlan.Name,
+		UserProvided:  instance.IsUserProvided(),
+		BoundApps:     instance.ApplicationNames,
+		LastOperation: InstanceStateToStatus(instance.LastOperation.Type, instance.LastOperation.State, instance.IsUserProvided()),
+	}
+	if record.UserProvided {
+		record.Service = "user-provided"
+	}
+	if record.BoundApps == nil {
+		record.BoundApps = []string{}
+	}
+	return record
+}
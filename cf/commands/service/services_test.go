@@ -1,6 +1,7 @@
 package service_test
 
 import (
+	"encoding/json"
 	"os"
 
 	"github.com/cloudfoundry/cli/cf/api/apifakes"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 
 	. "github.com/cloudfoundry/cli/testhelpers/matchers"
 
@@ -150,6 +152,36 @@ var _ = Describe("services", func() {
 		))
 	})
 
+	It("prints the service instances as records with --output json", func() {
+		serviceInstance := models.ServiceInstance{}
+		serviceInstance.Name = "my-service-1"
+		serviceInstance.GUID = "my-service-1-guid"
+		serviceInstance.LastOperation.Type = "create"
+		serviceInstance.LastOperation.State = "in progress"
+		serviceInstance.ServicePlan = models.ServicePlanFields{GUID: "spark-guid", Name: "spark"}
+		serviceInstance.ApplicationNames = []string{"cli1", "cli2"}
+		serviceInstance.ServiceOffering = models.ServiceOfferingFields{Label: "cleardb"}
+
+		userProvidedServiceInstance := models.ServiceInstance{}
+		userProvidedServiceInstance.Name = "my-service-provided-by-user"
+
+		serviceSummaryRepo.GetSummariesInCurrentSpaceInstances = []models.ServiceInstance{serviceInstance, userProvidedServiceInstance}
+		ui.Format = terminal.JSONOutput
+
+		runCommand()
+
+		Expect(ui.StructuredOutputs).To(HaveLen(1))
+		encoded, err := json.Marshal(ui.StructuredOutputs[0])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(encoded).To(MatchJSON(`[
+			{"name": "my-service-1", "guid": "my-service-1-guid", "service": "cleardb", "plan": "spark", "user_provided": false,
+			 "bound_apps": ["cli1", "cli2"], "last_operation": "create in progress"},
+			{"name": "my-service-provided-by-user", "guid": "", "service": "user-provided", "plan": "", "user_provided": true,
+			 "bound_apps": [], "last_operation": ""}
+		]`))
+		Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"my-service-1"}))
+	})
+
 	It("lists no services when none are found", func() {
 		serviceInstances := []models.ServiceInstance{}
 		serviceSummaryRepo.GetSummariesInCurrentSpaceInstances = serviceInstances
@@ -15,6 +15,24 @@ import (
 	"github.com/cloudfoundry/cli/flags"
 )
 
+// serviceAccessRecord is the machine readable form of a broker printed by
+// the service-access command.
+type serviceAccessRecord struct {
+	Broker   string                       `json:"broker" yaml:"broker"`
+	Services []serviceAccessServiceRecord `json:"services" yaml:"services"`
+}
+
+type serviceAccessServiceRecord struct {
+	Label string                    `json:"label" yaml:"label"`
+	Plans []serviceAccessPlanRecord `json:"plans" yaml:"plans"`
+}
+
+type serviceAccessPlanRecord struct {
+	Name   string   `json:"name" yaml:"name"`
+	Access string   `json:"access" yaml:"access"`
+	Orgs   []string `json:"orgs" yaml:"orgs"`
+}
+
 type ServiceAccess struct {
 	ui             terminal.UI
 	config         coreconfig.Reader
@@ -38,7 +56,8 @@ func (cmd *ServiceAccess) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			"CF_NAME service-access [-b BROKER] [-e SERVICE] [-o ORG]",
 		},
-		Flags: fs,
+		Flags:   fs,
+		Records: true,
 	}
 }
 
@@ -119,9 +138,44 @@ func (cmd *ServiceAccess) Execute(c flags.FlagContext) {
 		cmd.ui.Failed(err.Error())
 		return
 	}
+
+	if cmd.ui.OutputFormat().IsStructured() {
+		cmd.printRecords(brokers)
+		return
+	}
 	cmd.printTable(brokers)
 }
 
+func (cmd ServiceAccess) printRecords(brokers []models.ServiceBroker) {
+	records := make([]serviceAccessRecord, 0, len(brokers))
+	for _, serviceBroker := range brokers {
+		record := serviceAccessRecord{
+			Broker:   serviceBroker.Name,
+			Services: make([]serviceAccessServiceRecord, 0, len(serviceBroker.Services)),
+		}
+		for _, service := range serviceBroker.Services {
+			serviceRecord := serviceAccessServiceRecord{
+				Label: service.Label,
+				Plans: make([]serviceAccessPlanRecord, 0, len(service.Plans)),
+			}
+			for _, plan := range service.Plans {
+				orgs := plan.OrgNames
+				if orgs == nil {
+					orgs = []string{}
+				}
+				serviceRecord.Plans = append(serviceRecord.Plans, serviceAccessPlanRecord{
+					Name:   plan.Name,
+					Access: accessLevel(plan.Public, plan.OrgNames),
+					Orgs:   orgs,
+				})
+			}
+			record.Services = append(record.Services, serviceRecord)
+		}
+		records = append(records, record)
+	}
+	cmd.ui.PrintStructured(records)
+}
+
 func (cmd ServiceAccess) printTable(brokers []models.ServiceBroker) {
 	for _, serviceBroker := range brokers {
 		cmd.ui.Say(fmt.Sprintf(T("broker: {{.Name}}", map[string]interface{}{"Name": serviceBroker.Name})))
@@ -144,11 +198,16 @@ func (cmd ServiceAccess) printTable(brokers []models.ServiceBroker) {
 }
 
 func (cmd ServiceAccess) formatAccess(public bool, orgNames []string) string {
+	return T(accessLevel(public, orgNames))
+}
+
+// accessLevel names who can see a plan: all, limited or none.
+func accessLevel(public bool, orgNames []string) string {
 	if public {
-		return T("all")
+		return "all"
 	}
 	if len(orgNames) > 0 {
-		return T("limited")
+		return "limited"
 	}
-	return T("none")
+	return "none"
 }
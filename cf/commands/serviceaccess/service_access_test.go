@@ -1,12 +1,14 @@
 package serviceaccess_test
 
 import (
+	"encoding/json"
 	"errors"
 	"strings"
 
 	"github.com/cloudfoundry/cli/cf/actors/actorsfakes"
 	"github.com/cloudfoundry/cli/cf/api/authentication/authenticationfakes"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
@@ -159,6 +161,31 @@ var _ = Describe("service-access command", func() {
 					[]string{"my-service-3"},
 				))
 			})
+			It("prints the brokers as records with --output json", func() {
+				ui.Format = terminal.JSONOutput
+
+				runCommand()
+
+				Expect(ui.StructuredOutputs).To(HaveLen(1))
+				encoded, err := json.Marshal(ui.StructuredOutputs[0])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(encoded).To(MatchJSON(`[
+					{"broker": "brokername1", "services": [
+						{"label": "my-service-1", "plans": [
+							{"name": "beep", "access": "all", "orgs": []},
+							{"name": "burp", "access": "none", "orgs": []},
+							{"name": "boop", "access": "limited", "orgs": ["fwip", "brzzt"]}
+						]},
+						{"label": "my-service-2", "plans": [
+							{"name": "petaloideous-noncelebration", "access": "none", "orgs": []}
+						]}
+					]},
+					{"broker": "brokername2", "services": [
+						{"label": "my-service-3", "plans": []}
+					]}
+				]`))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"broker: brokername1"}))
+			})
 		})
 
 		Context("When the broker flag is provided", func() {
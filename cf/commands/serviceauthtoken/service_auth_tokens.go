@@ -11,6 +11,15 @@ import (
 	"github.com/cloudfoundry/cli/flags"
 )
 
+// serviceAuthTokenRecord is the machine readable form of a service auth
+// token printed by the service-auth-tokens command. The token itself is
+// never printed.
+type serviceAuthTokenRecord struct {
+	Label    string `json:"label" yaml:"label"`
+	Provider string `json:"provider" yaml:"provider"`
+	GUID     string `json:"guid" yaml:"guid"`
+}
+
 type ListServiceAuthTokens struct {
 	ui            terminal.UI
 	config        coreconfig.Reader
@@ -28,6 +37,7 @@ func (cmd *ListServiceAuthTokens) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			T("CF_NAME service-auth-tokens"),
 		},
+		Records: true,
 	}
 }
 
@@ -70,6 +80,20 @@ func (cmd *ListServiceAuthTokens) Execute(c flags.FlagContext) {
 		cmd.ui.Failed(apiErr.Error())
 		return
 	}
+
+	if cmd.ui.OutputFormat().IsStructured() {
+		records := make([]serviceAuthTokenRecord, 0, len(authTokens))
+		for _, authToken := range authTokens {
+			records = append(records, serviceAuthTokenRecord{
+				Label:    authToken.Label,
+				Provider: authToken.Provider,
+				GUID:     authToken.GUID,
+			})
+		}
+		cmd.ui.PrintStructured(records)
+		return
+	}
+
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
@@ -1,10 +1,13 @@
 package serviceauthtoken_test
 
 import (
+	"encoding/json"
+
 	"github.com/cloudfoundry/cli/cf/api/apifakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
@@ -84,7 +87,7 @@ var _ = Describe("service-auth-tokens command", func() {
 			requirementsFactory.MaxAPIVersionSuccess = true
 
 			authTokenRepo.FindAllAuthTokens = []models.ServiceAuthTokenFields{
-				{Label: "a label", Provider: "a provider"},
+				{Label: "a label", Provider: "a provider", GUID: "a-guid", Token: "a-token"},
 				{Label: "a second label", Provider: "a second provider"},
 			}
 		})
@@ -100,5 +103,20 @@ var _ = Describe("service-auth-tokens command", func() {
 				[]string{"a second label", "a second provider"},
 			))
 		})
+
+		It("prints the service auth tokens as records with --output json", func() {
+			ui.Format = terminal.JSONOutput
+
+			runCommand()
+
+			Expect(ui.StructuredOutputs).To(HaveLen(1))
+			encoded, err := json.Marshal(ui.StructuredOutputs[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encoded).To(MatchJSON(`[
+				{"label": "a label", "provider": "a provider", "guid": "a-guid"},
+				{"label": "a second label", "provider": "a second provider", "guid": ""}
+			]`))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"a label", "a provider"}))
+		})
 	})
 })
@@ -23,9 +23,18 @@ type serviceBrokerTable []serviceBrokerRow
 
 type serviceBrokerRow struct {
 	name string
+	guid string
 	url  string
 }
 
+// serviceBrokerRecord is the machine readable form of a service broker
+// printed by the service-brokers command.
+type serviceBrokerRecord struct {
+	Name string `json:"name" yaml:"name"`
+	GUID string `json:"guid" yaml:"guid"`
+	URL  string `json:"url" yaml:"url"`
+}
+
 func init() {
 	commandregistry.Register(&ListServiceBrokers{})
 }
@@ -37,6 +46,7 @@ func (cmd *ListServiceBrokers) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			"CF_NAME service-brokers",
 		},
+		Records: true,
 	}
 }
 
@@ -76,6 +86,7 @@ func (cmd *ListServiceBrokers) Execute(c flags.FlagContext) {
 	apiErr := cmd.repo.ListServiceBrokers(func(serviceBroker models.ServiceBroker) bool {
 		sbTable = append(sbTable, serviceBrokerRow{
 			name: serviceBroker.Name,
+			guid: serviceBroker.GUID,
 			url:  serviceBroker.URL,
 		})
 		foundBrokers = true
@@ -84,6 +95,15 @@ func (cmd *ListServiceBrokers) Execute(c flags.FlagContext) {
 
 	sort.Sort(sbTable)
 
+	if apiErr == nil && cmd.ui.OutputFormat().IsStructured() {
+		records := make([]serviceBrokerRecord, 0, len(sbTable))
+		for _, sb := range sbTable {
+			records = append(records, serviceBrokerRecord{Name: sb.name, GUID: sb.guid, URL: sb.url})
+		}
+		cmd.ui.PrintStructured(records)
+		return
+	}
+
 	for _, sb := range sbTable {
 		table.Add(sb.name, sb.url)
 	}
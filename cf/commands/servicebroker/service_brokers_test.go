@@ -1,12 +1,14 @@
 package servicebroker_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/cloudfoundry/cli/cf/api/apifakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
@@ -155,6 +157,26 @@ var _ = Describe("service-brokers command", func() {
 		))
 	})
 
+	It("prints the service brokers as records with --output json", func() {
+		repo.ListServiceBrokersStub = func(callback func(models.ServiceBroker) bool) error {
+			callback(models.ServiceBroker{Name: "z-broker", GUID: "z-broker-guid", URL: "http://z-url.com"})
+			callback(models.ServiceBroker{Name: "a-broker", GUID: "a-broker-guid", URL: "http://a-url.com"})
+			return nil
+		}
+		ui.Format = terminal.JSONOutput
+
+		testcmd.RunCLICommand("service-brokers", []string{}, requirementsFactory, updateCommandDependency, false)
+
+		Expect(ui.StructuredOutputs).To(HaveLen(1))
+		encoded, err := json.Marshal(ui.StructuredOutputs[0])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(encoded).To(MatchJSON(`[
+			{"name": "a-broker", "guid": "a-broker-guid", "url": "http://a-url.com"},
+			{"name": "z-broker", "guid": "z-broker-guid", "url": "http://z-url.com"}
+		]`))
+		Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"name", "url"}))
+	})
+
 	It("says when no service brokers were found", func() {
 		testcmd.RunCLICommand("service-brokers", []string{}, requirementsFactory, updateCommandDependency, false)
 
@@ -11,6 +11,13 @@ import (
 	. "github.com/cloudfoundry/cli/cf/i18n"
 )
 
+// serviceKeyRecord is the machine readable form of a service key printed
+// by the service-keys command.
+type serviceKeyRecord struct {
+	Name string `json:"name" yaml:"name"`
+	GUID string `json:"guid" yaml:"guid"`
+}
+
 type ServiceKeys struct {
 	ui                         terminal.UI
 	config                     coreconfig.Reader
@@ -34,6 +41,7 @@ func (cmd *ServiceKeys) MetaData() commandregistry.CommandMetadata {
 		Examples: []string{
 			"CF_NAME service-keys mydb",
 		},
+		Records: true,
 	}
 }
 
@@ -74,6 +82,15 @@ func (cmd *ServiceKeys) Execute(c flags.FlagContext) {
 		return
 	}
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		records := make([]serviceKeyRecord, 0, len(serviceKeys))
+		for _, serviceKey := range serviceKeys {
+			records = append(records, serviceKeyRecord{Name: serviceKey.Fields.Name, GUID: serviceKey.Fields.GUID})
+		}
+		cmd.ui.PrintStructured(records)
+		return
+	}
+
 	table := cmd.ui.Table([]string{T("name")})
 
 	for _, serviceKey := range serviceKeys {
@@ -1,9 +1,12 @@
 package servicekey_test
 
 import (
+	"encoding/json"
+
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 
 	"github.com/cloudfoundry/cli/cf/api/apifakes"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
@@ -100,6 +103,21 @@ var _ = Describe("service-keys command", func() {
 			Expect(serviceKeyRepo.ListServiceKeysMethod.InstanceGUID).To(Equal("fake-instance-guid"))
 		})
 
+		It("prints the service keys as records with --output json", func() {
+			serviceKeyRepo.ListServiceKeysMethod.ServiceKeys = []models.ServiceKey{
+				{Fields: models.ServiceKeyFields{Name: "fake-service-key-1", GUID: "fake-service-key-1-guid"}},
+			}
+			ui.Format = terminal.JSONOutput
+
+			callListServiceKeys([]string{"fake-service-instance"})
+
+			Expect(ui.StructuredOutputs).To(HaveLen(1))
+			encoded, err := json.Marshal(ui.StructuredOutputs[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encoded).To(MatchJSON(`[{"name": "fake-service-key-1", "guid": "fake-service-key-1-guid"}]`))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"fake-service-key-1"}))
+		})
+
 		It("does not list service keys when none are returned", func() {
 			callListServiceKeys([]string{"fake-service-instance"})
 			Expect(ui.Outputs).To(ContainSubstrings(
@@ -17,6 +17,24 @@ import (
 	"github.com/cloudfoundry/cli/cf/terminal"
 )
 
+// spaceDetailsRecord is the machine readable form of a space printed by
+// the space command.
+type spaceDetailsRecord struct {
+	Name           string                     `json:"name" yaml:"name"`
+	GUID           string                     `json:"guid" yaml:"guid"`
+	Org            string                     `json:"org" yaml:"org"`
+	Apps           []string                   `json:"apps" yaml:"apps"`
+	Domains        []string                   `json:"domains" yaml:"domains"`
+	Services       []string                   `json:"services" yaml:"services"`
+	SecurityGroups []spaceSecurityGroupRecord `json:"security_groups" yaml:"security_groups"`
+	SpaceQuota     string                     `json:"space_quota" yaml:"space_quota"`
+}
+
+type spaceSecurityGroupRecord struct {
+	Name  string                   `json:"name" yaml:"name"`
+	Rules []map[string]interface{} `json:"rules,omitempty" yaml:"rules,omitempty"`
+}
+
 type ShowSpace struct {
 	ui          terminal.UI
 	config      coreconfig.Reader
@@ -40,7 +58,8 @@ func (cmd *ShowSpace) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			T("CF_NAME space SPACE"),
 		},
-		Flags: fs,
+		Flags:   fs,
+		Records: true,
 	}
 }
 
@@ -75,6 +94,10 @@ func (cmd *ShowSpace) Execute(c flags.FlagContext) {
 		cmd.populatePluginModel(space)
 		return
 	}
+	if cmd.ui.OutputFormat().IsStructured() {
+		cmd.printRecord(space, c.Bool("security-group-rules"))
+		return
+	}
 	if c.Bool("guid") {
 		cmd.ui.Say(space.GUID)
 	} else {
@@ -137,6 +160,45 @@ func (cmd *ShowSpace) Execute(c flags.FlagContext) {
 
 }
 
+func (cmd *ShowSpace) printRecord(space models.Space, withRules bool) {
+	record := spaceDetailsRecord{
+		Name:           space.Name,
+		GUID:           space.GUID,
+		Org:            space.Organization.Name,
+		Apps:           []string{},
+		Domains:        []string{},
+		Services:       []string{},
+		SecurityGroups: []spaceSecurityGroupRecord{},
+	}
+	for _, app := range space.Applications {
+		record.Apps = append(record.Apps, app.Name)
+	}
+	for _, domain := range space.Domains {
+		record.Domains = append(record.Domains, domain.Name)
+	}
+	for _, service := range space.ServiceInstances {
+		record.Services = append(record.Services, service.Name)
+	}
+	for _, group := range space.SecurityGroups {
+		groupRecord := spaceSecurityGroupRecord{Name: group.Name}
+		if withRules {
+			groupRecord.Rules = group.Rules
+		}
+		record.SecurityGroups = append(record.SecurityGroups, groupRecord)
+	}
+
+	if space.SpaceQuotaGUID != "" {
+		quota, err := cmd.quotaRepo.FindByGUID(space.SpaceQuotaGUID)
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+			return
+		}
+		record.SpaceQuota = quota.Name
+	}
+
+	cmd.ui.PrintStructured(record)
+}
+
 func (cmd *ShowSpace) quotaString(space models.Space) string {
 	if space.SpaceQuotaGUID == "" {
 		return ""
@@ -1,12 +1,14 @@
 package space_test
 
 import (
+	"encoding/json"
 	"os"
 
 	"github.com/cloudfoundry/cli/cf/api/spacequotas/spacequotasfakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/cf/trace/tracefakes"
 	"github.com/cloudfoundry/cli/plugin/models"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
@@ -169,6 +171,33 @@ var _ = Describe("space command", func() {
 			})
 		})
 
+		Context("with --output json", func() {
+			BeforeEach(func() {
+				ui.Format = terminal.JSONOutput
+			})
+
+			It("prints the space as a record", func() {
+				runCommand("whose-space-is-it-anyway")
+
+				Expect(ui.StructuredOutputs).To(HaveLen(1))
+				encoded, err := json.Marshal(ui.StructuredOutputs[0])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(encoded).To(MatchJSON(`{"name": "whose-space-is-it-anyway", "guid": "whose-space-is-it-anyway-guid",
+					"org": "my-org", "apps": ["app1"], "domains": ["domain1"], "services": ["service1"],
+					"security_groups": [{"name": "Nacho Security"}, {"name": "Nacho Prime"}], "space_quota": "runaway"}`))
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Org", "my-org"}))
+			})
+
+			It("includes the security group rules when asked for them", func() {
+				runCommand("--security-group-rules", "whose-space-is-it-anyway")
+
+				Expect(ui.StructuredOutputs).To(HaveLen(1))
+				encoded, err := json.Marshal(ui.StructuredOutputs[0])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(encoded)).To(ContainSubstring(`"rules":[{"destination":"198.41.191.47/1","ports":"8080-9090","protocol":"udp"}]`))
+			})
+		})
+
 		Context("when the space has a space quota", func() {
 			It("shows information about the given space", func() {
 				runCommand("whose-space-is-it-anyway")
@@ -12,6 +12,13 @@ import (
 	"github.com/cloudfoundry/cli/plugin/models"
 )
 
+// spaceRecord is the machine readable form of a space printed by the
+// spaces command.
+type spaceRecord struct {
+	Name string `json:"name" yaml:"name"`
+	GUID string `json:"guid" yaml:"guid"`
+}
+
 type ListSpaces struct {
 	ui        terminal.UI
 	config    coreconfig.Reader
@@ -32,6 +39,7 @@ func (cmd *ListSpaces) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			T("CF_NAME spaces"),
 		},
+		Records: true,
 	}
 
 }
@@ -71,8 +79,10 @@ func (cmd *ListSpaces) Execute(c flags.FlagContext) {
 
 	foundSpaces := false
 	table := cmd.ui.Table([]string{T("name")})
+	records := []spaceRecord{}
 	apiErr := cmd.spaceRepo.ListSpaces(func(space models.Space) bool {
 		table.Add(space.Name)
+		records = append(records, spaceRecord{Name: space.Name, GUID: space.GUID})
 		foundSpaces = true
 
 		if cmd.pluginCall {
@@ -84,9 +94,9 @@ func (cmd *ListSpaces) Execute(c flags.FlagContext) {
 
 		return true
 	})
-	table.Print()
 
 	if apiErr != nil {
+		table.Print()
 		cmd.ui.Failed(T("Failed fetching spaces.\n{{.ErrorDescription}}",
 			map[string]interface{}{
 				"ErrorDescription": apiErr.Error(),
@@ -94,6 +104,13 @@ func (cmd *ListSpaces) Execute(c flags.FlagContext) {
 		return
 	}
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		cmd.ui.PrintStructured(records)
+		return
+	}
+
+	table.Print()
+
 	if !foundSpaces {
 		cmd.ui.Say(T("No spaces found"))
 	}
@@ -1,12 +1,14 @@
 package space_test
 
 import (
+	"encoding/json"
 	"os"
 
 	"github.com/cloudfoundry/cli/cf/api/apifakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/cf/trace/tracefakes"
 	"github.com/cloudfoundry/cli/flags"
 	"github.com/cloudfoundry/cli/plugin/models"
@@ -155,6 +157,22 @@ var _ = Describe("spaces command", func() {
 			))
 		})
 
+		It("prints the spaces as records with --output json", func() {
+			ui.Format = terminal.JSONOutput
+
+			runCommand()
+
+			Expect(ui.StructuredOutputs).To(HaveLen(1))
+			encoded, err := json.Marshal(ui.StructuredOutputs[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encoded).To(MatchJSON(`[
+				{"name": "space1", "guid": ""},
+				{"name": "space2", "guid": ""},
+				{"name": "space3", "guid": ""}
+			]`))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"space1"}))
+		})
+
 		Context("when there are no spaces", func() {
 			BeforeEach(func() {
 				spaceRepo.ListSpacesStub = listSpacesStub([]models.Space{})
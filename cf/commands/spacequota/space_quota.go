@@ -31,6 +31,7 @@ func (cmd *SpaceQuota) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			T("CF_NAME space-quota SPACE_QUOTA_NAME"),
 		},
+		Records: true,
 	}
 }
 
@@ -70,6 +71,11 @@ func (cmd *SpaceQuota) Execute(c flags.FlagContext) {
 		return
 	}
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		cmd.ui.PrintStructured(newSpaceQuotaRecord(spaceQuota))
+		return
+	}
+
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 	var megabytes string
@@ -1,11 +1,14 @@
 package spacequota_test
 
 import (
+	"encoding/json"
+
 	"github.com/cloudfoundry/cli/cf/api/spacequotas/spacequotasfakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/errors"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
 	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
@@ -97,6 +100,21 @@ var _ = Describe("quotas command", func() {
 				))
 			})
 
+			Context("with --output json", func() {
+				BeforeEach(func() {
+					ui.Format = terminal.JSONOutput
+				})
+
+				It("prints the quota as a record", func() {
+					Expect(ui.StructuredOutputs).To(HaveLen(1))
+					encoded, err := json.Marshal(ui.StructuredOutputs[0])
+					Expect(err).NotTo(HaveOccurred())
+					Expect(encoded).To(MatchJSON(`{"name": "quota-name", "guid": "", "memory_limit_in_mb": 1024, "instance_memory_limit_in_mb": -1,
+						"total_routes": 111, "total_services": 222, "paid_service_plans_allowed": true, "app_instance_limit": 5}`))
+					Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"total memory limit"}))
+				})
+			})
+
 			Context("when the services are unlimited", func() {
 				BeforeEach(func() {
 					quotaRepo.FindByNameReturns(
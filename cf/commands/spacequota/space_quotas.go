@@ -9,11 +9,39 @@ import (
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/formatters"
 	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 )
 
+// spaceQuotaRecord is the machine readable form of a space quota printed
+// by the space-quotas and space-quota commands. Limits of -1 are
+// unlimited.
+type spaceQuotaRecord struct {
+	Name                    string `json:"name" yaml:"name"`
+	GUID                    string `json:"guid" yaml:"guid"`
+	MemoryLimit             int64  `json:"memory_limit_in_mb" yaml:"memory_limit_in_mb"`
+	InstanceMemoryLimit     int64  `json:"instance_memory_limit_in_mb" yaml:"instance_memory_limit_in_mb"`
+	RoutesLimit             int    `json:"total_routes" yaml:"total_routes"`
+	ServicesLimit           int    `json:"total_services" yaml:"total_services"`
+	NonBasicServicesAllowed bool   `json:"paid_service_plans_allowed" yaml:"paid_service_plans_allowed"`
+	AppInstanceLimit        int    `json:"app_instance_limit" yaml:"app_instance_limit"`
+}
+
+func newSpaceQuotaRecord(quota models.SpaceQuota) spaceQuotaRecord {
+	return spaceQuotaRecord{
+		Name:                    quota.Name,
+		GUID:                    quota.GUID,
+		MemoryLimit:             quota.MemoryLimit,
+		InstanceMemoryLimit:     quota.InstanceMemoryLimit,
+		RoutesLimit:             quota.RoutesLimit,
+		ServicesLimit:           quota.ServicesLimit,
+		NonBasicServicesAllowed: quota.NonBasicServicesAllowed,
+		AppInstanceLimit:        quota.AppInstanceLimit,
+	}
+}
+
 type ListSpaceQuotas struct {
 	ui             terminal.UI
 	config         coreconfig.Reader
@@ -31,6 +59,7 @@ func (cmd *ListSpaceQuotas) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			T("CF_NAME space-quotas"),
 		},
+		Records: true,
 	}
 }
 
@@ -68,6 +97,15 @@ func (cmd *ListSpaceQuotas) Execute(c flags.FlagContext) {
 		return
 	}
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		records := make([]spaceQuotaRecord, 0, len(quotas))
+		for _, quota := range quotas {
+			records = append(records, newSpaceQuotaRecord(quota))
+		}
+		cmd.ui.PrintStructured(records)
+		return
+	}
+
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
@@ -1,11 +1,14 @@
 package spacequota_test
 
 import (
+	"encoding/json"
+
 	"github.com/cloudfoundry/cli/cf/api/spacequotas/spacequotasfakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/errors"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
@@ -134,6 +137,27 @@ var _ = Describe("quotas command", func() {
 				))
 			})
 
+			Context("with --output json", func() {
+				BeforeEach(func() {
+					ui.Format = terminal.JSONOutput
+				})
+
+				It("prints the quotas as records", func() {
+					Expect(ui.StructuredOutputs).To(HaveLen(1))
+					encoded, err := json.Marshal(ui.StructuredOutputs[0])
+					Expect(err).NotTo(HaveOccurred())
+					Expect(encoded).To(MatchJSON(`[
+						{"name": "quota-name", "guid": "", "memory_limit_in_mb": 1024, "instance_memory_limit_in_mb": 512, "total_routes": 111,
+						 "total_services": 222, "paid_service_plans_allowed": true, "app_instance_limit": 7},
+						{"name": "quota-non-basic-not-allowed", "guid": "", "memory_limit_in_mb": 434, "instance_memory_limit_in_mb": -1, "total_routes": 1,
+						 "total_services": 2, "paid_service_plans_allowed": false, "app_instance_limit": 1},
+						{"name": "quota-app-instances", "guid": "", "memory_limit_in_mb": 434, "instance_memory_limit_in_mb": 512, "total_routes": 1,
+						 "total_services": 2, "paid_service_plans_allowed": false, "app_instance_limit": -1}
+					]`))
+					Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"quota-name"}))
+				})
+			})
+
 			Context("when services are unlimited", func() {
 				BeforeEach(func() {
 					quotaRepo.FindByOrgReturns([]models.SpaceQuota{
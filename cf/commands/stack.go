@@ -32,6 +32,7 @@ func (cmd *ListStack) MetaData() commandregistry.CommandMetadata {
 		},
 		Flags:     fs,
 		TotalArgs: 1,
+		Records:   true,
 	}
 }
 
@@ -73,6 +74,11 @@ func (cmd *ListStack) Execute(c flags.FlagContext) {
 				"SpaceName":        terminal.EntityNameColor(cmd.config.SpaceFields().Name),
 				"Username":         terminal.EntityNameColor(cmd.config.Username())}))
 
+		if cmd.ui.OutputFormat().IsStructured() {
+			cmd.ui.PrintStructured(newStackRecord(stack))
+			return
+		}
+
 		cmd.ui.Ok()
 		cmd.ui.Say("")
 		table := cmd.ui.Table([]string{T("name"), T("description")})
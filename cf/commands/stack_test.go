@@ -1,10 +1,12 @@
 package commands_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/cloudfoundry/cli/cf/api/stacks/stacksfakes"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
 	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
@@ -97,6 +99,18 @@ var _ = Describe("stack command", func() {
 		Expect(ui.Outputs[0]).To(Equal(""))
 	})
 
+	It("prints the stack as a record with --output json", func() {
+		repo.FindByNameReturns(models.Stack{Name: "Stack-1", GUID: "stack-1-guid", Description: "Stack 1 Description"}, nil)
+		ui.Format = terminal.JSONOutput
+
+		testcmd.RunCLICommand("stack", []string{"Stack-1"}, requirementsFactory, updateCommandDependency, false)
+
+		Expect(ui.StructuredOutputs).To(HaveLen(1))
+		encoded, err := json.Marshal(ui.StructuredOutputs[0])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(encoded).To(MatchJSON(`{"name": "Stack-1", "guid": "stack-1-guid", "description": "Stack 1 Description"}`))
+	})
+
 	It("informs user if stack is not found", func() {
 		repo.FindByNameReturns(models.Stack{}, errors.New("Stack Stack-1 not found"))
 
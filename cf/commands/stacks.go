@@ -5,11 +5,24 @@ import (
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 )
 
+// stackRecord is the machine readable form of a stack printed by the
+// stacks and stack commands.
+type stackRecord struct {
+	Name        string `json:"name" yaml:"name"`
+	GUID        string `json:"guid" yaml:"guid"`
+	Description string `json:"description" yaml:"description"`
+}
+
+func newStackRecord(stack models.Stack) stackRecord {
+	return stackRecord{Name: stack.Name, GUID: stack.GUID, Description: stack.Description}
+}
+
 type ListStacks struct {
 	ui         terminal.UI
 	config     coreconfig.Reader
@@ -27,6 +40,7 @@ func (cmd *ListStacks) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			T("CF_NAME stacks"),
 		},
+		Records: true,
 	}
 }
 
@@ -65,6 +79,15 @@ func (cmd *ListStacks) Execute(c flags.FlagContext) {
 		return
 	}
 
+	if cmd.ui.OutputFormat().IsStructured() {
+		records := make([]stackRecord, 0, len(stacks))
+		for _, stack := range stacks {
+			records = append(records, newStackRecord(stack))
+		}
+		cmd.ui.PrintStructured(records)
+		return
+	}
+
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
@@ -1,10 +1,13 @@
 package commands_test
 
 import (
+	"encoding/json"
+
 	"github.com/cloudfoundry/cli/cf/api/stacks/stacksfakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/flags"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
@@ -90,4 +93,18 @@ var _ = Describe("stacks command", func() {
 			[]string{"Stack-2", "Stack 2 Description"},
 		))
 	})
+	It("prints the stacks as records with --output json", func() {
+		repo.FindAllReturns([]models.Stack{
+			{Name: "Stack-1", GUID: "stack-1-guid", Description: "Stack 1 Description"},
+		}, nil)
+		ui.Format = terminal.JSONOutput
+
+		testcmd.RunCLICommand("stacks", []string{}, requirementsFactory, updateCommandDependency, false)
+
+		Expect(ui.StructuredOutputs).To(HaveLen(1))
+		encoded, err := json.Marshal(ui.StructuredOutputs[0])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(encoded).To(MatchJSON(`[{"name": "Stack-1", "guid": "stack-1-guid", "description": "Stack 1 Description"}]`))
+		Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Stack-1", "Stack 1 Description"}))
+	})
 })
@@ -37,7 +37,8 @@ func (cmd *OrgUsers) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			T("CF_NAME org-users ORG"),
 		},
-		Flags: fs,
+		Flags:   fs,
+		Records: true,
 	}
 }
 
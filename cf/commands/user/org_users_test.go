@@ -1,10 +1,13 @@
 package user_test
 
 import (
+	"encoding/json"
+
 	"github.com/cloudfoundry/cli/cf/api/apifakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/cf/trace/tracefakes"
 	"github.com/cloudfoundry/cli/plugin/models"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
@@ -218,6 +221,22 @@ var _ = Describe("org-users command", func() {
 			))
 		})
 
+		It("prints the users by role as records with --output json", func() {
+			ui.Format = terminal.JSONOutput
+
+			runCommand("the-org")
+
+			Expect(ui.StructuredOutputs).To(HaveLen(1))
+			encoded, err := json.Marshal(ui.StructuredOutputs[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encoded).To(MatchJSON(`[
+				{"role": "org_manager", "users": [{"username": "user1", "guid": ""}, {"username": "user2", "guid": ""}]},
+				{"role": "billing_manager", "users": [{"username": "user4", "guid": ""}]},
+				{"role": "org_auditor", "users": [{"username": "user3", "guid": ""}]}
+			]`))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"ORG MANAGER"}))
+		})
+
 		Context("when the -a flag is provided", func() {
 			BeforeEach(func() {
 				user := models.UserFields{Username: "user1"}
@@ -36,6 +36,7 @@ func (cmd *SpaceUsers) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			T("CF_NAME space-users ORG SPACE"),
 		},
+		Records: true,
 	}
 }
 
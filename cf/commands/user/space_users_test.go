@@ -1,12 +1,14 @@
 package user_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	"github.com/cloudfoundry/cli/cf/api/apifakes"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/cf/trace/tracefakes"
 	"github.com/cloudfoundry/cli/plugin/models"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
@@ -132,6 +134,22 @@ var _ = Describe("space-users command", func() {
 			))
 		})
 
+		It("prints the users by role as records with --output json", func() {
+			ui.Format = terminal.JSONOutput
+
+			runCommand("my-org", "my-space")
+
+			Expect(ui.StructuredOutputs).To(HaveLen(1))
+			encoded, err := json.Marshal(ui.StructuredOutputs[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encoded).To(MatchJSON(`[
+				{"role": "space_manager", "users": [{"username": "user1", "guid": ""}, {"username": "user2", "guid": ""}]},
+				{"role": "space_developer", "users": [{"username": "user4", "guid": ""}]},
+				{"role": "space_auditor", "users": [{"username": "user3", "guid": ""}]}
+			]`))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"SPACE MANAGER"}))
+		})
+
 		Context("when cc api verson is >= 2.21.0", func() {
 			BeforeEach(func() {
 				configRepo.SetAPIVersion("2.22.0")
@@ -0,0 +1,122 @@
+package v3
+
+import (
+	"fmt"
+
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/formatters"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/cf/v3/models"
+	"github.com/cloudfoundry/cli/cf/v3/repository"
+	"github.com/cloudfoundry/cli/flags"
+
+	. "github.com/cloudfoundry/cli/cf/i18n"
+)
+
+type RunTask struct {
+	ui         terminal.UI
+	config     coreconfig.Reader
+	repository repository.Repository
+}
+
+func init() {
+	commandregistry.Register(&RunTask{})
+}
+
+func (cmd *RunTask) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["name"] = &flags.StringFlag{Name: "name", Usage: T("Name to give the task (generated if omitted)")}
+	fs["k"] = &flags.StringFlag{ShortName: "k", Usage: T("Disk limit (e.g. 256M, 1024M, 1G)")}
+	fs["m"] = &flags.StringFlag{ShortName: "m", Usage: T("Memory limit (e.g. 256M, 1024M, 1G)")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "run-task",
+		Description: T("Run a one-off task on an app"),
+		Usage: []string{
+			T("CF_NAME run-task APP_NAME COMMAND [--name TASK_NAME] [-k DISK] [-m MEMORY]"),
+		},
+		Examples: []string{
+			`CF_NAME run-task my-app "bundle exec rake db:migrate" --name migrate`,
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *RunTask) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) []requirements.Requirement {
+	if len(fc.Args()) != 2 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires APP_NAME and COMMAND as arguments\n\n") + commandregistry.Commands.CommandUsage("run-task"))
+	}
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+	}
+
+	return reqs
+}
+
+func (cmd *RunTask) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.repository = deps.RepoLocator.GetV3Repository()
+
+	return cmd
+}
+
+func (cmd *RunTask) Execute(fc flags.FlagContext) {
+	appName := fc.Args()[0]
+	params := models.V3TaskParams{
+		Command: fc.Args()[1],
+		Name:    fc.String("name"),
+	}
+
+	if fc.String("m") != "" {
+		memory, err := formatters.ToMegabytes(fc.String("m"))
+		if err != nil {
+			cmd.ui.Failed(T("Invalid memory limit: {{.Memory}}\n{{.ErrorDescription}}",
+				map[string]interface{}{
+					"Memory":           fc.String("m"),
+					"ErrorDescription": err,
+				}))
+		}
+		params.MemoryInMB = memory
+	}
+
+	if fc.String("k") != "" {
+		diskQuota, err := formatters.ToMegabytes(fc.String("k"))
+		if err != nil {
+			cmd.ui.Failed(T("Invalid disk quota: {{.DiskQuota}}\n{{.ErrorDescription}}",
+				map[string]interface{}{
+					"DiskQuota":        fc.String("k"),
+					"ErrorDescription": err,
+				}))
+		}
+		params.DiskInMB = diskQuota
+	}
+
+	cmd.ui.Say(T("Creating task for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     terminal.EntityNameColor(appName),
+			"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	application, err := cmd.repository.GetApplicationByName(cmd.config.SpaceFields().GUID, appName)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	task, err := cmd.repository.CreateTask(application.GUID, params)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+	cmd.ui.Say(T("Task has been submitted successfully for execution."))
+	cmd.ui.Say("%s %s", terminal.HeaderColor(T("task name:")), task.Name)
+	cmd.ui.Say("%s %s", terminal.HeaderColor(T("task id:")), fmt.Sprintf("%d", task.SequenceID))
+}
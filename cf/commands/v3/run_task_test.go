@@ -0,0 +1,105 @@
+package v3_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/commands/v3"
+	"github.com/cloudfoundry/cli/cf/requirements/requirementsfakes"
+	"github.com/cloudfoundry/cli/cf/v3/models"
+	"github.com/cloudfoundry/cli/cf/v3/repository/repositoryfakes"
+	"github.com/cloudfoundry/cli/flags"
+
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RunTask", func() {
+	var (
+		ui         *testterm.FakeUI
+		repository *repositoryfakes.FakeRepository
+
+		cmd         commandregistry.Command
+		deps        commandregistry.Dependency
+		factory     *requirementsfakes.FakeFactory
+		flagContext flags.FlagContext
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		repository = new(repositoryfakes.FakeRepository)
+
+		deps = commandregistry.Dependency{
+			UI:          ui,
+			Config:      testconfig.NewRepositoryWithDefaults(),
+			RepoLocator: deps.RepoLocator.SetV3Repository(repository),
+		}
+
+		cmd = &v3.RunTask{}
+		cmd.SetDependency(deps, false)
+
+		flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+		factory = new(requirementsfakes.FakeFactory)
+
+		repository.GetApplicationByNameReturns(models.V3Application{GUID: "app-guid"}, nil)
+	})
+
+	Describe("Requirements", func() {
+		It("fails with usage when not provided an app name and a command", func() {
+			flagContext.Parse("app-name")
+			Expect(func() { cmd.Requirements(factory, flagContext) }).To(Panic())
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"Incorrect Usage. Requires APP_NAME and COMMAND as arguments"}))
+		})
+	})
+
+	Describe("Execute", func() {
+		BeforeEach(func() {
+			repository.CreateTaskReturns(models.V3Task{GUID: "task-guid", SequenceID: 7, Name: "migrate"}, nil)
+		})
+
+		It("runs the command as a task of the app", func() {
+			flagContext.Parse("app-name", "rake db:migrate", "--name", "migrate", "-m", "512M", "-k", "1G")
+			cmd.Execute(flagContext)
+
+			appGUID, params := repository.CreateTaskArgsForCall(0)
+			Expect(appGUID).To(Equal("app-guid"))
+			Expect(params).To(Equal(models.V3TaskParams{
+				Command:    "rake db:migrate",
+				Name:       "migrate",
+				MemoryInMB: 512,
+				DiskInMB:   1024,
+			}))
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Creating task for app", "app-name"},
+				[]string{"OK"},
+				[]string{"task name:", "migrate"},
+				[]string{"task id:", "7"},
+			))
+		})
+
+		It("leaves the name and limits to the Cloud Controller when not given", func() {
+			flagContext.Parse("app-name", "rake db:migrate")
+			cmd.Execute(flagContext)
+
+			_, params := repository.CreateTaskArgsForCall(0)
+			Expect(params).To(Equal(models.V3TaskParams{Command: "rake db:migrate"}))
+		})
+
+		Context("when creating the task fails", func() {
+			BeforeEach(func() {
+				repository.CreateTaskReturns(models.V3Task{}, errors.New("create-task-err"))
+			})
+
+			It("fails", func() {
+				flagContext.Parse("app-name", "rake db:migrate")
+				Expect(func() { cmd.Execute(flagContext) }).To(Panic())
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"create-task-err"}))
+			})
+		})
+	})
+})
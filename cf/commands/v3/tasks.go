@@ -0,0 +1,99 @@
+package v3
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/cf/v3/repository"
+	"github.com/cloudfoundry/cli/flags"
+
+	. "github.com/cloudfoundry/cli/cf/i18n"
+)
+
+type Tasks struct {
+	ui         terminal.UI
+	config     coreconfig.Reader
+	repository repository.Repository
+}
+
+func init() {
+	commandregistry.Register(&Tasks{})
+}
+
+func (cmd *Tasks) MetaData() commandregistry.CommandMetadata {
+	return commandregistry.CommandMetadata{
+		Name:        "tasks",
+		Description: T("List the tasks of an app"),
+		Usage: []string{
+			T("CF_NAME tasks APP_NAME"),
+		},
+	}
+}
+
+func (cmd *Tasks) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) []requirements.Requirement {
+	if len(fc.Args()) != 1 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires an argument\n\n") + commandregistry.Commands.CommandUsage("tasks"))
+	}
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+	}
+
+	return reqs
+}
+
+func (cmd *Tasks) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.repository = deps.RepoLocator.GetV3Repository()
+
+	return cmd
+}
+
+func (cmd *Tasks) Execute(fc flags.FlagContext) {
+	appName := fc.Args()[0]
+
+	cmd.ui.Say(T("Getting tasks for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     terminal.EntityNameColor(appName),
+			"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	application, err := cmd.repository.GetApplicationByName(cmd.config.SpaceFields().GUID, appName)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	tasks, err := cmd.repository.GetTasks(application.GUID)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	if len(tasks) == 0 {
+		cmd.ui.Say(T("No tasks found"))
+		return
+	}
+
+	table := cmd.ui.Table([]string{T("id"), T("name"), T("state"), T("start time"), T("command")})
+	for _, task := range tasks {
+		table.Add(
+			fmt.Sprintf("%d", task.SequenceID),
+			task.Name,
+			strings.ToLower(task.State),
+			task.CreatedAt,
+			task.Command,
+		)
+	}
+
+	table.Print()
+}
@@ -0,0 +1,86 @@
+package v3_test
+
+import (
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/commands/v3"
+	"github.com/cloudfoundry/cli/cf/requirements/requirementsfakes"
+	"github.com/cloudfoundry/cli/cf/v3/models"
+	"github.com/cloudfoundry/cli/cf/v3/repository/repositoryfakes"
+	"github.com/cloudfoundry/cli/flags"
+
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tasks", func() {
+	var (
+		ui         *testterm.FakeUI
+		repository *repositoryfakes.FakeRepository
+
+		cmd         commandregistry.Command
+		deps        commandregistry.Dependency
+		factory     *requirementsfakes.FakeFactory
+		flagContext flags.FlagContext
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		repository = new(repositoryfakes.FakeRepository)
+
+		deps = commandregistry.Dependency{
+			UI:          ui,
+			Config:      testconfig.NewRepositoryWithDefaults(),
+			RepoLocator: deps.RepoLocator.SetV3Repository(repository),
+		}
+
+		cmd = &v3.Tasks{}
+		cmd.SetDependency(deps, false)
+
+		flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+		factory = new(requirementsfakes.FakeFactory)
+
+		repository.GetApplicationByNameReturns(models.V3Application{GUID: "app-guid"}, nil)
+	})
+
+	Describe("Requirements", func() {
+		It("fails with usage when not provided exactly one arg", func() {
+			flagContext.Parse()
+			Expect(func() { cmd.Requirements(factory, flagContext) }).To(Panic())
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"Incorrect Usage. Requires an argument"}))
+		})
+	})
+
+	Describe("Execute", func() {
+		BeforeEach(func() {
+			flagContext.Parse("app-name")
+		})
+
+		It("lists the tasks of the app", func() {
+			repository.GetTasksReturns([]models.V3Task{
+				{SequenceID: 1, Name: "migrate", State: "SUCCEEDED", Command: "rake db:migrate", CreatedAt: "2016-06-01T00:00:00Z"},
+				{SequenceID: 2, Name: "backup", State: "RUNNING", Command: "./backup"},
+			}, nil)
+
+			cmd.Execute(flagContext)
+
+			Expect(repository.GetTasksArgsForCall(0)).To(Equal("app-guid"))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"id", "name", "state", "start time", "command"},
+				[]string{"1", "migrate", "succeeded", "2016-06-01T00:00:00Z", "rake db:migrate"},
+				[]string{"2", "backup", "running", "./backup"},
+			))
+		})
+
+		It("says so when the app has no tasks", func() {
+			repository.GetTasksReturns([]models.V3Task{}, nil)
+
+			cmd.Execute(flagContext)
+
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"No tasks found"}))
+		})
+	})
+})
@@ -0,0 +1,103 @@
+package v3
+
+import (
+	"strconv"
+
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/cf/v3/repository"
+	"github.com/cloudfoundry/cli/flags"
+
+	. "github.com/cloudfoundry/cli/cf/i18n"
+)
+
+type TerminateTask struct {
+	ui         terminal.UI
+	config     coreconfig.Reader
+	repository repository.Repository
+}
+
+func init() {
+	commandregistry.Register(&TerminateTask{})
+}
+
+func (cmd *TerminateTask) MetaData() commandregistry.CommandMetadata {
+	return commandregistry.CommandMetadata{
+		Name:        "terminate-task",
+		Description: T("Terminate a running task of an app"),
+		Usage: []string{
+			T("CF_NAME terminate-task APP_NAME TASK_ID"),
+		},
+		Examples: []string{
+			"CF_NAME terminate-task my-app 3",
+		},
+	}
+}
+
+func (cmd *TerminateTask) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) []requirements.Requirement {
+	if len(fc.Args()) != 2 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires APP_NAME and TASK_ID as arguments\n\n") + commandregistry.Commands.CommandUsage("terminate-task"))
+	}
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+	}
+
+	return reqs
+}
+
+func (cmd *TerminateTask) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.repository = deps.RepoLocator.GetV3Repository()
+
+	return cmd
+}
+
+func (cmd *TerminateTask) Execute(fc flags.FlagContext) {
+	appName := fc.Args()[0]
+
+	sequenceID, err := strconv.Atoi(fc.Args()[1])
+	if err != nil {
+		cmd.ui.Failed(T("Invalid task ID: {{.TaskID}}", map[string]interface{}{"TaskID": fc.Args()[1]}))
+	}
+
+	cmd.ui.Say(T("Terminating task {{.TaskID}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"TaskID":      terminal.EntityNameColor(fc.Args()[1]),
+			"AppName":     terminal.EntityNameColor(appName),
+			"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	application, err := cmd.repository.GetApplicationByName(cmd.config.SpaceFields().GUID, appName)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	tasks, err := cmd.repository.GetTasks(application.GUID)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	for _, task := range tasks {
+		if task.SequenceID != sequenceID {
+			continue
+		}
+
+		_, err = cmd.repository.CancelTask(task.GUID)
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+		}
+
+		cmd.ui.Ok()
+		return
+	}
+
+	cmd.ui.Failed(T("Task {{.TaskID}} not found for app {{.AppName}}",
+		map[string]interface{}{"TaskID": sequenceID, "AppName": appName}))
+}
@@ -0,0 +1,88 @@
+package v3_test
+
+import (
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/commands/v3"
+	"github.com/cloudfoundry/cli/cf/requirements/requirementsfakes"
+	"github.com/cloudfoundry/cli/cf/v3/models"
+	"github.com/cloudfoundry/cli/cf/v3/repository/repositoryfakes"
+	"github.com/cloudfoundry/cli/flags"
+
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TerminateTask", func() {
+	var (
+		ui         *testterm.FakeUI
+		repository *repositoryfakes.FakeRepository
+
+		cmd         commandregistry.Command
+		deps        commandregistry.Dependency
+		factory     *requirementsfakes.FakeFactory
+		flagContext flags.FlagContext
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		repository = new(repositoryfakes.FakeRepository)
+
+		deps = commandregistry.Dependency{
+			UI:          ui,
+			Config:      testconfig.NewRepositoryWithDefaults(),
+			RepoLocator: deps.RepoLocator.SetV3Repository(repository),
+		}
+
+		cmd = &v3.TerminateTask{}
+		cmd.SetDependency(deps, false)
+
+		flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+		factory = new(requirementsfakes.FakeFactory)
+
+		repository.GetApplicationByNameReturns(models.V3Application{GUID: "app-guid"}, nil)
+		repository.GetTasksReturns([]models.V3Task{
+			{GUID: "task-1-guid", SequenceID: 1},
+			{GUID: "task-2-guid", SequenceID: 2},
+		}, nil)
+	})
+
+	Describe("Requirements", func() {
+		It("fails with usage when not provided an app name and a task id", func() {
+			flagContext.Parse("app-name")
+			Expect(func() { cmd.Requirements(factory, flagContext) }).To(Panic())
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"Incorrect Usage. Requires APP_NAME and TASK_ID as arguments"}))
+		})
+	})
+
+	Describe("Execute", func() {
+		It("cancels the task with the given id", func() {
+			flagContext.Parse("app-name", "2")
+			cmd.Execute(flagContext)
+
+			Expect(repository.GetTasksArgsForCall(0)).To(Equal("app-guid"))
+			Expect(repository.CancelTaskArgsForCall(0)).To(Equal("task-2-guid"))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Terminating task", "2", "app-name"},
+				[]string{"OK"},
+			))
+		})
+
+		It("fails when the app has no task with the given id", func() {
+			flagContext.Parse("app-name", "3")
+			Expect(func() { cmd.Execute(flagContext) }).To(Panic())
+			Expect(repository.CancelTaskCallCount()).To(Equal(0))
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"Task 3 not found"}))
+		})
+
+		It("fails when the task id is not a number", func() {
+			flagContext.Parse("app-name", "migrate")
+			Expect(func() { cmd.Execute(flagContext) }).To(Panic())
+			Expect(repository.GetTasksCallCount()).To(Equal(0))
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"Invalid task ID", "migrate"}))
+		})
+	})
+})
@@ -0,0 +1,116 @@
+package v3
+
+import (
+	"strings"
+
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/cf/v3/repository"
+	"github.com/cloudfoundry/cli/flags"
+
+	. "github.com/cloudfoundry/cli/cf/i18n"
+)
+
+type V3Droplets struct {
+	ui         terminal.UI
+	config     coreconfig.Reader
+	repository repository.Repository
+}
+
+func init() {
+	commandregistry.Register(&V3Droplets{})
+}
+
+func (cmd *V3Droplets) MetaData() commandregistry.CommandMetadata {
+	return commandregistry.CommandMetadata{
+		Name:        "v3-droplets",
+		Description: T("List the droplets of an app"),
+		Usage: []string{
+			T("CF_NAME v3-droplets APP_NAME"),
+		},
+	}
+}
+
+func (cmd *V3Droplets) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) []requirements.Requirement {
+	if len(fc.Args()) != 1 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires an argument\n\n") + commandregistry.Commands.CommandUsage("v3-droplets"))
+	}
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+	}
+
+	return reqs
+}
+
+func (cmd *V3Droplets) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.repository = deps.RepoLocator.GetV3Repository()
+
+	return cmd
+}
+
+func (cmd *V3Droplets) Execute(fc flags.FlagContext) {
+	appName := fc.Args()[0]
+
+	cmd.ui.Say(T("Getting droplets of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     terminal.EntityNameColor(appName),
+			"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	application, err := cmd.repository.GetApplicationByName(cmd.config.SpaceFields().GUID, appName)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	droplets, err := cmd.repository.GetDroplets(application.GUID)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	current, err := cmd.repository.GetCurrentDroplet(application.GUID)
+	if err != nil {
+		if _, ok := err.(*errors.ModelNotFoundError); !ok {
+			cmd.ui.Failed(err.Error())
+		}
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	if len(droplets) == 0 {
+		cmd.ui.Say(T("No droplets found"))
+		return
+	}
+
+	table := cmd.ui.Table([]string{T("guid"), T("state"), T("stack"), T("buildpacks"), T("created")})
+	for _, droplet := range droplets {
+		guid := droplet.GUID
+		if current.GUID != "" && droplet.GUID == current.GUID {
+			guid += " " + T("(current)")
+		}
+
+		var buildpacks []string
+		for _, buildpack := range droplet.Buildpacks {
+			buildpacks = append(buildpacks, buildpack.Name)
+		}
+
+		table.Add(
+			guid,
+			strings.ToLower(droplet.State),
+			droplet.Stack,
+			strings.Join(buildpacks, ", "),
+			droplet.CreatedAt,
+		)
+	}
+
+	table.Print()
+}
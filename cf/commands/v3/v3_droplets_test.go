@@ -0,0 +1,110 @@
+package v3_test
+
+import (
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/commands/v3"
+	cferrors "github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/requirements/requirementsfakes"
+	"github.com/cloudfoundry/cli/cf/v3/models"
+	"github.com/cloudfoundry/cli/cf/v3/repository/repositoryfakes"
+	"github.com/cloudfoundry/cli/flags"
+
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V3Droplets", func() {
+	var (
+		ui         *testterm.FakeUI
+		repository *repositoryfakes.FakeRepository
+
+		cmd         commandregistry.Command
+		deps        commandregistry.Dependency
+		factory     *requirementsfakes.FakeFactory
+		flagContext flags.FlagContext
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		repository = new(repositoryfakes.FakeRepository)
+
+		deps = commandregistry.Dependency{
+			UI:          ui,
+			Config:      testconfig.NewRepositoryWithDefaults(),
+			RepoLocator: deps.RepoLocator.SetV3Repository(repository),
+		}
+
+		cmd = &v3.V3Droplets{}
+		cmd.SetDependency(deps, false)
+
+		flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+		factory = new(requirementsfakes.FakeFactory)
+
+		repository.GetApplicationByNameReturns(models.V3Application{GUID: "app-guid"}, nil)
+	})
+
+	Describe("Requirements", func() {
+		It("fails with usage when not provided exactly one arg", func() {
+			flagContext.Parse()
+			Expect(func() { cmd.Requirements(factory, flagContext) }).To(Panic())
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"Incorrect Usage. Requires an argument"}))
+		})
+	})
+
+	Describe("Execute", func() {
+		BeforeEach(func() {
+			flagContext.Parse("app-name")
+			repository.GetDropletsReturns([]models.V3Droplet{
+				{
+					GUID:       "droplet-1-guid",
+					State:      "STAGED",
+					Stack:      "cflinuxfs2",
+					Buildpacks: []models.V3Buildpack{{Name: "ruby_buildpack"}},
+				},
+				{
+					GUID:  "droplet-2-guid",
+					State: "FAILED",
+				},
+			}, nil)
+			repository.GetCurrentDropletReturns(models.V3Droplet{GUID: "droplet-1-guid"}, nil)
+		})
+
+		It("lists the droplets of the app and marks the current one", func() {
+			cmd.Execute(flagContext)
+
+			Expect(repository.GetDropletsArgsForCall(0)).To(Equal("app-guid"))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"guid", "state", "stack", "buildpacks"},
+				[]string{"droplet-1-guid (current)", "staged", "cflinuxfs2", "ruby_buildpack"},
+				[]string{"droplet-2-guid", "failed"},
+			))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"droplet-2-guid (current)"}))
+		})
+
+		Context("when the app has no current droplet", func() {
+			BeforeEach(func() {
+				repository.GetCurrentDropletReturns(models.V3Droplet{}, cferrors.NewModelNotFoundError("Droplet", "app-guid"))
+			})
+
+			It("lists the droplets without marking any", func() {
+				cmd.Execute(flagContext)
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"(current)"}))
+			})
+		})
+
+		Context("when the app has no droplets", func() {
+			BeforeEach(func() {
+				repository.GetDropletsReturns([]models.V3Droplet{}, nil)
+			})
+
+			It("says so", func() {
+				cmd.Execute(flagContext)
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"No droplets found"}))
+			})
+		})
+	})
+})
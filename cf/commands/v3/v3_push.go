@@ -0,0 +1,246 @@
+package v3
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/cloudfoundry/cli/cf/appfiles"
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/cf/v3/models"
+	"github.com/cloudfoundry/cli/cf/v3/repository"
+	"github.com/cloudfoundry/cli/flags"
+
+	. "github.com/cloudfoundry/cli/cf/i18n"
+)
+
+const (
+	DefaultStagingTimeout = 15 * time.Minute
+	DefaultPingerThrottle = 5 * time.Second
+)
+
+type V3Push struct {
+	ui         terminal.UI
+	config     coreconfig.Reader
+	repository repository.Repository
+	zipper     appfiles.Zipper
+
+	StagingTimeout time.Duration
+	PingerThrottle time.Duration
+}
+
+func init() {
+	commandregistry.Register(&V3Push{})
+}
+
+func (cmd *V3Push) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["p"] = &flags.StringFlag{ShortName: "p", Usage: T("Path to app directory or to a zip file of the contents of the app directory")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "v3-push",
+		Description: T("Push a new app or sync changes to an existing app using the v3 API"),
+		Usage: []string{
+			T("CF_NAME v3-push APP_NAME [-p APP_PATH]"),
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *V3Push) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) []requirements.Requirement {
+	if len(fc.Args()) != 1 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires an argument\n\n") + commandregistry.Commands.CommandUsage("v3-push"))
+	}
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+	}
+
+	return reqs
+}
+
+func (cmd *V3Push) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.repository = deps.RepoLocator.GetV3Repository()
+	cmd.zipper = deps.AppZipper
+	cmd.PingerThrottle = DefaultPingerThrottle
+
+	if os.Getenv("CF_STAGING_TIMEOUT") != "" {
+		duration, err := strconv.ParseInt(os.Getenv("CF_STAGING_TIMEOUT"), 10, 64)
+		if err != nil {
+			cmd.ui.Failed(T("invalid value for env var CF_STAGING_TIMEOUT\n{{.Err}}",
+				map[string]interface{}{"Err": err}))
+		}
+		cmd.StagingTimeout = time.Duration(duration) * time.Minute
+	} else {
+		cmd.StagingTimeout = DefaultStagingTimeout
+	}
+
+	return cmd
+}
+
+func (cmd *V3Push) Execute(fc flags.FlagContext) {
+	appName := fc.Args()[0]
+
+	appDir := fc.String("p")
+	if appDir == "" {
+		var err error
+		appDir, err = os.Getwd()
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+		}
+	}
+
+	cmd.ui.Say(T("Pushing app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"AppName":     terminal.EntityNameColor(appName),
+			"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	application, err := cmd.findOrCreateApp(appName)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	cmd.ui.Say(T("Uploading app files from: {{.Path}}", map[string]interface{}{"Path": appDir}))
+	pkg, err := cmd.uploadPackage(application.GUID, appDir)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	cmd.ui.Say(T("Staging package for app {{.AppName}}...", map[string]interface{}{"AppName": terminal.EntityNameColor(appName)}))
+	build, err := cmd.stagePackage(pkg.GUID)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	cmd.ui.Say(T("Starting app {{.AppName}} with droplet {{.DropletGUID}}...",
+		map[string]interface{}{
+			"AppName":     terminal.EntityNameColor(appName),
+			"DropletGUID": terminal.EntityNameColor(build.Droplet.GUID),
+		}))
+
+	err = cmd.repository.StopApplication(application.GUID)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	err = cmd.repository.SetCurrentDroplet(application.GUID, build.Droplet.GUID)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	err = cmd.repository.StartApplication(application.GUID)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	cmd.ui.Ok()
+}
+
+func (cmd *V3Push) findOrCreateApp(appName string) (models.V3Application, error) {
+	spaceGUID := cmd.config.SpaceFields().GUID
+
+	application, err := cmd.repository.GetApplicationByName(spaceGUID, appName)
+	switch err.(type) {
+	case nil:
+		return application, nil
+	case *errors.ModelNotFoundError:
+		cmd.ui.Say(T("Creating app {{.AppName}}...", map[string]interface{}{"AppName": terminal.EntityNameColor(appName)}))
+		return cmd.repository.CreateApplication(spaceGUID, appName)
+	default:
+		return models.V3Application{}, err
+	}
+}
+
+func (cmd *V3Push) uploadPackage(appGUID, appDir string) (models.V3Package, error) {
+	pkg, err := cmd.repository.CreatePackage(appGUID)
+	if err != nil {
+		return models.V3Package{}, err
+	}
+
+	zipFile, err := ioutil.TempFile("", "uploads")
+	if err != nil {
+		return models.V3Package{}, err
+	}
+	defer func() {
+		zipFile.Close()
+		os.Remove(zipFile.Name())
+	}()
+
+	err = cmd.zipper.Zip(appDir, zipFile)
+	if err != nil {
+		if emptyDirErr, ok := err.(*errors.EmptyDirError); ok {
+			return models.V3Package{}, emptyDirErr
+		}
+		return models.V3Package{}, fmt.Errorf("%s: %s", T("Error zipping application"), err.Error())
+	}
+
+	err = cmd.repository.UploadPackage(pkg.GUID, zipFile)
+	if err != nil {
+		return models.V3Package{}, err
+	}
+
+	startTime := time.Now()
+	for pkg.State != models.V3PackageReady {
+		switch pkg.State {
+		case models.V3PackageFailed, models.V3PackageExpired:
+			return models.V3Package{}, errors.New(T("Package {{.PackageGUID}} could not be processed: {{.State}}",
+				map[string]interface{}{"PackageGUID": pkg.GUID, "State": pkg.State}))
+		}
+
+		if time.Since(startTime) >= cmd.StagingTimeout {
+			return models.V3Package{}, errors.New(T("Package {{.PackageGUID}} was not processed within {{.Timeout}} minutes",
+				map[string]interface{}{"PackageGUID": pkg.GUID, "Timeout": cmd.StagingTimeout.Minutes()}))
+		}
+
+		time.Sleep(cmd.PingerThrottle)
+		pkg, err = cmd.repository.GetPackage(pkg.GUID)
+		if err != nil {
+			return models.V3Package{}, err
+		}
+	}
+
+	return pkg, nil
+}
+
+func (cmd *V3Push) stagePackage(packageGUID string) (models.V3Build, error) {
+	build, err := cmd.repository.CreateBuild(packageGUID)
+	if err != nil {
+		return models.V3Build{}, err
+	}
+
+	startTime := time.Now()
+	for build.State != models.V3BuildStaged {
+		if build.State == models.V3BuildFailed {
+			return models.V3Build{}, errors.New(T("Staging failed: {{.Error}}", map[string]interface{}{"Error": build.Error}))
+		}
+
+		if time.Since(startTime) >= cmd.StagingTimeout {
+			return models.V3Build{}, errors.New(T("Package failed to stage within {{.Timeout}} minutes",
+				map[string]interface{}{"Timeout": cmd.StagingTimeout.Minutes()}))
+		}
+
+		time.Sleep(cmd.PingerThrottle)
+		build, err = cmd.repository.GetBuild(build.GUID)
+		if err != nil {
+			return models.V3Build{}, err
+		}
+	}
+
+	if build.Droplet == nil {
+		return models.V3Build{}, errors.New(T("Staging completed without a droplet"))
+	}
+
+	return build, nil
+}
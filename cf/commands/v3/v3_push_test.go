@@ -0,0 +1,211 @@
+package v3_test
+
+import (
+	"errors"
+	"os"
+
+	"github.com/cloudfoundry/cli/cf/appfiles/appfilesfakes"
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/commands/v3"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	cferrors "github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/requirements/requirementsfakes"
+	"github.com/cloudfoundry/cli/cf/v3/models"
+	"github.com/cloudfoundry/cli/cf/v3/repository/repositoryfakes"
+	"github.com/cloudfoundry/cli/flags"
+
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V3Push", func() {
+	var (
+		ui         *testterm.FakeUI
+		configRepo coreconfig.Repository
+		repository *repositoryfakes.FakeRepository
+		zipper     *appfilesfakes.FakeZipper
+
+		cmd         *v3.V3Push
+		deps        commandregistry.Dependency
+		factory     *requirementsfakes.FakeFactory
+		flagContext flags.FlagContext
+
+		loginRequirement         requirements.Requirement
+		targetedSpaceRequirement requirements.Requirement
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		repository = new(repositoryfakes.FakeRepository)
+		zipper = new(appfilesfakes.FakeZipper)
+
+		deps = commandregistry.Dependency{
+			UI:          ui,
+			Config:      configRepo,
+			RepoLocator: deps.RepoLocator.SetV3Repository(repository),
+			AppZipper:   zipper,
+		}
+
+		cmd = &v3.V3Push{}
+		cmd.SetDependency(deps, false)
+		cmd.PingerThrottle = 0
+
+		flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+
+		factory = new(requirementsfakes.FakeFactory)
+
+		loginRequirement = &passingRequirement{Name: "login-requirement"}
+		factory.NewLoginRequirementReturns(loginRequirement)
+
+		targetedSpaceRequirement = &passingRequirement{Name: "targeted-space-requirement"}
+		factory.NewTargetedSpaceRequirementReturns(targetedSpaceRequirement)
+	})
+
+	Describe("Requirements", func() {
+		It("fails with usage when not provided exactly one arg", func() {
+			flagContext.Parse()
+			Expect(func() { cmd.Requirements(factory, flagContext) }).To(Panic())
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Incorrect Usage. Requires an argument"},
+				[]string{"USAGE"},
+			))
+		})
+
+		It("returns a LoginRequirement and a TargetedSpaceRequirement", func() {
+			flagContext.Parse("app-name")
+			actualRequirements := cmd.Requirements(factory, flagContext)
+			Expect(actualRequirements).To(ContainElement(loginRequirement))
+			Expect(actualRequirements).To(ContainElement(targetedSpaceRequirement))
+		})
+	})
+
+	Describe("Execute", func() {
+		BeforeEach(func() {
+			flagContext.Parse("app-name", "-p", "/path/to/app")
+
+			repository.GetApplicationByNameReturns(models.V3Application{GUID: "app-guid", Name: "app-name"}, nil)
+			repository.CreatePackageReturns(models.V3Package{GUID: "package-guid", State: "AWAITING_UPLOAD"}, nil)
+			repository.GetPackageReturns(models.V3Package{GUID: "package-guid", State: models.V3PackageReady}, nil)
+			repository.CreateBuildReturns(models.V3Build{GUID: "build-guid", State: models.V3BuildStaging}, nil)
+			repository.GetBuildReturns(models.V3Build{
+				GUID:    "build-guid",
+				State:   models.V3BuildStaged,
+				Droplet: &models.V3DropletRef{GUID: "droplet-guid"},
+			}, nil)
+		})
+
+		It("uploads the app directory, stages it and starts the app with the new droplet", func() {
+			cmd.Execute(flagContext)
+
+			spaceGUID, appName := repository.GetApplicationByNameArgsForCall(0)
+			Expect(spaceGUID).To(Equal(configRepo.SpaceFields().GUID))
+			Expect(appName).To(Equal("app-name"))
+			Expect(repository.CreateApplicationCallCount()).To(Equal(0))
+
+			Expect(repository.CreatePackageArgsForCall(0)).To(Equal("app-guid"))
+			appDir, _ := zipper.ZipArgsForCall(0)
+			Expect(appDir).To(Equal("/path/to/app"))
+			packageGUID, _ := repository.UploadPackageArgsForCall(0)
+			Expect(packageGUID).To(Equal("package-guid"))
+			Expect(repository.GetPackageArgsForCall(0)).To(Equal("package-guid"))
+
+			Expect(repository.CreateBuildArgsForCall(0)).To(Equal("package-guid"))
+			Expect(repository.GetBuildArgsForCall(0)).To(Equal("build-guid"))
+
+			Expect(repository.StopApplicationArgsForCall(0)).To(Equal("app-guid"))
+			appGUID, dropletGUID := repository.SetCurrentDropletArgsForCall(0)
+			Expect(appGUID).To(Equal("app-guid"))
+			Expect(dropletGUID).To(Equal("droplet-guid"))
+			Expect(repository.StartApplicationArgsForCall(0)).To(Equal("app-guid"))
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Pushing app", "app-name"},
+				[]string{"Uploading app files from", "/path/to/app"},
+				[]string{"Staging package"},
+				[]string{"Starting app", "droplet-guid"},
+				[]string{"OK"},
+			))
+		})
+
+		It("removes the temporary zip file", func() {
+			cmd.Execute(flagContext)
+
+			_, zipFile := zipper.ZipArgsForCall(0)
+			_, err := os.Stat(zipFile.Name())
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		Context("when the app does not exist", func() {
+			BeforeEach(func() {
+				repository.GetApplicationByNameReturns(models.V3Application{}, cferrors.NewModelNotFoundError("App", "app-name"))
+				repository.CreateApplicationReturns(models.V3Application{GUID: "new-app-guid", Name: "app-name"}, nil)
+			})
+
+			It("creates it in the targeted space", func() {
+				cmd.Execute(flagContext)
+
+				spaceGUID, appName := repository.CreateApplicationArgsForCall(0)
+				Expect(spaceGUID).To(Equal(configRepo.SpaceFields().GUID))
+				Expect(appName).To(Equal("app-name"))
+				Expect(repository.CreatePackageArgsForCall(0)).To(Equal("new-app-guid"))
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"Creating app", "app-name"}))
+			})
+		})
+
+		Context("when looking up the app fails", func() {
+			BeforeEach(func() {
+				repository.GetApplicationByNameReturns(models.V3Application{}, errors.New("get-app-err"))
+			})
+
+			It("fails without creating the app", func() {
+				Expect(func() { cmd.Execute(flagContext) }).To(Panic())
+				Expect(repository.CreateApplicationCallCount()).To(Equal(0))
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"get-app-err"}))
+			})
+		})
+
+		Context("when the package cannot be processed", func() {
+			BeforeEach(func() {
+				repository.GetPackageReturns(models.V3Package{GUID: "package-guid", State: models.V3PackageFailed}, nil)
+			})
+
+			It("fails without staging", func() {
+				Expect(func() { cmd.Execute(flagContext) }).To(Panic())
+				Expect(repository.CreateBuildCallCount()).To(Equal(0))
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"package-guid", "FAILED"}))
+			})
+		})
+
+		Context("when staging fails", func() {
+			BeforeEach(func() {
+				repository.GetBuildReturns(models.V3Build{GUID: "build-guid", State: models.V3BuildFailed, Error: "NoAppDetectedError"}, nil)
+			})
+
+			It("fails without touching the running app", func() {
+				Expect(func() { cmd.Execute(flagContext) }).To(Panic())
+				Expect(repository.StopApplicationCallCount()).To(Equal(0))
+				Expect(repository.SetCurrentDropletCallCount()).To(Equal(0))
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"Staging failed", "NoAppDetectedError"}))
+			})
+		})
+
+		Context("when staging does not finish in time", func() {
+			BeforeEach(func() {
+				cmd.StagingTimeout = 0
+				repository.CreatePackageReturns(models.V3Package{GUID: "package-guid", State: models.V3PackageReady}, nil)
+				repository.GetBuildReturns(models.V3Build{GUID: "build-guid", State: models.V3BuildStaging}, nil)
+			})
+
+			It("fails", func() {
+				Expect(func() { cmd.Execute(flagContext) }).To(Panic())
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"failed to stage within"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,155 @@
+package v3
+
+import (
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/formatters"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/cf/v3/models"
+	"github.com/cloudfoundry/cli/cf/v3/repository"
+	"github.com/cloudfoundry/cli/flags"
+
+	. "github.com/cloudfoundry/cli/cf/i18n"
+)
+
+type V3Scale struct {
+	ui         terminal.UI
+	config     coreconfig.Reader
+	repository repository.Repository
+}
+
+func init() {
+	commandregistry.Register(&V3Scale{})
+}
+
+func (cmd *V3Scale) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["process"] = &flags.StringFlag{Name: "process", Usage: T("App process to scale (Default: web)")}
+	fs["i"] = &flags.IntFlag{ShortName: "i", Usage: T("Number of instances")}
+	fs["k"] = &flags.StringFlag{ShortName: "k", Usage: T("Disk limit (e.g. 256M, 1024M, 1G)")}
+	fs["m"] = &flags.StringFlag{ShortName: "m", Usage: T("Memory limit (e.g. 256M, 1024M, 1G)")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "v3-scale",
+		Description: T("Change or view the instance count, disk space limit, and memory limit for a process of an app"),
+		Usage: []string{
+			T("CF_NAME v3-scale APP_NAME [--process PROCESS] [-i INSTANCES] [-k DISK] [-m MEMORY]"),
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *V3Scale) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) []requirements.Requirement {
+	if len(fc.Args()) != 1 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires an argument\n\n") + commandregistry.Commands.CommandUsage("v3-scale"))
+	}
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+	}
+
+	return reqs
+}
+
+func (cmd *V3Scale) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.repository = deps.RepoLocator.GetV3Repository()
+
+	return cmd
+}
+
+func (cmd *V3Scale) Execute(fc flags.FlagContext) {
+	appName := fc.Args()[0]
+	processType := fc.String("process")
+	if processType == "" {
+		processType = "web"
+	}
+
+	application, err := cmd.repository.GetApplicationByName(cmd.config.SpaceFields().GUID, appName)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	scale := models.V3ProcessScale{}
+
+	if fc.IsSet("i") {
+		instances := fc.Int("i")
+		scale.Instances = &instances
+	}
+
+	if fc.String("m") != "" {
+		memory, err := formatters.ToMegabytes(fc.String("m"))
+		if err != nil {
+			cmd.ui.Failed(T("Invalid memory limit: {{.Memory}}\n{{.ErrorDescription}}",
+				map[string]interface{}{
+					"Memory":           fc.String("m"),
+					"ErrorDescription": err,
+				}))
+		}
+		scale.MemoryInMB = &memory
+	}
+
+	if fc.String("k") != "" {
+		diskQuota, err := formatters.ToMegabytes(fc.String("k"))
+		if err != nil {
+			cmd.ui.Failed(T("Invalid disk quota: {{.DiskQuota}}\n{{.ErrorDescription}}",
+				map[string]interface{}{
+					"DiskQuota":        fc.String("k"),
+					"ErrorDescription": err,
+				}))
+		}
+		scale.DiskInMB = &diskQuota
+	}
+
+	var process models.V3Process
+	if scale.Instances == nil && scale.MemoryInMB == nil && scale.DiskInMB == nil {
+		cmd.ui.Say(T("Showing current scale of process {{.ProcessType}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+			cmd.messageArgs(processType, appName)))
+
+		process, err = cmd.findProcess(application, processType)
+	} else {
+		cmd.ui.Say(T("Scaling process {{.ProcessType}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+			cmd.messageArgs(processType, appName)))
+
+		process, err = cmd.repository.ScaleProcess(application.GUID, processType, scale)
+	}
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	cmd.ui.Say("%s %s", terminal.HeaderColor(T("memory:")), formatters.ByteSize(process.MemoryInMB*formatters.MEGABYTE))
+	cmd.ui.Say("%s %s", terminal.HeaderColor(T("disk:")), formatters.ByteSize(process.DiskInMB*formatters.MEGABYTE))
+	cmd.ui.Say("%s %d", terminal.HeaderColor(T("instances:")), process.Instances)
+}
+
+func (cmd *V3Scale) findProcess(application models.V3Application, processType string) (models.V3Process, error) {
+	processes, err := cmd.repository.GetProcesses(application.Links.Processes.Href)
+	if err != nil {
+		return models.V3Process{}, err
+	}
+
+	for _, process := range processes {
+		if process.Type == processType {
+			return process, nil
+		}
+	}
+
+	return models.V3Process{}, errors.NewModelNotFoundError("Process", processType)
+}
+
+func (cmd *V3Scale) messageArgs(processType, appName string) map[string]interface{} {
+	return map[string]interface{}{
+		"ProcessType": terminal.EntityNameColor(processType),
+		"AppName":     terminal.EntityNameColor(appName),
+		"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+		"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+		"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
+	}
+}
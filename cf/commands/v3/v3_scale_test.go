@@ -0,0 +1,129 @@
+package v3_test
+
+import (
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/commands/v3"
+	"github.com/cloudfoundry/cli/cf/requirements/requirementsfakes"
+	"github.com/cloudfoundry/cli/cf/v3/models"
+	"github.com/cloudfoundry/cli/cf/v3/repository/repositoryfakes"
+	"github.com/cloudfoundry/cli/flags"
+
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V3Scale", func() {
+	var (
+		ui         *testterm.FakeUI
+		repository *repositoryfakes.FakeRepository
+
+		cmd         commandregistry.Command
+		deps        commandregistry.Dependency
+		factory     *requirementsfakes.FakeFactory
+		flagContext flags.FlagContext
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		repository = new(repositoryfakes.FakeRepository)
+
+		deps = commandregistry.Dependency{
+			UI:          ui,
+			Config:      testconfig.NewRepositoryWithDefaults(),
+			RepoLocator: deps.RepoLocator.SetV3Repository(repository),
+		}
+
+		cmd = &v3.V3Scale{}
+		cmd.SetDependency(deps, false)
+
+		flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+		factory = new(requirementsfakes.FakeFactory)
+
+		repository.GetApplicationByNameReturns(models.V3Application{
+			GUID: "app-guid",
+			Links: models.Links{
+				Processes: models.Link{Href: "/v3/apps/app-guid/processes"},
+			},
+		}, nil)
+	})
+
+	Describe("Requirements", func() {
+		It("fails with usage when not provided exactly one arg", func() {
+			flagContext.Parse("app-name", "extra")
+			Expect(func() { cmd.Requirements(factory, flagContext) }).To(Panic())
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"Incorrect Usage. Requires an argument"}))
+		})
+	})
+
+	Describe("Execute", func() {
+		Context("when no scale flags are given", func() {
+			BeforeEach(func() {
+				repository.GetProcessesReturns([]models.V3Process{
+					{Type: "web", Instances: 1, MemoryInMB: 1024, DiskInMB: 1024},
+					{Type: "worker", Instances: 3, MemoryInMB: 256, DiskInMB: 512},
+				}, nil)
+			})
+
+			It("shows the current scale of the process", func() {
+				flagContext.Parse("app-name", "--process", "worker")
+				cmd.Execute(flagContext)
+
+				Expect(repository.GetProcessesArgsForCall(0)).To(Equal("/v3/apps/app-guid/processes"))
+				Expect(repository.ScaleProcessCallCount()).To(Equal(0))
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Showing current scale of process", "worker", "app-name"},
+					[]string{"memory:", "256M"},
+					[]string{"disk:", "512M"},
+					[]string{"instances:", "3"},
+				))
+			})
+
+			It("fails when the app has no such process", func() {
+				flagContext.Parse("app-name", "--process", "clock")
+				Expect(func() { cmd.Execute(flagContext) }).To(Panic())
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"clock"}))
+			})
+		})
+
+		Context("when scale flags are given", func() {
+			BeforeEach(func() {
+				flagContext.Parse("app-name", "-i", "4", "-m", "2G")
+				repository.ScaleProcessReturns(models.V3Process{Type: "web", Instances: 4, MemoryInMB: 2048, DiskInMB: 1024}, nil)
+			})
+
+			It("scales the web process by default", func() {
+				cmd.Execute(flagContext)
+
+				appGUID, processType, scale := repository.ScaleProcessArgsForCall(0)
+				Expect(appGUID).To(Equal("app-guid"))
+				Expect(processType).To(Equal("web"))
+				Expect(*scale.Instances).To(Equal(4))
+				Expect(*scale.MemoryInMB).To(Equal(int64(2048)))
+				Expect(scale.DiskInMB).To(BeNil())
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Scaling process", "web", "app-name"},
+					[]string{"OK"},
+					[]string{"memory:", "2G"},
+					[]string{"instances:", "4"},
+				))
+			})
+		})
+
+		Context("when the memory limit is invalid", func() {
+			BeforeEach(func() {
+				flagContext.Parse("app-name", "-m", "lots")
+			})
+
+			It("fails", func() {
+				Expect(func() { cmd.Execute(flagContext) }).To(Panic())
+				Expect(repository.ScaleProcessCallCount()).To(Equal(0))
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"Invalid memory limit", "lots"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,154 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cloudfoundry/cli/cf/api"
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/manifest"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/flags"
+)
+
+type ValidateManifest struct {
+	ui           terminal.UI
+	config       coreconfig.Reader
+	manifestRepo manifest.ManifestRepository
+	serviceRepo  api.ServiceRepository
+}
+
+func init() {
+	commandregistry.Register(&ValidateManifest{})
+}
+
+func (cmd *ValidateManifest) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["f"] = &flags.StringFlag{ShortName: "f", Usage: T("Path to manifest")}
+	fs["vars-file"] = &flags.StringSliceFlag{Name: "vars-file", Usage: T("Path to a YAML file with values for the ((variables)) of the manifest, flag can be specified multiple times")}
+	fs["var"] = &flags.StringSliceFlag{Name: "var", Usage: T("Value for a ((variable)) of the manifest as KEY=VALUE, flag can be specified multiple times")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "validate-manifest",
+		Description: T("Check a manifest for errors without pushing it"),
+		Usage: []string{
+			T("CF_NAME validate-manifest [-f MANIFEST_PATH] [--vars-file VARS_FILE_PATH] [--var KEY=VALUE]"),
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *ValidateManifest) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) []requirements.Requirement {
+	if len(fc.Args()) != 0 {
+		cmd.ui.Failed(T("Incorrect Usage. No argument required\n\n") + commandregistry.Commands.CommandUsage("validate-manifest"))
+	}
+
+	return []requirements.Requirement{}
+}
+
+func (cmd *ValidateManifest) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.manifestRepo = deps.ManifestRepo
+	cmd.serviceRepo = deps.RepoLocator.GetServiceRepository()
+	return cmd
+}
+
+func (cmd *ValidateManifest) Execute(c flags.FlagContext) {
+	path := c.String("f")
+	if path == "" {
+		var err error
+		path, err = os.Getwd()
+		if err != nil {
+			cmd.ui.Failed(T("Could not determine the current working directory!"))
+		}
+	}
+
+	m, err := cmd.manifestRepo.ReadManifest(path)
+	if err != nil {
+		cmd.ui.Failed(T("Error reading manifest file:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
+	}
+
+	cmd.ui.Say(T("Validating manifest {{.Path}}...", map[string]interface{}{"Path": terminal.EntityNameColor(m.Path)}))
+
+	vars, err := manifest.ReadVarsFiles(c.StringSlice("vars-file"))
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+	err = vars.AddVarFlags(c.StringSlice("var"))
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+	err = m.Interpolate(vars)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	validationErrs := m.Validate()
+	validationErrs = append(validationErrs, cmd.validateServices(m)...)
+	if len(validationErrs) == 0 {
+		cmd.ui.Ok()
+		cmd.ui.Say("")
+		cmd.ui.Say(T("Manifest is valid"))
+		return
+	}
+
+	cmd.ui.Say("")
+	for _, validationErr := range validationErrs {
+		location := validationErr.Path
+		if validationErr.Line > 0 {
+			location = fmt.Sprintf("%s:%d", location, validationErr.Line)
+		}
+
+		message := validationErr.Message
+		if validationErr.Key != "" && !strings.Contains(message, validationErr.Key) {
+			message = fmt.Sprintf("%s: %s", validationErr.Key, message)
+		}
+
+		if validationErr.AppName != "" {
+			cmd.ui.Say(T("{{.Location}}: app {{.AppName}}: {{.Message}}", map[string]interface{}{
+				"Location": location,
+				"AppName":  terminal.EntityNameColor(validationErr.AppName),
+				"Message":  message,
+			}))
+		} else {
+			cmd.ui.Say("%s: %s", location, message)
+		}
+	}
+	cmd.ui.Say("")
+
+	cmd.ui.Failed(T("Found {{.Count}} error(s) in manifest", map[string]interface{}{"Count": len(validationErrs)}))
+}
+
+// validateServices checks that the services bound by the manifest exist in
+// the targeted space. The rest of the validation works offline, so it is
+// skipped without a target rather than requiring a login.
+func (cmd *ValidateManifest) validateServices(m *manifest.Manifest) []manifest.ValidationError {
+	if !cmd.config.IsLoggedIn() || !cmd.config.HasSpace() {
+		cmd.ui.Say(T("Not checking service names because no space is targeted"))
+		return nil
+	}
+
+	validationErrs, err := m.ValidateServices(func(name string) (bool, error) {
+		_, err := cmd.serviceRepo.FindInstanceByName(name)
+		switch err.(type) {
+		case nil:
+			return true, nil
+		case *errors.ModelNotFoundError:
+			return false, nil
+		default:
+			return false, err
+		}
+	})
+	if err != nil {
+		cmd.ui.Warn(T("Could not check service names: {{.Err}}", map[string]interface{}{"Err": err.Error()}))
+		return nil
+	}
+
+	return validationErrs
+}
@@ -0,0 +1,179 @@
+package commands_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/cli/cf/api/apifakes"
+	"github.com/cloudfoundry/cli/cf/commandregistry"
+	"github.com/cloudfoundry/cli/cf/commands"
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	cferrors "github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/manifest"
+	"github.com/cloudfoundry/cli/cf/manifest/manifestfakes"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/requirements/requirementsfakes"
+	"github.com/cloudfoundry/cli/flags"
+	"github.com/cloudfoundry/cli/generic"
+
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateManifest", func() {
+	var (
+		ui           *testterm.FakeUI
+		config       coreconfig.Repository
+		manifestRepo *manifestfakes.FakeManifestRepository
+		serviceRepo  *apifakes.FakeServiceRepository
+
+		cmd         commandregistry.Command
+		deps        commandregistry.Dependency
+		factory     *requirementsfakes.FakeFactory
+		flagContext flags.FlagContext
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		config = testconfig.NewRepository()
+		manifestRepo = new(manifestfakes.FakeManifestRepository)
+		serviceRepo = new(apifakes.FakeServiceRepository)
+
+		deps = commandregistry.Dependency{
+			UI:           ui,
+			Config:       config,
+			ManifestRepo: manifestRepo,
+		}
+		deps.RepoLocator = deps.RepoLocator.SetServiceRepository(serviceRepo)
+
+		cmd = &commands.ValidateManifest{}
+
+		flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+		factory = new(requirementsfakes.FakeFactory)
+	})
+
+	JustBeforeEach(func() {
+		cmd.SetDependency(deps, false)
+	})
+
+	Describe("Requirements", func() {
+		It("fails with usage when provided an argument", func() {
+			flagContext.Parse("app-name")
+			Expect(func() { cmd.Requirements(factory, flagContext) }).To(Panic())
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"Incorrect Usage. No argument required"}))
+		})
+
+		It("does not require a login", func() {
+			flagContext.Parse()
+			Expect(cmd.Requirements(factory, flagContext)).To(BeEmpty())
+			Expect(factory.NewLoginRequirementCallCount()).To(BeZero())
+		})
+	})
+
+	Describe("Execute", func() {
+		It("reads the manifest at the given path", func() {
+			manifestRepo.ReadManifestReturns(&manifest.Manifest{
+				Path: "some/manifest.yml",
+				Data: generic.NewMap(map[interface{}]interface{}{
+					"applications": []interface{}{
+						map[interface{}]interface{}{"name": "app1", "no-route": true},
+					},
+				}),
+			}, nil)
+
+			flagContext.Parse("-f", "some/manifest.yml")
+			cmd.Execute(flagContext)
+
+			Expect(manifestRepo.ReadManifestArgsForCall(0)).To(Equal("some/manifest.yml"))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Validating manifest", "some/manifest.yml"},
+				[]string{"Not checking service names because no space is targeted"},
+				[]string{"OK"},
+				[]string{"Manifest is valid"},
+			))
+		})
+
+		It("prints every error of the manifest", func() {
+			manifestRepo.ReadManifestReturns(&manifest.Manifest{
+				Path: "some/manifest.yml",
+				Data: generic.NewMap(map[interface{}]interface{}{
+					"colour": "blue",
+					"applications": []interface{}{
+						map[interface{}]interface{}{"name": "app1", "no-route": true, "host": "app1-host"},
+					},
+				}),
+			}, nil)
+
+			flagContext.Parse("-f", "some/manifest.yml")
+			Expect(func() { cmd.Execute(flagContext) }).To(Panic())
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"some/manifest.yml: unknown property colour"},
+				[]string{"some/manifest.yml: app app1: host cannot be used with no-route"},
+				[]string{"FAILED"},
+				[]string{"Found 2 error(s) in manifest"},
+			))
+		})
+
+		Context("when a space is targeted", func() {
+			BeforeEach(func() {
+				deps.Config = testconfig.NewRepositoryWithDefaults()
+
+				manifestRepo.ReadManifestReturns(&manifest.Manifest{
+					Path: "some/manifest.yml",
+					Data: generic.NewMap(map[interface{}]interface{}{
+						"applications": []interface{}{
+							map[interface{}]interface{}{"name": "app1", "services": []interface{}{"my-db", "my-dbb"}},
+							map[interface{}]interface{}{"name": "app2", "services": []interface{}{"my-db"}},
+						},
+					}),
+				}, nil)
+			})
+
+			It("reports the services that are not in the space", func() {
+				serviceRepo.FindInstanceByNameStub = func(name string) (models.ServiceInstance, error) {
+					if name == "my-db" {
+						return models.ServiceInstance{}, nil
+					}
+					return models.ServiceInstance{}, cferrors.NewModelNotFoundError("Service instance", name)
+				}
+
+				flagContext.Parse("-f", "some/manifest.yml")
+				Expect(func() { cmd.Execute(flagContext) }).To(Panic())
+
+				Expect(serviceRepo.FindInstanceByNameCallCount()).To(Equal(2))
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"some/manifest.yml: app app1: services: service instance my-dbb not found"},
+					[]string{"Found 1 error(s) in manifest"},
+				))
+			})
+
+			It("warns and skips the check when the services cannot be looked up", func() {
+				serviceRepo.FindInstanceByNameReturns(models.ServiceInstance{}, errors.New("lookup-error"))
+
+				flagContext.Parse("-f", "some/manifest.yml")
+				cmd.Execute(flagContext)
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Could not check service names: lookup-error"},
+					[]string{"Manifest is valid"},
+				))
+			})
+		})
+
+		It("fails when the manifest cannot be read", func() {
+			manifestRepo.ReadManifestReturns(manifest.NewEmptyManifest(), errors.New("read-error"))
+
+			flagContext.Parse()
+			Expect(func() { cmd.Execute(flagContext) }).To(Panic())
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Error reading manifest file"},
+				[]string{"read-error"},
+			))
+		})
+	})
+})
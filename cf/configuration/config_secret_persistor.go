@@ -0,0 +1,155 @@
+package configuration
+
+import (
+	"reflect"
+
+	"github.com/cloudfoundry/cli/cf/configuration/secretstore"
+)
+
+// SecretData is implemented by data that has secrets which should be kept
+// in a secretstore.SecretStore instead of the config file.
+type SecretData interface {
+	DataInterface
+	Secrets() map[string]string
+	SetSecrets(map[string]string)
+}
+
+// SecretPersistor wraps a Persistor, moving the secrets of SecretData into a
+// secret store on Save and restoring them on Load. Secrets found in the file
+// on Load, e.g. tokens written by an older CLI, are moved into the store.
+type SecretPersistor struct {
+	persistor Persistor
+	store     secretstore.SecretStore
+	namespace string
+	stored    map[string]string
+}
+
+func NewSecretPersistor(persistor Persistor, store secretstore.SecretStore, namespace string) *SecretPersistor {
+	return &SecretPersistor{
+		persistor: persistor,
+		store:     store,
+		namespace: namespace,
+	}
+}
+
+func (sp *SecretPersistor) Exists() bool {
+	return sp.persistor.Exists()
+}
+
+func (sp *SecretPersistor) Delete() {
+	sp.persistor.Delete()
+	_ = sp.store.Delete(sp.namespace)
+	sp.stored = nil
+}
+
+func (sp *SecretPersistor) Load(data DataInterface) error {
+	err := sp.persistor.Load(data)
+	if err != nil {
+		return err
+	}
+
+	secretData, ok := data.(SecretData)
+	if !ok {
+		return nil
+	}
+
+	if hasSecrets(secretData.Secrets()) {
+		return sp.Save(data)
+	}
+
+	secrets, err := sp.store.Get(sp.namespace)
+	if err != nil {
+		return err
+	}
+
+	secretData.SetSecrets(secrets)
+	sp.stored = secrets
+	return nil
+}
+
+func (sp *SecretPersistor) Save(data DataInterface) error {
+	secretData, ok := data.(SecretData)
+	if !ok {
+		return sp.persistor.Save(data)
+	}
+
+	secrets := secretData.Secrets()
+	if sp.stored == nil || !reflect.DeepEqual(secrets, sp.stored) {
+		var err error
+		if hasSecrets(secrets) {
+			err = sp.store.Set(sp.namespace, secrets)
+		} else {
+			err = sp.store.Delete(sp.namespace)
+		}
+		if err != nil {
+			return err
+		}
+		sp.stored = secrets
+	}
+
+	secretData.SetSecrets(map[string]string{})
+	defer secretData.SetSecrets(secrets)
+
+	return sp.persistor.Save(data)
+}
+
+func hasSecrets(secrets map[string]string) bool {
+	for _, secret := range secrets {
+		if secret != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// PlaintextPersistor wraps a Persistor that keeps secrets in the config file,
+// calling warn the first time secrets are written into a file that held
+// none, e.g. on login, so that users learn their tokens are not encrypted.
+type PlaintextPersistor struct {
+	persistor  Persistor
+	warn       func()
+	hadSecrets bool
+}
+
+func NewPlaintextPersistor(persistor Persistor, warn func()) *PlaintextPersistor {
+	return &PlaintextPersistor{
+		persistor: persistor,
+		warn:      warn,
+	}
+}
+
+func (pp *PlaintextPersistor) Exists() bool {
+	return pp.persistor.Exists()
+}
+
+func (pp *PlaintextPersistor) Delete() {
+	pp.persistor.Delete()
+	pp.hadSecrets = false
+}
+
+func (pp *PlaintextPersistor) Load(data DataInterface) error {
+	err := pp.persistor.Load(data)
+	if err != nil {
+		return err
+	}
+
+	if secretData, ok := data.(SecretData); ok {
+		pp.hadSecrets = hasSecrets(secretData.Secrets())
+	}
+	return nil
+}
+
+func (pp *PlaintextPersistor) Save(data DataInterface) error {
+	err := pp.persistor.Save(data)
+	if err != nil {
+		return err
+	}
+
+	if secretData, ok := data.(SecretData); ok && hasSecrets(secretData.Secrets()) {
+		if !pp.hadSecrets {
+			pp.warn()
+		}
+		pp.hadSecrets = true
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package configuration_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+
+	. "github.com/cloudfoundry/cli/cf/configuration"
+	"github.com/cloudfoundry/cli/cf/configuration/secretstore/secretstorefakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SecretPersistor", func() {
+	var (
+		tmpFile   *os.File
+		store     *secretstorefakes.FakeSecretStore
+		persistor *SecretPersistor
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpFile, err = ioutil.TempFile("", "secret_persistor")
+		Expect(err).ToNot(HaveOccurred())
+		tmpFile.Close()
+
+		store = new(secretstorefakes.FakeSecretStore)
+		store.GetReturns(map[string]string{}, nil)
+		persistor = NewSecretPersistor(NewDiskPersistor(tmpFile.Name()), store, "some-namespace")
+	})
+
+	AfterEach(func() {
+		os.Remove(tmpFile.Name())
+	})
+
+	readFile := func() *secretData {
+		d := &secretData{}
+		contents, err := ioutil.ReadFile(tmpFile.Name())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(json.Unmarshal(contents, d)).To(Succeed())
+		return d
+	}
+
+	Describe(".Save", func() {
+		It("keeps the secrets in the store instead of the file", func() {
+			d := &secretData{Info: "save test", Token: "some-token"}
+
+			Expect(persistor.Save(d)).To(Succeed())
+
+			Expect(store.SetCallCount()).To(Equal(1))
+			namespace, secrets := store.SetArgsForCall(0)
+			Expect(namespace).To(Equal("some-namespace"))
+			Expect(secrets).To(Equal(map[string]string{"Token": "some-token"}))
+
+			Expect(readFile()).To(Equal(&secretData{Info: "save test"}))
+			Expect(d.Token).To(Equal("some-token"))
+		})
+
+		It("does not update the store when the secrets are unchanged", func() {
+			d := &secretData{Info: "save test", Token: "some-token"}
+
+			Expect(persistor.Save(d)).To(Succeed())
+			d.Info = "changed"
+			Expect(persistor.Save(d)).To(Succeed())
+
+			Expect(store.SetCallCount()).To(Equal(1))
+			Expect(readFile().Info).To(Equal("changed"))
+		})
+
+		It("deletes the secrets from the store when they are cleared", func() {
+			Expect(persistor.Save(&secretData{Token: "some-token"})).To(Succeed())
+			Expect(persistor.Save(&secretData{})).To(Succeed())
+
+			Expect(store.DeleteCallCount()).To(Equal(1))
+			Expect(store.DeleteArgsForCall(0)).To(Equal("some-namespace"))
+		})
+
+		It("returns the error when the store fails and does not write the file", func() {
+			store.SetReturns(errors.New("keyring locked"))
+
+			err := persistor.Save(&secretData{Info: "save test", Token: "some-token"})
+			Expect(err).To(MatchError("keyring locked"))
+
+			contents, err := ioutil.ReadFile(tmpFile.Name())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(contents).To(BeEmpty())
+		})
+	})
+
+	Describe(".Load", func() {
+		It("restores the secrets from the store", func() {
+			Expect(ioutil.WriteFile(tmpFile.Name(), []byte(`{"Info":"load test"}`), 0600)).To(Succeed())
+			store.GetReturns(map[string]string{"Token": "some-token"}, nil)
+
+			d := &secretData{}
+			Expect(persistor.Load(d)).To(Succeed())
+
+			Expect(store.GetArgsForCall(0)).To(Equal("some-namespace"))
+			Expect(d).To(Equal(&secretData{Info: "load test", Token: "some-token"}))
+		})
+
+		It("moves plaintext secrets from the file into the store", func() {
+			Expect(ioutil.WriteFile(tmpFile.Name(), []byte(`{"Info":"load test","Token":"plaintext-token"}`), 0600)).To(Succeed())
+
+			d := &secretData{}
+			Expect(persistor.Load(d)).To(Succeed())
+
+			Expect(d.Token).To(Equal("plaintext-token"))
+			Expect(store.GetCallCount()).To(Equal(0))
+			Expect(store.SetCallCount()).To(Equal(1))
+			_, secrets := store.SetArgsForCall(0)
+			Expect(secrets).To(Equal(map[string]string{"Token": "plaintext-token"}))
+			Expect(readFile()).To(Equal(&secretData{Info: "load test"}))
+		})
+
+		It("returns the error when the store fails", func() {
+			Expect(ioutil.WriteFile(tmpFile.Name(), []byte(`{"Info":"load test"}`), 0600)).To(Succeed())
+			store.GetReturns(nil, errors.New("keyring locked"))
+
+			Expect(persistor.Load(&secretData{})).To(MatchError("keyring locked"))
+		})
+	})
+
+	Describe(".Delete", func() {
+		It("deletes the file and the secrets", func() {
+			persistor.Delete()
+
+			Expect(persistor.Exists()).To(BeFalse())
+			Expect(store.DeleteCallCount()).To(Equal(1))
+			Expect(store.DeleteArgsForCall(0)).To(Equal("some-namespace"))
+		})
+	})
+})
+
+var _ = Describe("PlaintextPersistor", func() {
+	var (
+		tmpFile   *os.File
+		warnings  int
+		persistor *PlaintextPersistor
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpFile, err = ioutil.TempFile("", "plaintext_persistor")
+		Expect(err).ToNot(HaveOccurred())
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+
+		warnings = 0
+		persistor = NewPlaintextPersistor(NewDiskPersistor(tmpFile.Name()), func() { warnings++ })
+	})
+
+	AfterEach(func() {
+		os.Remove(tmpFile.Name())
+	})
+
+	It("warns once when secrets are first written to the file", func() {
+		d := &secretData{Info: "plaintext test"}
+		Expect(persistor.Load(d)).To(Succeed())
+		Expect(persistor.Save(d)).To(Succeed())
+		Expect(warnings).To(Equal(0))
+
+		d.Token = "some-token"
+		Expect(persistor.Save(d)).To(Succeed())
+		Expect(warnings).To(Equal(1))
+
+		d.Token = "refreshed-token"
+		Expect(persistor.Save(d)).To(Succeed())
+		Expect(warnings).To(Equal(1))
+	})
+
+	It("does not warn when the file already held secrets", func() {
+		Expect(NewDiskPersistor(tmpFile.Name()).Save(&secretData{Token: "some-token"})).To(Succeed())
+
+		d := &secretData{}
+		Expect(persistor.Load(d)).To(Succeed())
+		d.Token = "refreshed-token"
+		Expect(persistor.Save(d)).To(Succeed())
+		Expect(warnings).To(Equal(0))
+	})
+})
+
+type secretData struct {
+	Info  string
+	Token string `json:",omitempty"`
+}
+
+func (d *secretData) JSONMarshalV3() ([]byte, error) {
+	return json.MarshalIndent(d, "", "  ")
+}
+
+func (d *secretData) JSONUnmarshalV3(data []byte) error {
+	return json.Unmarshal(data, d)
+}
+
+func (d *secretData) Secrets() map[string]string {
+	return map[string]string{"Token": d.Token}
+}
+
+func (d *secretData) SetSecrets(secrets map[string]string) {
+	d.Token = secrets["Token"]
+}
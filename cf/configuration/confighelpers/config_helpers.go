@@ -4,10 +4,47 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"runtime"
 )
 
+var profileNameRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// IsValidProfileName tells whether name can be used as the name of a
+// profile, which keeps profiles inside the profiles directory.
+func IsValidProfileName(name string) bool {
+	return profileNameRegexp.MatchString(name)
+}
+
+// DefaultFilePath returns the path of the config file, which is the file of
+// the profile named by CF_PROFILE when it is set.
 func DefaultFilePath() (string, error) {
+	if profile := os.Getenv("CF_PROFILE"); profile != "" {
+		if !IsValidProfileName(profile) {
+			return "", fmt.Errorf("Invalid profile name '%s'", profile)
+		}
+
+		profilesDir, err := ProfilesDir()
+		if err != nil {
+			return "", err
+		}
+
+		profilePath := filepath.Join(profilesDir, profile+".json")
+		if _, err := os.Stat(profilePath); err != nil {
+			return "", fmt.Errorf("Error locating profile '%s'", profile)
+		}
+		return profilePath, nil
+	}
+
+	configDir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "config.json"), nil
+}
+
+func ConfigDir() (string, error) {
 	var homeDir string
 
 	if os.Getenv("CF_HOME") != "" {
@@ -20,7 +57,26 @@ func DefaultFilePath() (string, error) {
 		homeDir = userHomeDir()
 	}
 
-	return filepath.Join(homeDir, ".cf", "config.json"), nil
+	return filepath.Join(homeDir, ".cf"), nil
+}
+
+func ProfilesDir() (string, error) {
+	configDir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "profiles"), nil
+}
+
+// HTTPCacheDir is where responses are cached when the HTTP cache is enabled.
+func HTTPCacheDir() (string, error) {
+	configDir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "http-cache"), nil
 }
 
 // See: http://stackoverflow.com/questions/7922270/obtain-users-home-directory
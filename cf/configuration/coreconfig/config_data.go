@@ -30,10 +30,15 @@ type Data struct {
 	AccessToken              string
 	SSHOAuthClient           string
 	RefreshToken             string
+	UAAGrantType             string
+	UAAOAuthClient           string
+	UAAOAuthClientSecret     string
 	OrganizationFields       models.OrganizationFields
 	SpaceFields              models.SpaceFields
 	SSLDisabled              bool
 	AsyncTimeout             uint
+	RequestRetries           uint
+	HTTPCacheTTL             uint
 	Trace                    string
 	ColorEnabled             string
 	Locale                   string
@@ -65,3 +70,21 @@ func (d *Data) JSONUnmarshalV3(input []byte) (err error) {
 
 	return
 }
+
+// Secrets returns the fields of the config that are kept in the secret
+// store when one is configured.
+func (d *Data) Secrets() map[string]string {
+	return map[string]string{
+		"AccessToken":          d.AccessToken,
+		"RefreshToken":         d.RefreshToken,
+		"UAAOAuthClientSecret": d.UAAOAuthClientSecret,
+	}
+}
+
+// SetSecrets sets the fields returned by Secrets, clearing those missing
+// from secrets.
+func (d *Data) SetSecrets(secrets map[string]string) {
+	d.AccessToken = secrets["AccessToken"]
+	d.RefreshToken = secrets["RefreshToken"]
+	d.UAAOAuthClientSecret = secrets["UAAOAuthClientSecret"]
+}
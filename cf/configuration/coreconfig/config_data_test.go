@@ -22,6 +22,9 @@ var _ = Describe("V3 Config files", func() {
 		"AccessToken": "the-access-token",
 		"SSHOAuthClient": "ssh-oauth-client-id",
 		"RefreshToken": "the-refresh-token",
+		"UAAGrantType": "client_credentials",
+		"UAAOAuthClient": "the-client",
+		"UAAOAuthClientSecret": "the-client-secret",
 		"OrganizationFields": {
 			"GUID": "the-org-guid",
 			"Name": "the-org",
@@ -42,6 +45,8 @@ var _ = Describe("V3 Config files", func() {
 		},
 		"SSLDisabled": true,
 		"AsyncTimeout": 1000,
+		"RequestRetries": 3,
+		"HTTPCacheTTL": 30,
 		"Trace": "path/to/some/file",
 		"ColorEnabled": "true",
 		"Locale": "fr_FR",
@@ -88,6 +93,8 @@ var _ = Describe("V3 Config files", func() {
 		},
 		"SSLDisabled": true,
 		"AsyncTimeout": 1000,
+		"RequestRetries": 3,
+		"HTTPCacheTTL": 30,
 		"Trace": "path/to/some/file",
 		"ColorEnabled": "true",
 		"Locale": "fr_FR",
@@ -114,6 +121,9 @@ var _ = Describe("V3 Config files", func() {
 				AccessToken:              "the-access-token",
 				RefreshToken:             "the-refresh-token",
 				SSHOAuthClient:           "ssh-oauth-client-id",
+				UAAGrantType:             "client_credentials",
+				UAAOAuthClient:           "the-client",
+				UAAOAuthClientSecret:     "the-client-secret",
 				MinCLIVersion:            "6.0.0",
 				MinRecommendedCLIVersion: "6.9.0",
 				OrganizationFields: models.OrganizationFields{
@@ -124,11 +134,13 @@ var _ = Describe("V3 Config files", func() {
 					GUID: "the-space-guid",
 					Name: "the-space",
 				},
-				SSLDisabled:  true,
-				Trace:        "path/to/some/file",
-				AsyncTimeout: 1000,
-				ColorEnabled: "true",
-				Locale:       "fr_FR",
+				SSLDisabled:    true,
+				Trace:          "path/to/some/file",
+				AsyncTimeout:   1000,
+				RequestRetries: 3,
+				HTTPCacheTTL:   30,
+				ColorEnabled:   "true",
+				Locale:         "fr_FR",
 				PluginRepos: []models.PluginRepo{
 					{
 						Name: "repo1",
@@ -164,6 +176,9 @@ var _ = Describe("V3 Config files", func() {
 				AccessToken:              "the-access-token",
 				RefreshToken:             "the-refresh-token",
 				SSHOAuthClient:           "ssh-oauth-client-id",
+				UAAGrantType:             "client_credentials",
+				UAAOAuthClient:           "the-client",
+				UAAOAuthClientSecret:     "the-client-secret",
 				MinCLIVersion:            "6.0.0",
 				MinRecommendedCLIVersion: "6.9.0",
 				OrganizationFields: models.OrganizationFields{
@@ -174,11 +189,13 @@ var _ = Describe("V3 Config files", func() {
 					GUID: "the-space-guid",
 					Name: "the-space",
 				},
-				SSLDisabled:  true,
-				Trace:        "path/to/some/file",
-				AsyncTimeout: 1000,
-				ColorEnabled: "true",
-				Locale:       "fr_FR",
+				SSLDisabled:    true,
+				Trace:          "path/to/some/file",
+				AsyncTimeout:   1000,
+				RequestRetries: 3,
+				HTTPCacheTTL:   30,
+				ColorEnabled:   "true",
+				Locale:         "fr_FR",
 				PluginRepos: []models.PluginRepo{
 					{
 						Name: "repo1",
@@ -1,11 +1,16 @@
 package coreconfig
 
 import (
+	"fmt"
+	"os"
 	"strings"
 	"sync"
 
 	"github.com/blang/semver"
 	"github.com/cloudfoundry/cli/cf/configuration"
+	"github.com/cloudfoundry/cli/cf/configuration/confighelpers"
+	"github.com/cloudfoundry/cli/cf/configuration/secretstore"
+	. "github.com/cloudfoundry/cli/cf/i18n"
 	"github.com/cloudfoundry/cli/cf/models"
 )
 
@@ -31,7 +36,35 @@ func NewRepositoryFromFilepath(filepath string, errorHandler func(error)) Reposi
 	if errorHandler == nil {
 		return nil
 	}
-	return NewRepositoryFromPersistor(configuration.NewDiskPersistor(filepath), errorHandler)
+	return NewRepositoryFromPersistor(newPersistor(filepath), errorHandler)
+}
+
+// newPersistor returns a persistor for the config file at path which keeps
+// the tokens in the secret store selected by the environment, if any.
+func newPersistor(path string) configuration.Persistor {
+	persistor := configuration.NewDiskPersistor(path)
+
+	configDir, err := confighelpers.ConfigDir()
+	if err != nil {
+		return persistor
+	}
+
+	store := secretstore.NewFromEnv(configDir)
+	if store == nil {
+		if os.Getenv("CF_SECRET_STORE") == secretstore.PlaintextStoreName {
+			return persistor
+		}
+		return configuration.NewPlaintextPersistor(persistor, func() { warnPlaintextSecrets(path) })
+	}
+
+	return configuration.NewSecretPersistor(persistor, store, path)
+}
+
+// warnPlaintextSecrets tells the user on stderr, so that it does not mix
+// with command output, that the tokens were written to the config file.
+func warnPlaintextSecrets(path string) {
+	fmt.Fprintln(os.Stderr, T("Tokens are stored unencrypted in {{.Path}} because no Secret Service keyring is available. Set CF_SECRET_PASSPHRASE to encrypt them, or CF_SECRET_STORE=plaintext to hide this warning.",
+		map[string]interface{}{"Path": path}))
 }
 
 func NewRepositoryFromPersistor(persistor configuration.Persistor, errorHandler func(error)) Repository {
@@ -66,6 +99,9 @@ type Reader interface {
 	AccessToken() string
 	SSHOAuthClient() string
 	RefreshToken() string
+	UAAGrantType() string
+	UAAOAuthClient() string
+	UAAOAuthClientSecret() string
 
 	OrganizationFields() models.OrganizationFields
 	HasOrganization() bool
@@ -84,6 +120,8 @@ type Reader interface {
 	MinRecommendedCLIVersion() string
 
 	AsyncTimeout() uint
+	RequestRetries() uint
+	HTTPCacheTTL() uint
 	Trace() string
 
 	ColorEnabled() string
@@ -110,10 +148,15 @@ type ReadWriter interface {
 	SetAccessToken(string)
 	SetSSHOAuthClient(string)
 	SetRefreshToken(string)
+	SetUAAGrantType(string)
+	SetUAAOAuthClient(string)
+	SetUAAOAuthClientSecret(string)
 	SetOrganizationFields(models.OrganizationFields)
 	SetSpaceFields(models.SpaceFields)
 	SetSSLDisabled(bool)
 	SetAsyncTimeout(uint)
+	SetRequestRetries(uint)
+	SetHTTPCacheTTL(uint)
 	SetTrace(string)
 	SetColorEnabled(string)
 	SetLocale(string)
@@ -254,6 +297,27 @@ func (c *ConfigRepository) RefreshToken() (refreshToken string) {
 	return
 }
 
+func (c *ConfigRepository) UAAGrantType() (grantType string) {
+	c.read(func() {
+		grantType = c.data.UAAGrantType
+	})
+	return
+}
+
+func (c *ConfigRepository) UAAOAuthClient() (clientID string) {
+	c.read(func() {
+		clientID = c.data.UAAOAuthClient
+	})
+	return
+}
+
+func (c *ConfigRepository) UAAOAuthClientSecret() (clientSecret string) {
+	c.read(func() {
+		clientSecret = c.data.UAAOAuthClientSecret
+	})
+	return
+}
+
 func (c *ConfigRepository) OrganizationFields() (org models.OrganizationFields) {
 	c.read(func() {
 		org = c.data.OrganizationFields
@@ -282,9 +346,14 @@ func (c *ConfigRepository) UserGUID() (guid string) {
 	return
 }
 
+// Username returns the name of the user of the access token, or the ID of
+// the client when logged in with client credentials.
 func (c *ConfigRepository) Username() (name string) {
 	c.read(func() {
 		name = NewTokenInfo(c.data.AccessToken).Username
+		if name == "" && c.data.UAAGrantType == "client_credentials" {
+			name = c.data.UAAOAuthClient
+		}
 	})
 	return
 }
@@ -374,6 +443,20 @@ func (c *ConfigRepository) AsyncTimeout() (timeout uint) {
 	return
 }
 
+func (c *ConfigRepository) RequestRetries() (retries uint) {
+	c.read(func() {
+		retries = c.data.RequestRetries
+	})
+	return
+}
+
+func (c *ConfigRepository) HTTPCacheTTL() (ttl uint) {
+	c.read(func() {
+		ttl = c.data.HTTPCacheTTL
+	})
+	return
+}
+
 func (c *ConfigRepository) Trace() (trace string) {
 	c.read(func() {
 		trace = c.data.Trace
@@ -408,6 +491,9 @@ func (c *ConfigRepository) ClearSession() {
 	c.write(func() {
 		c.data.AccessToken = ""
 		c.data.RefreshToken = ""
+		c.data.UAAGrantType = ""
+		c.data.UAAOAuthClient = ""
+		c.data.UAAOAuthClientSecret = ""
 		c.data.OrganizationFields = models.OrganizationFields{}
 		c.data.SpaceFields = models.SpaceFields{}
 	})
@@ -485,6 +571,24 @@ func (c *ConfigRepository) SetRefreshToken(token string) {
 	})
 }
 
+func (c *ConfigRepository) SetUAAGrantType(grantType string) {
+	c.write(func() {
+		c.data.UAAGrantType = grantType
+	})
+}
+
+func (c *ConfigRepository) SetUAAOAuthClient(clientID string) {
+	c.write(func() {
+		c.data.UAAOAuthClient = clientID
+	})
+}
+
+func (c *ConfigRepository) SetUAAOAuthClientSecret(clientSecret string) {
+	c.write(func() {
+		c.data.UAAOAuthClientSecret = clientSecret
+	})
+}
+
 func (c *ConfigRepository) SetOrganizationFields(org models.OrganizationFields) {
 	c.write(func() {
 		c.data.OrganizationFields = org
@@ -509,6 +613,18 @@ func (c *ConfigRepository) SetAsyncTimeout(timeout uint) {
 	})
 }
 
+func (c *ConfigRepository) SetRequestRetries(retries uint) {
+	c.write(func() {
+		c.data.RequestRetries = retries
+	})
+}
+
+func (c *ConfigRepository) SetHTTPCacheTTL(ttl uint) {
+	c.write(func() {
+		c.data.HTTPCacheTTL = ttl
+	})
+}
+
 func (c *ConfigRepository) SetTrace(value string) {
 	c.write(func() {
 		c.data.Trace = value
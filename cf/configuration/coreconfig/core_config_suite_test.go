@@ -1,13 +1,22 @@
 package coreconfig_test
 
 import (
+	"os"
+	"testing"
+
+	"github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/testhelpers/configuration"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-
-	"testing"
 )
 
 func TestCoreConfig(t *testing.T) {
+	config := configuration.NewRepositoryWithDefaults()
+	i18n.T = i18n.Init(config)
+
+	// keep the tokens of test configs out of the keyring of the machine
+	os.Setenv("CF_SECRET_STORE", "plaintext")
+
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "CoreConfig Suite")
 }
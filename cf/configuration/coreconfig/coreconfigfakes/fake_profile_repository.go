@@ -0,0 +1,211 @@
+// This file was generated by counterfeiter
+package coreconfigfakes
+
+import (
+	"sync"
+
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+)
+
+type FakeProfileRepository struct {
+	ListStub        func() ([]string, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct{}
+	listReturns     struct {
+		result1 []string
+		result2 error
+	}
+	GetStub        func(name string) (coreconfig.Reader, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		name string
+	}
+	getReturns struct {
+		result1 coreconfig.Reader
+		result2 error
+	}
+	SaveStub        func(name string, config coreconfig.Reader) error
+	saveMutex       sync.RWMutex
+	saveArgsForCall []struct {
+		name   string
+		config coreconfig.Reader
+	}
+	saveReturns struct {
+		result1 error
+	}
+	UseStub        func(name string, config coreconfig.ReadWriter) error
+	useMutex       sync.RWMutex
+	useArgsForCall []struct {
+		name   string
+		config coreconfig.ReadWriter
+	}
+	useReturns struct {
+		result1 error
+	}
+	DeleteStub        func(name string) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		name string
+	}
+	deleteReturns struct {
+		result1 error
+	}
+}
+
+func (fake *FakeProfileRepository) List() ([]string, error) {
+	fake.listMutex.Lock()
+	fake.listArgsForCall = append(fake.listArgsForCall, struct{}{})
+	fake.listMutex.Unlock()
+	if fake.ListStub != nil {
+		return fake.ListStub()
+	} else {
+		return fake.listReturns.result1, fake.listReturns.result2
+	}
+}
+
+func (fake *FakeProfileRepository) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeProfileRepository) ListReturns(result1 []string, result2 error) {
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProfileRepository) Get(name string) (coreconfig.Reader, error) {
+	fake.getMutex.Lock()
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		name string
+	}{name})
+	fake.getMutex.Unlock()
+	if fake.GetStub != nil {
+		return fake.GetStub(name)
+	} else {
+		return fake.getReturns.result1, fake.getReturns.result2
+	}
+}
+
+func (fake *FakeProfileRepository) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeProfileRepository) GetArgsForCall(i int) string {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return fake.getArgsForCall[i].name
+}
+
+func (fake *FakeProfileRepository) GetReturns(result1 coreconfig.Reader, result2 error) {
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 coreconfig.Reader
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProfileRepository) Save(name string, config coreconfig.Reader) error {
+	fake.saveMutex.Lock()
+	fake.saveArgsForCall = append(fake.saveArgsForCall, struct {
+		name   string
+		config coreconfig.Reader
+	}{name, config})
+	fake.saveMutex.Unlock()
+	if fake.SaveStub != nil {
+		return fake.SaveStub(name, config)
+	} else {
+		return fake.saveReturns.result1
+	}
+}
+
+func (fake *FakeProfileRepository) SaveCallCount() int {
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	return len(fake.saveArgsForCall)
+}
+
+func (fake *FakeProfileRepository) SaveArgsForCall(i int) (string, coreconfig.Reader) {
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	return fake.saveArgsForCall[i].name, fake.saveArgsForCall[i].config
+}
+
+func (fake *FakeProfileRepository) SaveReturns(result1 error) {
+	fake.SaveStub = nil
+	fake.saveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProfileRepository) Use(name string, config coreconfig.ReadWriter) error {
+	fake.useMutex.Lock()
+	fake.useArgsForCall = append(fake.useArgsForCall, struct {
+		name   string
+		config coreconfig.ReadWriter
+	}{name, config})
+	fake.useMutex.Unlock()
+	if fake.UseStub != nil {
+		return fake.UseStub(name, config)
+	} else {
+		return fake.useReturns.result1
+	}
+}
+
+func (fake *FakeProfileRepository) UseCallCount() int {
+	fake.useMutex.RLock()
+	defer fake.useMutex.RUnlock()
+	return len(fake.useArgsForCall)
+}
+
+func (fake *FakeProfileRepository) UseArgsForCall(i int) (string, coreconfig.ReadWriter) {
+	fake.useMutex.RLock()
+	defer fake.useMutex.RUnlock()
+	return fake.useArgsForCall[i].name, fake.useArgsForCall[i].config
+}
+
+func (fake *FakeProfileRepository) UseReturns(result1 error) {
+	fake.UseStub = nil
+	fake.useReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProfileRepository) Delete(name string) error {
+	fake.deleteMutex.Lock()
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		name string
+	}{name})
+	fake.deleteMutex.Unlock()
+	if fake.DeleteStub != nil {
+		return fake.DeleteStub(name)
+	} else {
+		return fake.deleteReturns.result1
+	}
+}
+
+func (fake *FakeProfileRepository) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeProfileRepository) DeleteArgsForCall(i int) string {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return fake.deleteArgsForCall[i].name
+}
+
+func (fake *FakeProfileRepository) DeleteReturns(result1 error) {
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+var _ coreconfig.ProfileRepository = new(FakeProfileRepository)
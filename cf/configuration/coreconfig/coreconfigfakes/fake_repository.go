@@ -70,6 +70,24 @@ type FakeRepository struct {
 	sSHOAuthClientReturns     struct {
 		result1 string
 	}
+	UAAGrantTypeStub        func() string
+	uAAGrantTypeMutex       sync.RWMutex
+	uAAGrantTypeArgsForCall []struct{}
+	uAAGrantTypeReturns     struct {
+		result1 string
+	}
+	UAAOAuthClientStub        func() string
+	uAAOAuthClientMutex       sync.RWMutex
+	uAAOAuthClientArgsForCall []struct{}
+	uAAOAuthClientReturns     struct {
+		result1 string
+	}
+	UAAOAuthClientSecretStub        func() string
+	uAAOAuthClientSecretMutex       sync.RWMutex
+	uAAOAuthClientSecretArgsForCall []struct{}
+	uAAOAuthClientSecretReturns     struct {
+		result1 string
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct{}
@@ -164,6 +182,18 @@ type FakeRepository struct {
 	asyncTimeoutReturns     struct {
 		result1 uint
 	}
+	RequestRetriesStub        func() uint
+	requestRetriesMutex       sync.RWMutex
+	requestRetriesArgsForCall []struct{}
+	requestRetriesReturns     struct {
+		result1 uint
+	}
+	HTTPCacheTTLStub        func() uint
+	hTTPCacheTTLMutex       sync.RWMutex
+	hTTPCacheTTLArgsForCall []struct{}
+	hTTPCacheTTLReturns     struct {
+		result1 uint
+	}
 	TraceStub        func() string
 	traceMutex       sync.RWMutex
 	traceArgsForCall []struct{}
@@ -246,6 +276,21 @@ type FakeRepository struct {
 	setSSHOAuthClientArgsForCall []struct {
 		arg1 string
 	}
+	SetUAAGrantTypeStub        func(string)
+	setUAAGrantTypeMutex       sync.RWMutex
+	setUAAGrantTypeArgsForCall []struct {
+		arg1 string
+	}
+	SetUAAOAuthClientStub        func(string)
+	setUAAOAuthClientMutex       sync.RWMutex
+	setUAAOAuthClientArgsForCall []struct {
+		arg1 string
+	}
+	SetUAAOAuthClientSecretStub        func(string)
+	setUAAOAuthClientSecretMutex       sync.RWMutex
+	setUAAOAuthClientSecretArgsForCall []struct {
+		arg1 string
+	}
 	SetRefreshTokenStub        func(string)
 	setRefreshTokenMutex       sync.RWMutex
 	setRefreshTokenArgsForCall []struct {
@@ -271,6 +316,16 @@ type FakeRepository struct {
 	setAsyncTimeoutArgsForCall []struct {
 		arg1 uint
 	}
+	SetRequestRetriesStub        func(uint)
+	setRequestRetriesMutex       sync.RWMutex
+	setRequestRetriesArgsForCall []struct {
+		arg1 uint
+	}
+	SetHTTPCacheTTLStub        func(uint)
+	setHTTPCacheTTLMutex       sync.RWMutex
+	setHTTPCacheTTLArgsForCall []struct {
+		arg1 uint
+	}
 	SetTraceStub        func(string)
 	setTraceMutex       sync.RWMutex
 	setTraceArgsForCall []struct {
@@ -541,6 +596,78 @@ func (fake *FakeRepository) SSHOAuthClientReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeRepository) UAAGrantType() string {
+	fake.uAAGrantTypeMutex.Lock()
+	fake.uAAGrantTypeArgsForCall = append(fake.uAAGrantTypeArgsForCall, struct{}{})
+	fake.uAAGrantTypeMutex.Unlock()
+	if fake.UAAGrantTypeStub != nil {
+		return fake.UAAGrantTypeStub()
+	} else {
+		return fake.uAAGrantTypeReturns.result1
+	}
+}
+
+func (fake *FakeRepository) UAAGrantTypeCallCount() int {
+	fake.uAAGrantTypeMutex.RLock()
+	defer fake.uAAGrantTypeMutex.RUnlock()
+	return len(fake.uAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeRepository) UAAGrantTypeReturns(result1 string) {
+	fake.UAAGrantTypeStub = nil
+	fake.uAAGrantTypeReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRepository) UAAOAuthClient() string {
+	fake.uAAOAuthClientMutex.Lock()
+	fake.uAAOAuthClientArgsForCall = append(fake.uAAOAuthClientArgsForCall, struct{}{})
+	fake.uAAOAuthClientMutex.Unlock()
+	if fake.UAAOAuthClientStub != nil {
+		return fake.UAAOAuthClientStub()
+	} else {
+		return fake.uAAOAuthClientReturns.result1
+	}
+}
+
+func (fake *FakeRepository) UAAOAuthClientCallCount() int {
+	fake.uAAOAuthClientMutex.RLock()
+	defer fake.uAAOAuthClientMutex.RUnlock()
+	return len(fake.uAAOAuthClientArgsForCall)
+}
+
+func (fake *FakeRepository) UAAOAuthClientReturns(result1 string) {
+	fake.UAAOAuthClientStub = nil
+	fake.uAAOAuthClientReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRepository) UAAOAuthClientSecret() string {
+	fake.uAAOAuthClientSecretMutex.Lock()
+	fake.uAAOAuthClientSecretArgsForCall = append(fake.uAAOAuthClientSecretArgsForCall, struct{}{})
+	fake.uAAOAuthClientSecretMutex.Unlock()
+	if fake.UAAOAuthClientSecretStub != nil {
+		return fake.UAAOAuthClientSecretStub()
+	} else {
+		return fake.uAAOAuthClientSecretReturns.result1
+	}
+}
+
+func (fake *FakeRepository) UAAOAuthClientSecretCallCount() int {
+	fake.uAAOAuthClientSecretMutex.RLock()
+	defer fake.uAAOAuthClientSecretMutex.RUnlock()
+	return len(fake.uAAOAuthClientSecretArgsForCall)
+}
+
+func (fake *FakeRepository) UAAOAuthClientSecretReturns(result1 string) {
+	fake.UAAOAuthClientSecretStub = nil
+	fake.uAAOAuthClientSecretReturns = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeRepository) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	fake.refreshTokenArgsForCall = append(fake.refreshTokenArgsForCall, struct{}{})
@@ -917,6 +1044,54 @@ func (fake *FakeRepository) AsyncTimeoutReturns(result1 uint) {
 	}{result1}
 }
 
+func (fake *FakeRepository) RequestRetries() uint {
+	fake.requestRetriesMutex.Lock()
+	fake.requestRetriesArgsForCall = append(fake.requestRetriesArgsForCall, struct{}{})
+	fake.requestRetriesMutex.Unlock()
+	if fake.RequestRetriesStub != nil {
+		return fake.RequestRetriesStub()
+	} else {
+		return fake.requestRetriesReturns.result1
+	}
+}
+
+func (fake *FakeRepository) RequestRetriesCallCount() int {
+	fake.requestRetriesMutex.RLock()
+	defer fake.requestRetriesMutex.RUnlock()
+	return len(fake.requestRetriesArgsForCall)
+}
+
+func (fake *FakeRepository) RequestRetriesReturns(result1 uint) {
+	fake.RequestRetriesStub = nil
+	fake.requestRetriesReturns = struct {
+		result1 uint
+	}{result1}
+}
+
+func (fake *FakeRepository) HTTPCacheTTL() uint {
+	fake.hTTPCacheTTLMutex.Lock()
+	fake.hTTPCacheTTLArgsForCall = append(fake.hTTPCacheTTLArgsForCall, struct{}{})
+	fake.hTTPCacheTTLMutex.Unlock()
+	if fake.HTTPCacheTTLStub != nil {
+		return fake.HTTPCacheTTLStub()
+	} else {
+		return fake.hTTPCacheTTLReturns.result1
+	}
+}
+
+func (fake *FakeRepository) HTTPCacheTTLCallCount() int {
+	fake.hTTPCacheTTLMutex.RLock()
+	defer fake.hTTPCacheTTLMutex.RUnlock()
+	return len(fake.hTTPCacheTTLArgsForCall)
+}
+
+func (fake *FakeRepository) HTTPCacheTTLReturns(result1 uint) {
+	fake.HTTPCacheTTLStub = nil
+	fake.hTTPCacheTTLReturns = struct {
+		result1 uint
+	}{result1}
+}
+
 func (fake *FakeRepository) Trace() string {
 	fake.traceMutex.Lock()
 	fake.traceArgsForCall = append(fake.traceArgsForCall, struct{}{})
@@ -1281,6 +1456,75 @@ func (fake *FakeRepository) SetSSHOAuthClientArgsForCall(i int) string {
 	return fake.setSSHOAuthClientArgsForCall[i].arg1
 }
 
+func (fake *FakeRepository) SetUAAGrantType(arg1 string) {
+	fake.setUAAGrantTypeMutex.Lock()
+	fake.setUAAGrantTypeArgsForCall = append(fake.setUAAGrantTypeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.setUAAGrantTypeMutex.Unlock()
+	if fake.SetUAAGrantTypeStub != nil {
+		fake.SetUAAGrantTypeStub(arg1)
+	}
+}
+
+func (fake *FakeRepository) SetUAAGrantTypeCallCount() int {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return len(fake.setUAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeRepository) SetUAAGrantTypeArgsForCall(i int) string {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return fake.setUAAGrantTypeArgsForCall[i].arg1
+}
+
+func (fake *FakeRepository) SetUAAOAuthClient(arg1 string) {
+	fake.setUAAOAuthClientMutex.Lock()
+	fake.setUAAOAuthClientArgsForCall = append(fake.setUAAOAuthClientArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.setUAAOAuthClientMutex.Unlock()
+	if fake.SetUAAOAuthClientStub != nil {
+		fake.SetUAAOAuthClientStub(arg1)
+	}
+}
+
+func (fake *FakeRepository) SetUAAOAuthClientCallCount() int {
+	fake.setUAAOAuthClientMutex.RLock()
+	defer fake.setUAAOAuthClientMutex.RUnlock()
+	return len(fake.setUAAOAuthClientArgsForCall)
+}
+
+func (fake *FakeRepository) SetUAAOAuthClientArgsForCall(i int) string {
+	fake.setUAAOAuthClientMutex.RLock()
+	defer fake.setUAAOAuthClientMutex.RUnlock()
+	return fake.setUAAOAuthClientArgsForCall[i].arg1
+}
+
+func (fake *FakeRepository) SetUAAOAuthClientSecret(arg1 string) {
+	fake.setUAAOAuthClientSecretMutex.Lock()
+	fake.setUAAOAuthClientSecretArgsForCall = append(fake.setUAAOAuthClientSecretArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.setUAAOAuthClientSecretMutex.Unlock()
+	if fake.SetUAAOAuthClientSecretStub != nil {
+		fake.SetUAAOAuthClientSecretStub(arg1)
+	}
+}
+
+func (fake *FakeRepository) SetUAAOAuthClientSecretCallCount() int {
+	fake.setUAAOAuthClientSecretMutex.RLock()
+	defer fake.setUAAOAuthClientSecretMutex.RUnlock()
+	return len(fake.setUAAOAuthClientSecretArgsForCall)
+}
+
+func (fake *FakeRepository) SetUAAOAuthClientSecretArgsForCall(i int) string {
+	fake.setUAAOAuthClientSecretMutex.RLock()
+	defer fake.setUAAOAuthClientSecretMutex.RUnlock()
+	return fake.setUAAOAuthClientSecretArgsForCall[i].arg1
+}
+
 func (fake *FakeRepository) SetRefreshToken(arg1 string) {
 	fake.setRefreshTokenMutex.Lock()
 	fake.setRefreshTokenArgsForCall = append(fake.setRefreshTokenArgsForCall, struct {
@@ -1396,6 +1640,52 @@ func (fake *FakeRepository) SetAsyncTimeoutArgsForCall(i int) uint {
 	return fake.setAsyncTimeoutArgsForCall[i].arg1
 }
 
+func (fake *FakeRepository) SetRequestRetries(arg1 uint) {
+	fake.setRequestRetriesMutex.Lock()
+	fake.setRequestRetriesArgsForCall = append(fake.setRequestRetriesArgsForCall, struct {
+		arg1 uint
+	}{arg1})
+	fake.setRequestRetriesMutex.Unlock()
+	if fake.SetRequestRetriesStub != nil {
+		fake.SetRequestRetriesStub(arg1)
+	}
+}
+
+func (fake *FakeRepository) SetRequestRetriesCallCount() int {
+	fake.setRequestRetriesMutex.RLock()
+	defer fake.setRequestRetriesMutex.RUnlock()
+	return len(fake.setRequestRetriesArgsForCall)
+}
+
+func (fake *FakeRepository) SetRequestRetriesArgsForCall(i int) uint {
+	fake.setRequestRetriesMutex.RLock()
+	defer fake.setRequestRetriesMutex.RUnlock()
+	return fake.setRequestRetriesArgsForCall[i].arg1
+}
+
+func (fake *FakeRepository) SetHTTPCacheTTL(arg1 uint) {
+	fake.setHTTPCacheTTLMutex.Lock()
+	fake.setHTTPCacheTTLArgsForCall = append(fake.setHTTPCacheTTLArgsForCall, struct {
+		arg1 uint
+	}{arg1})
+	fake.setHTTPCacheTTLMutex.Unlock()
+	if fake.SetHTTPCacheTTLStub != nil {
+		fake.SetHTTPCacheTTLStub(arg1)
+	}
+}
+
+func (fake *FakeRepository) SetHTTPCacheTTLCallCount() int {
+	fake.setHTTPCacheTTLMutex.RLock()
+	defer fake.setHTTPCacheTTLMutex.RUnlock()
+	return len(fake.setHTTPCacheTTLArgsForCall)
+}
+
+func (fake *FakeRepository) SetHTTPCacheTTLArgsForCall(i int) uint {
+	fake.setHTTPCacheTTLMutex.RLock()
+	defer fake.setHTTPCacheTTLMutex.RUnlock()
+	return fake.setHTTPCacheTTLArgsForCall[i].arg1
+}
+
 func (fake *FakeRepository) SetTrace(arg1 string) {
 	fake.setTraceMutex.Lock()
 	fake.setTraceArgsForCall = append(fake.setTraceArgsForCall, struct {
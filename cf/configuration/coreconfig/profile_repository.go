@@ -0,0 +1,176 @@
+package coreconfig
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cloudfoundry/cli/cf/configuration"
+	"github.com/cloudfoundry/cli/cf/configuration/confighelpers"
+	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+)
+
+const profileFileExtension = ".json"
+
+//go:generate counterfeiter . ProfileRepository
+
+// ProfileRepository keeps named copies of the config, so that users can
+// switch between API endpoints without logging in again.
+type ProfileRepository interface {
+	List() ([]string, error)
+	Get(name string) (Reader, error)
+	Save(name string, config Reader) error
+	Use(name string, config ReadWriter) error
+	Delete(name string) error
+}
+
+type DiskProfileRepository struct {
+	dir string
+}
+
+func NewDiskProfileRepository(dir string) DiskProfileRepository {
+	return DiskProfileRepository{dir: dir}
+}
+
+func (repo DiskProfileRepository) List() ([]string, error) {
+	files, err := ioutil.ReadDir(repo.dir)
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, file := range files {
+		name := strings.TrimSuffix(file.Name(), profileFileExtension)
+		if file.IsDir() || name == file.Name() || !confighelpers.IsValidProfileName(name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+func (repo DiskProfileRepository) Get(name string) (Reader, error) {
+	persistor, err := repo.existingPersistor(name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = persistor.Load(NewData())
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRepositoryFromPersistor(persistor, func(error) {}), nil
+}
+
+// Save copies the whole config to the profile, replacing the profile if it
+// already exists.
+func (repo DiskProfileRepository) Save(name string, config Reader) error {
+	if !confighelpers.IsValidProfileName(name) {
+		return errors.New(T("Invalid profile name {{.Name}}: use only letters, digits, '.', '-' and '_'", map[string]interface{}{"Name": name}))
+	}
+
+	err := os.MkdirAll(repo.dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	return newPersistor(repo.path(name)).Save(profileData(config))
+}
+
+// Use copies the endpoint, tokens, SSL setting and target of the profile to
+// the config. The other settings of the config are kept.
+func (repo DiskProfileRepository) Use(name string, config ReadWriter) error {
+	persistor, err := repo.existingPersistor(name)
+	if err != nil {
+		return err
+	}
+
+	data := NewData()
+	err = persistor.Load(data)
+	if err != nil {
+		return err
+	}
+
+	config.SetAPIEndpoint(data.Target)
+	config.SetAPIVersion(data.APIVersion)
+	config.SetAuthenticationEndpoint(data.AuthorizationEndpoint)
+	config.SetLoggregatorEndpoint(data.LoggregatorEndPoint)
+	config.SetDopplerEndpoint(data.DopplerEndPoint)
+	config.SetUaaEndpoint(data.UaaEndpoint)
+	config.SetRoutingAPIEndpoint(data.RoutingAPIEndpoint)
+	config.SetAccessToken(data.AccessToken)
+	config.SetSSHOAuthClient(data.SSHOAuthClient)
+	config.SetRefreshToken(data.RefreshToken)
+	config.SetUAAGrantType(data.UAAGrantType)
+	config.SetUAAOAuthClient(data.UAAOAuthClient)
+	config.SetUAAOAuthClientSecret(data.UAAOAuthClientSecret)
+	config.SetOrganizationFields(data.OrganizationFields)
+	config.SetSpaceFields(data.SpaceFields)
+	config.SetSSLDisabled(data.SSLDisabled)
+	config.SetMinCLIVersion(data.MinCLIVersion)
+	config.SetMinRecommendedCLIVersion(data.MinRecommendedCLIVersion)
+
+	return nil
+}
+
+func (repo DiskProfileRepository) Delete(name string) error {
+	persistor, err := repo.existingPersistor(name)
+	if err != nil {
+		return err
+	}
+
+	persistor.Delete()
+	return nil
+}
+
+func (repo DiskProfileRepository) existingPersistor(name string) (configuration.Persistor, error) {
+	persistor := newPersistor(repo.path(name))
+	if !confighelpers.IsValidProfileName(name) || !persistor.Exists() {
+		return nil, errors.NewModelNotFoundError("Profile", name)
+	}
+
+	return persistor, nil
+}
+
+func (repo DiskProfileRepository) path(name string) string {
+	return filepath.Join(repo.dir, name+profileFileExtension)
+}
+
+func profileData(config Reader) *Data {
+	return &Data{
+		Target:                   config.APIEndpoint(),
+		APIVersion:               config.APIVersion(),
+		AuthorizationEndpoint:    config.AuthenticationEndpoint(),
+		LoggregatorEndPoint:      config.LoggregatorEndpoint(),
+		DopplerEndPoint:          config.DopplerEndpoint(),
+		UaaEndpoint:              config.UaaEndpoint(),
+		RoutingAPIEndpoint:       config.RoutingAPIEndpoint(),
+		AccessToken:              config.AccessToken(),
+		SSHOAuthClient:           config.SSHOAuthClient(),
+		RefreshToken:             config.RefreshToken(),
+		UAAGrantType:             config.UAAGrantType(),
+		UAAOAuthClient:           config.UAAOAuthClient(),
+		UAAOAuthClientSecret:     config.UAAOAuthClientSecret(),
+		OrganizationFields:       config.OrganizationFields(),
+		SpaceFields:              config.SpaceFields(),
+		SSLDisabled:              config.IsSSLDisabled(),
+		AsyncTimeout:             config.AsyncTimeout(),
+		RequestRetries:           config.RequestRetries(),
+		HTTPCacheTTL:             config.HTTPCacheTTL(),
+		Trace:                    config.Trace(),
+		ColorEnabled:             config.ColorEnabled(),
+		Locale:                   config.Locale(),
+		PluginRepos:              config.PluginRepos(),
+		MinCLIVersion:            config.MinCLIVersion(),
+		MinRecommendedCLIVersion: config.MinRecommendedCLIVersion(),
+	}
+}
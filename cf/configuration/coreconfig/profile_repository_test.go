@@ -0,0 +1,136 @@
+package coreconfig_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DiskProfileRepository", func() {
+	var (
+		tmpDir string
+		repo   coreconfig.DiskProfileRepository
+		config coreconfig.Repository
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "profiles")
+		Expect(err).NotTo(HaveOccurred())
+
+		repo = coreconfig.NewDiskProfileRepository(filepath.Join(tmpDir, "profiles"))
+		config = coreconfig.NewRepositoryFromFilepath(filepath.Join(tmpDir, "config.json"), func(err error) { panic(err) })
+		config.SetAPIEndpoint("https://api.production.example.com")
+		config.SetAccessToken("bearer production-token")
+		config.SetRefreshToken("production-refresh-token")
+		config.SetSSLDisabled(true)
+		config.SetOrganizationFields(models.OrganizationFields{GUID: "org-guid", Name: "my-org"})
+		config.SetSpaceFields(models.SpaceFields{GUID: "space-guid", Name: "my-space"})
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	Describe("List", func() {
+		It("returns no profiles when the directory does not exist", func() {
+			names, err := repo.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names).To(BeEmpty())
+		})
+
+		It("returns the saved profiles in order", func() {
+			Expect(repo.Save("staging", config)).To(Succeed())
+			Expect(repo.Save("production", config)).To(Succeed())
+
+			names, err := repo.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names).To(Equal([]string{"production", "staging"}))
+		})
+
+		It("ignores files that are not profiles", func() {
+			Expect(repo.Save("production", config)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(tmpDir, "profiles", "notes.txt"), []byte("hi"), 0600)).To(Succeed())
+
+			names, err := repo.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names).To(Equal([]string{"production"}))
+		})
+	})
+
+	Describe("Save and Get", func() {
+		It("stores the endpoint, tokens, SSL setting and target", func() {
+			Expect(repo.Save("production", config)).To(Succeed())
+
+			profile, err := repo.Get("production")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(profile.APIEndpoint()).To(Equal("https://api.production.example.com"))
+			Expect(profile.AccessToken()).To(Equal("bearer production-token"))
+			Expect(profile.RefreshToken()).To(Equal("production-refresh-token"))
+			Expect(profile.IsSSLDisabled()).To(BeTrue())
+			Expect(profile.OrganizationFields().Name).To(Equal("my-org"))
+			Expect(profile.SpaceFields().Name).To(Equal("my-space"))
+		})
+
+		It("rejects invalid profile names", func() {
+			err := repo.Save("../escape", config)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Invalid profile name"))
+		})
+
+		It("returns a not found error for an unknown profile", func() {
+			_, err := repo.Get("unknown")
+			Expect(err).To(BeAssignableToTypeOf(&errors.ModelNotFoundError{}))
+		})
+	})
+
+	Describe("Use", func() {
+		It("copies the profile into the config and keeps the other settings", func() {
+			Expect(repo.Save("production", config)).To(Succeed())
+
+			config.SetAPIEndpoint("https://api.staging.example.com")
+			config.SetAccessToken("bearer staging-token")
+			config.SetSSLDisabled(false)
+			config.SetOrganizationFields(models.OrganizationFields{})
+			config.SetSpaceFields(models.SpaceFields{})
+			config.SetColorEnabled("false")
+
+			Expect(repo.Use("production", config)).To(Succeed())
+
+			Expect(config.APIEndpoint()).To(Equal("https://api.production.example.com"))
+			Expect(config.AccessToken()).To(Equal("bearer production-token"))
+			Expect(config.IsSSLDisabled()).To(BeTrue())
+			Expect(config.OrganizationFields().Name).To(Equal("my-org"))
+			Expect(config.SpaceFields().Name).To(Equal("my-space"))
+			Expect(config.ColorEnabled()).To(Equal("false"))
+		})
+
+		It("returns a not found error for an unknown profile", func() {
+			err := repo.Use("unknown", config)
+			Expect(err).To(BeAssignableToTypeOf(&errors.ModelNotFoundError{}))
+		})
+	})
+
+	Describe("Delete", func() {
+		It("removes the profile", func() {
+			Expect(repo.Save("production", config)).To(Succeed())
+			Expect(repo.Delete("production")).To(Succeed())
+
+			names, err := repo.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names).To(BeEmpty())
+		})
+
+		It("returns a not found error for an unknown profile", func() {
+			err := repo.Delete("unknown")
+			Expect(err).To(BeAssignableToTypeOf(&errors.ModelNotFoundError{}))
+		})
+	})
+})
@@ -14,6 +14,7 @@ type PluginMetadata struct {
 	Location string
 	Version  plugin.VersionType
 	Commands []plugin.Command
+	Pinned   bool `json:",omitempty"`
 }
 
 func NewData() *PluginData {
@@ -0,0 +1,186 @@
+package secretstore
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	keyLength     = 32
+	saltLength    = 16
+	keyIterations = 100000
+)
+
+// FileStore encrypts all namespaces into a single file with AES-GCM, using
+// a key derived from the passphrase with PBKDF2.
+type FileStore struct {
+	path       string
+	passphrase string
+
+	salt []byte
+	key  []byte
+}
+
+type encryptedFile struct {
+	Salt  []byte
+	Nonce []byte
+	Data  []byte
+}
+
+func NewFileStore(path, passphrase string) *FileStore {
+	return &FileStore{
+		path:       path,
+		passphrase: passphrase,
+	}
+}
+
+func (s *FileStore) Get(namespace string) (map[string]string, error) {
+	all, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, ok := all[namespace]
+	if !ok {
+		return map[string]string{}, nil
+	}
+	return secrets, nil
+}
+
+func (s *FileStore) Set(namespace string, secrets map[string]string) error {
+	all, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	all[namespace] = secrets
+	return s.write(all)
+}
+
+func (s *FileStore) Delete(namespace string) error {
+	all, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := all[namespace]; !ok {
+		return nil
+	}
+
+	delete(all, namespace)
+	return s.write(all)
+}
+
+func (s *FileStore) read() (map[string]map[string]string, error) {
+	all := map[string]map[string]string{}
+
+	contents, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return all, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	file := encryptedFile{}
+	err = json.Unmarshal(contents, &file)
+	if err != nil {
+		return nil, s.invalidFileError()
+	}
+
+	gcm, err := s.cipher(file.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(file.Nonce) != gcm.NonceSize() {
+		return nil, s.invalidFileError()
+	}
+
+	plaintext, err := gcm.Open(nil, file.Nonce, file.Data, nil)
+	if err != nil {
+		return nil, errors.New(T("Could not decrypt secrets in {{.Path}}. Check that CF_SECRET_PASSPHRASE is correct.",
+			map[string]interface{}{"Path": s.path}))
+	}
+
+	err = json.Unmarshal(plaintext, &all)
+	if err != nil {
+		return nil, s.invalidFileError()
+	}
+
+	return all, nil
+}
+
+func (s *FileStore) write(all map[string]map[string]string) error {
+	salt := s.salt
+	if salt == nil {
+		salt = make([]byte, saltLength)
+		_, err := rand.Read(salt)
+		if err != nil {
+			return err
+		}
+	}
+
+	gcm, err := s.cipher(salt)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := json.Marshal(all)
+	if err != nil {
+		return err
+	}
+
+	contents, err := json.MarshalIndent(encryptedFile{
+		Salt:  salt,
+		Nonce: nonce,
+		Data:  gcm.Seal(nil, nonce, plaintext, nil),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(s.path), 0700)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(s.path, contents, 0600)
+}
+
+// cipher derives the key for salt, reusing the last key because deriving
+// it is deliberately slow.
+func (s *FileStore) cipher(salt []byte) (cipher.AEAD, error) {
+	if s.key == nil || !bytes.Equal(salt, s.salt) {
+		s.salt = salt
+		s.key = pbkdf2.Key([]byte(s.passphrase), salt, keyIterations, keyLength, sha256.New)
+	}
+
+	block, err := aes.NewCipher(s.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func (s *FileStore) invalidFileError() error {
+	return errors.New(T("Invalid secret store {{.Path}}", map[string]interface{}{"Path": s.path}))
+}
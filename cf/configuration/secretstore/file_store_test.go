@@ -0,0 +1,90 @@
+package secretstore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/cloudfoundry/cli/cf/configuration/secretstore"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileStore", func() {
+	var (
+		tmpDir string
+		path   string
+		store  *FileStore
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "secret_store")
+		Expect(err).ToNot(HaveOccurred())
+
+		path = filepath.Join(tmpDir, "secrets.json")
+		store = NewFileStore(path, "correct horse")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tmpDir)
+	})
+
+	It("returns no secrets for an unknown namespace", func() {
+		secrets, err := store.Get("some-namespace")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secrets).To(BeEmpty())
+	})
+
+	It("returns the secrets that were set", func() {
+		Expect(store.Set("some-namespace", map[string]string{"AccessToken": "bearer some-token"})).To(Succeed())
+		Expect(store.Set("other-namespace", map[string]string{"AccessToken": "bearer other-token"})).To(Succeed())
+
+		secrets, err := NewFileStore(path, "correct horse").Get("some-namespace")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secrets).To(Equal(map[string]string{"AccessToken": "bearer some-token"}))
+	})
+
+	It("encrypts the file and makes it readable only by the user", func() {
+		Expect(store.Set("some-namespace", map[string]string{"AccessToken": "bearer some-token"})).To(Succeed())
+
+		contents, err := ioutil.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(contents)).ToNot(ContainSubstring("some-token"))
+
+		info, err := os.Stat(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+
+	It("deletes the secrets of a namespace", func() {
+		Expect(store.Set("some-namespace", map[string]string{"AccessToken": "bearer some-token"})).To(Succeed())
+		Expect(store.Set("other-namespace", map[string]string{"AccessToken": "bearer other-token"})).To(Succeed())
+
+		Expect(store.Delete("some-namespace")).To(Succeed())
+
+		secrets, err := store.Get("some-namespace")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secrets).To(BeEmpty())
+
+		secrets, err = store.Get("other-namespace")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secrets).To(Equal(map[string]string{"AccessToken": "bearer other-token"}))
+	})
+
+	It("returns an error when the passphrase is wrong", func() {
+		Expect(store.Set("some-namespace", map[string]string{"AccessToken": "bearer some-token"})).To(Succeed())
+
+		_, err := NewFileStore(path, "wrong horse").Get("some-namespace")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Could not decrypt secrets"))
+	})
+
+	It("returns an error when the file is invalid", func() {
+		Expect(ioutil.WriteFile(path, []byte("not json"), 0600)).To(Succeed())
+
+		_, err := store.Get("some-namespace")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Invalid secret store"))
+	})
+})
@@ -0,0 +1,92 @@
+// +build linux
+
+package secretstore
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+const secretServiceName = "cf-cli"
+
+// SecretServiceStore keeps each namespace as one item in the Secret Service
+// keyring (e.g. GNOME Keyring or KWallet), talking to it over D-Bus through
+// libsecret's secret-tool.
+type SecretServiceStore struct {
+	command string
+}
+
+func newSecretServiceStore() (SecretStore, bool) {
+	if os.Getenv("DBUS_SESSION_BUS_ADDRESS") == "" {
+		return nil, false
+	}
+
+	command, err := exec.LookPath("secret-tool")
+	if err != nil {
+		return nil, false
+	}
+
+	return SecretServiceStore{command: command}, true
+}
+
+func (s SecretServiceStore) Get(namespace string) (map[string]string, error) {
+	secrets := map[string]string{}
+
+	stdout, stderr, err := s.run(nil, "lookup", "service", secretServiceName, "account", namespace)
+	if err != nil {
+		// secret-tool fails silently when there is no matching item
+		if stderr == "" {
+			return secrets, nil
+		}
+		return nil, s.keyringError(stderr)
+	}
+
+	err = json.Unmarshal([]byte(stdout), &secrets)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid secrets in the Secret Service keyring for %s", namespace)
+	}
+
+	return secrets, nil
+}
+
+func (s SecretServiceStore) Set(namespace string, secrets map[string]string) error {
+	value, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+
+	label := "--label=Cloud Foundry CLI (" + namespace + ")"
+	_, stderr, err := s.run(value, "store", label, "service", secretServiceName, "account", namespace)
+	if err != nil {
+		return s.keyringError(stderr)
+	}
+	return nil
+}
+
+func (s SecretServiceStore) Delete(namespace string) error {
+	_, stderr, err := s.run(nil, "clear", "service", secretServiceName, "account", namespace)
+	if err != nil && stderr != "" {
+		return s.keyringError(stderr)
+	}
+	return nil
+}
+
+func (s SecretServiceStore) run(stdin []byte, args ...string) (string, string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command(s.command, args...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	return stdout.String(), strings.TrimSpace(stderr.String()), err
+}
+
+func (s SecretServiceStore) keyringError(message string) error {
+	return fmt.Errorf("Error accessing the Secret Service keyring: %s", message)
+}
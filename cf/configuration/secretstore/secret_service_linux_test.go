@@ -0,0 +1,96 @@
+// +build linux
+
+package secretstore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/cloudfoundry/cli/cf/configuration/secretstore"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeSecretTool mimics secret-tool by keeping each item in a file named
+// after its account attribute.
+const fakeSecretTool = `#!/bin/sh
+item="$FAKE_KEYRING/$(echo "$@" | sed 's/.* account //' | tr '/' '_')"
+case "$1" in
+	store) cat > "$item" ;;
+	lookup) [ -f "$item" ] && cat "$item" || exit 1 ;;
+	clear) rm -f "$item" ;;
+esac
+`
+
+var _ = Describe("SecretServiceStore", func() {
+	var (
+		tmpDir                         string
+		oldPath, oldDBus, oldStoreName string
+		store                          SecretStore
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "secret_service")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ioutil.WriteFile(filepath.Join(tmpDir, "secret-tool"), []byte(fakeSecretTool), 0700)).To(Succeed())
+
+		oldPath, oldDBus, oldStoreName = os.Getenv("PATH"), os.Getenv("DBUS_SESSION_BUS_ADDRESS"), os.Getenv("CF_SECRET_STORE")
+		os.Setenv("PATH", tmpDir+string(os.PathListSeparator)+oldPath)
+		os.Setenv("DBUS_SESSION_BUS_ADDRESS", "unix:path=/some/bus")
+		os.Setenv("CF_SECRET_STORE", SecretServiceStoreName)
+		os.Setenv("FAKE_KEYRING", tmpDir)
+
+		store = NewFromEnv("some-dir")
+	})
+
+	AfterEach(func() {
+		os.Setenv("PATH", oldPath)
+		os.Setenv("DBUS_SESSION_BUS_ADDRESS", oldDBus)
+		os.Setenv("CF_SECRET_STORE", oldStoreName)
+		os.Unsetenv("FAKE_KEYRING")
+		os.RemoveAll(tmpDir)
+	})
+
+	It("is selected when secret-tool and a session bus are available", func() {
+		Expect(store).To(BeAssignableToTypeOf(SecretServiceStore{}))
+	})
+
+	It("is the default when no passphrase is set", func() {
+		oldPassphrase := os.Getenv("CF_SECRET_PASSPHRASE")
+		defer os.Setenv("CF_SECRET_PASSPHRASE", oldPassphrase)
+		os.Setenv("CF_SECRET_PASSPHRASE", "")
+		os.Setenv("CF_SECRET_STORE", "")
+
+		Expect(NewFromEnv("some-dir")).To(BeAssignableToTypeOf(SecretServiceStore{}))
+	})
+
+	It("is not used when plaintext is asked for", func() {
+		os.Setenv("CF_SECRET_STORE", PlaintextStoreName)
+		Expect(NewFromEnv("some-dir")).To(BeNil())
+	})
+
+	It("is not available without a session bus", func() {
+		os.Setenv("DBUS_SESSION_BUS_ADDRESS", "")
+		Expect(NewFromEnv("some-dir")).To(BeNil())
+	})
+
+	It("stores, looks up and clears the secrets of a namespace", func() {
+		secrets, err := store.Get("/home/user/.cf/config.json")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secrets).To(BeEmpty())
+
+		Expect(store.Set("/home/user/.cf/config.json", map[string]string{"AccessToken": "bearer some-token"})).To(Succeed())
+
+		secrets, err = store.Get("/home/user/.cf/config.json")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secrets).To(Equal(map[string]string{"AccessToken": "bearer some-token"}))
+
+		Expect(store.Delete("/home/user/.cf/config.json")).To(Succeed())
+
+		secrets, err = store.Get("/home/user/.cf/config.json")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secrets).To(BeEmpty())
+	})
+})
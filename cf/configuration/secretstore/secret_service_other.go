@@ -0,0 +1,7 @@
+// +build !linux
+
+package secretstore
+
+func newSecretServiceStore() (SecretStore, bool) {
+	return nil, false
+}
@@ -0,0 +1,60 @@
+package secretstore
+
+import (
+	"os"
+	"path/filepath"
+)
+
+const (
+	FileStoreName          = "file"
+	SecretServiceStoreName = "secret-service"
+	PlaintextStoreName     = "plaintext"
+)
+
+//go:generate counterfeiter . SecretStore
+
+// SecretStore keeps the secrets of a config file, such as its access and
+// refresh tokens, outside of the file. Secrets are grouped by namespace,
+// which is usually the path of the config file.
+type SecretStore interface {
+	Get(namespace string) (map[string]string, error)
+	Set(namespace string, secrets map[string]string) error
+	Delete(namespace string) error
+}
+
+// NewFromEnv returns the store named by CF_SECRET_STORE. Secrets are kept
+// encrypted by default: in the passphrase encrypted file store in configDir
+// when CF_SECRET_PASSPHRASE is set, and in the Secret Service keyring
+// otherwise. It returns nil when secrets should stay in the config file,
+// because CF_SECRET_STORE is plaintext or because no store is available.
+func NewFromEnv(configDir string) SecretStore {
+	switch os.Getenv("CF_SECRET_STORE") {
+	case PlaintextStoreName:
+		return nil
+	case SecretServiceStoreName:
+		return secretServiceStore()
+	case FileStoreName:
+		return fileStore(configDir)
+	default:
+		if store := fileStore(configDir); store != nil {
+			return store
+		}
+		return secretServiceStore()
+	}
+}
+
+func fileStore(configDir string) SecretStore {
+	passphrase := os.Getenv("CF_SECRET_PASSPHRASE")
+	if passphrase == "" {
+		return nil
+	}
+	return NewFileStore(filepath.Join(configDir, "secrets.json"), passphrase)
+}
+
+func secretServiceStore() SecretStore {
+	store, ok := newSecretServiceStore()
+	if !ok {
+		return nil
+	}
+	return store
+}
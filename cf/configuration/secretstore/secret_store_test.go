@@ -0,0 +1,56 @@
+package secretstore_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/cloudfoundry/cli/cf/configuration/secretstore"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewFromEnv", func() {
+	var oldStore, oldPassphrase string
+
+	BeforeEach(func() {
+		oldStore = os.Getenv("CF_SECRET_STORE")
+		oldPassphrase = os.Getenv("CF_SECRET_PASSPHRASE")
+	})
+
+	AfterEach(func() {
+		os.Setenv("CF_SECRET_STORE", oldStore)
+		os.Setenv("CF_SECRET_PASSPHRASE", oldPassphrase)
+	})
+
+	It("uses the file store when a passphrase is set", func() {
+		os.Setenv("CF_SECRET_STORE", "")
+		os.Setenv("CF_SECRET_PASSPHRASE", "correct horse")
+
+		store := NewFromEnv("some-dir")
+		Expect(store).To(Equal(NewFileStore(filepath.Join("some-dir", "secrets.json"), "correct horse")))
+	})
+
+	It("falls back to plaintext when no passphrase is set", func() {
+		os.Setenv("CF_SECRET_STORE", FileStoreName)
+		os.Setenv("CF_SECRET_PASSPHRASE", "")
+
+		Expect(NewFromEnv("some-dir")).To(BeNil())
+	})
+
+	It("falls back to plaintext when neither a passphrase nor a keyring is available", func() {
+		oldDBus := os.Getenv("DBUS_SESSION_BUS_ADDRESS")
+		defer os.Setenv("DBUS_SESSION_BUS_ADDRESS", oldDBus)
+		os.Setenv("DBUS_SESSION_BUS_ADDRESS", "")
+		os.Setenv("CF_SECRET_STORE", "")
+		os.Setenv("CF_SECRET_PASSPHRASE", "")
+
+		Expect(NewFromEnv("some-dir")).To(BeNil())
+	})
+
+	It("uses plaintext when asked to", func() {
+		os.Setenv("CF_SECRET_STORE", PlaintextStoreName)
+		os.Setenv("CF_SECRET_PASSPHRASE", "correct horse")
+
+		Expect(NewFromEnv("some-dir")).To(BeNil())
+	})
+})
@@ -0,0 +1,137 @@
+// This file was generated by counterfeiter
+package secretstorefakes
+
+import (
+	"sync"
+
+	"github.com/cloudfoundry/cli/cf/configuration/secretstore"
+)
+
+type FakeSecretStore struct {
+	GetStub        func(namespace string) (map[string]string, error)
+	getMutex       sync.RWMutex
+	getArgsForCall []struct {
+		namespace string
+	}
+	getReturns struct {
+		result1 map[string]string
+		result2 error
+	}
+	SetStub        func(namespace string, secrets map[string]string) error
+	setMutex       sync.RWMutex
+	setArgsForCall []struct {
+		namespace string
+		secrets   map[string]string
+	}
+	setReturns struct {
+		result1 error
+	}
+	DeleteStub        func(namespace string) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		namespace string
+	}
+	deleteReturns struct {
+		result1 error
+	}
+}
+
+func (fake *FakeSecretStore) Get(namespace string) (map[string]string, error) {
+	fake.getMutex.Lock()
+	fake.getArgsForCall = append(fake.getArgsForCall, struct {
+		namespace string
+	}{namespace})
+	fake.getMutex.Unlock()
+	if fake.GetStub != nil {
+		return fake.GetStub(namespace)
+	} else {
+		return fake.getReturns.result1, fake.getReturns.result2
+	}
+}
+
+func (fake *FakeSecretStore) GetCallCount() int {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return len(fake.getArgsForCall)
+}
+
+func (fake *FakeSecretStore) GetArgsForCall(i int) string {
+	fake.getMutex.RLock()
+	defer fake.getMutex.RUnlock()
+	return fake.getArgsForCall[i].namespace
+}
+
+func (fake *FakeSecretStore) GetReturns(result1 map[string]string, result2 error) {
+	fake.GetStub = nil
+	fake.getReturns = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecretStore) Set(namespace string, secrets map[string]string) error {
+	fake.setMutex.Lock()
+	fake.setArgsForCall = append(fake.setArgsForCall, struct {
+		namespace string
+		secrets   map[string]string
+	}{namespace, secrets})
+	fake.setMutex.Unlock()
+	if fake.SetStub != nil {
+		return fake.SetStub(namespace, secrets)
+	} else {
+		return fake.setReturns.result1
+	}
+}
+
+func (fake *FakeSecretStore) SetCallCount() int {
+	fake.setMutex.RLock()
+	defer fake.setMutex.RUnlock()
+	return len(fake.setArgsForCall)
+}
+
+func (fake *FakeSecretStore) SetArgsForCall(i int) (string, map[string]string) {
+	fake.setMutex.RLock()
+	defer fake.setMutex.RUnlock()
+	return fake.setArgsForCall[i].namespace, fake.setArgsForCall[i].secrets
+}
+
+func (fake *FakeSecretStore) SetReturns(result1 error) {
+	fake.SetStub = nil
+	fake.setReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecretStore) Delete(namespace string) error {
+	fake.deleteMutex.Lock()
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		namespace string
+	}{namespace})
+	fake.deleteMutex.Unlock()
+	if fake.DeleteStub != nil {
+		return fake.DeleteStub(namespace)
+	} else {
+		return fake.deleteReturns.result1
+	}
+}
+
+func (fake *FakeSecretStore) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeSecretStore) DeleteArgsForCall(i int) string {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return fake.deleteArgsForCall[i].namespace
+}
+
+func (fake *FakeSecretStore) DeleteReturns(result1 error) {
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+var _ secretstore.SecretStore = new(FakeSecretStore)
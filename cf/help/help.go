@@ -108,6 +108,9 @@ func newAppPresenter() appPresenter {
 				}, {
 					presentCommand("api"),
 					presentCommand("auth"),
+				}, {
+					presentCommand("profiles"),
+					presentCommand("profile"),
 				},
 			},
 		}, {
@@ -127,6 +130,7 @@ func newAppPresenter() appPresenter {
 					presentCommand("restart"),
 					presentCommand("restage"),
 					presentCommand("restart-app-instance"),
+					presentCommand("wait-app"),
 				}, {
 					presentCommand("events"),
 					presentCommand("files"),
@@ -142,6 +146,7 @@ func newAppPresenter() appPresenter {
 					presentCommand("copy-source"),
 				}, {
 					presentCommand("create-app-manifest"),
+					presentCommand("validate-manifest"),
 				}, {
 					presentCommand("get-health-check"),
 					presentCommand("set-health-check"),
@@ -151,6 +156,19 @@ func newAppPresenter() appPresenter {
 					presentCommand("ssh"),
 				},
 			},
+		}, {
+			Name: T("V3 APPS (EXPERIMENTAL)"),
+			CommandSubGroups: [][]cmdPresenter{
+				{
+					presentCommand("v3-push"),
+					presentCommand("v3-scale"),
+					presentCommand("v3-droplets"),
+				}, {
+					presentCommand("run-task"),
+					presentCommand("tasks"),
+					presentCommand("terminate-task"),
+				},
+			},
 		}, {
 			Name: T("SERVICES"),
 			CommandSubGroups: [][]cmdPresenter{
@@ -384,6 +402,10 @@ func newAppPresenter() appPresenter {
 					presentCommand("plugins"),
 					presentCommand("install-plugin"),
 					presentCommand("uninstall-plugin"),
+					presentCommand("update-plugin"),
+					presentCommand("pin-plugin"),
+					presentCommand("unpin-plugin"),
+					presentCommand("plugins-sync"),
 				},
 			},
 		}, {
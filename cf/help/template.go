@@ -19,14 +19,22 @@ func GetHelpTemplate() string {
    CF_COLOR=false                     ` + T("Do not colorize output") + `
    CF_HOME=path/to/dir/               ` + T("Override path to default config directory") + `
    CF_PLUGIN_HOME=path/to/dir/        ` + T("Override path to default plugin config directory") + `
+   CF_PROFILE=name                    ` + T("Use a saved target profile instead of the default config") + `
+   CF_SECRET_PASSPHRASE=passphrase    ` + T("Encrypt access and refresh tokens in a file with this passphrase") + `
+   CF_SECRET_STORE=plaintext          ` + T("Keep access and refresh tokens unencrypted in the config file instead of the Secret Service keyring (Linux)") + `
    CF_STAGING_TIMEOUT=15              ` + T("Max wait time for buildpack staging, in minutes") + `
    CF_STARTUP_TIMEOUT=5               ` + T("Max wait time for app instance startup, in minutes") + `
    CF_TRACE=true                      ` + T("Print API request diagnostics to stdout") + `
    CF_TRACE=path/to/trace.log         ` + T("Append API request diagnostics to a log file") + `
+   CF_TRACE_MAX_SIZE=10M              ` + T("Rotate the trace log file at this size, 0 to never rotate") + `
+   CF_TRACE_MAX_FILES=3               ` + T("Number of rotated trace log files to keep") + `
+   CF_TRACE_REDACT=field1,field2      ` + T("Also hide these JSON fields in API request diagnostics") + `
    https_proxy=proxy.example.com:8080 ` + T("Enable HTTP proxying for API requests") + `
 
 {{.Title "` + T("GLOBAL OPTIONS:") + `"}}
    --help, -h                         ` + T("Show help") + `
+   --output (json | yaml | table)     ` + T("Print command results in a machine readable format") + `
+   --profile NAME                     ` + T("Use a saved target profile for this command") + `
    -v                                 ` + T("Print API request diagnostics to stdout") + `
 `
 }
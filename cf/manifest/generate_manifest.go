@@ -24,7 +24,7 @@ type AppManifest interface {
 	EnvironmentVars(string, string, string)
 	HealthCheckTimeout(string, int)
 	Instances(string, int)
-	Domain(string, string, string)
+	Route(string, string, string, string, int)
 	GetContents() []models.Application
 	Stack(string, string)
 	AppPorts(string, []int)
@@ -32,23 +32,23 @@ type AppManifest interface {
 }
 
 type ManifestApplication struct {
-	Name       string                 `yaml:"name"`
-	Instances  int                    `yaml:"instances,omitempty"`
-	Memory     string                 `yaml:"memory,omitempty"`
-	DiskQuota  string                 `yaml:"disk_quota,omitempty"`
-	AppPorts   []int                  `yaml:"app-ports,omitempty"`
-	Host       string                 `yaml:"host,omitempty"`
-	Hosts      []string               `yaml:"hosts,omitempty"`
-	Domain     string                 `yaml:"domain,omitempty"`
-	Domains    []string               `yaml:"domains,omitempty"`
-	NoHostname bool                   `yaml:"no-hostname,omitempty"`
-	NoRoute    bool                   `yaml:"no-route,omitempty"`
-	Buildpack  string                 `yaml:"buildpack,omitempty"`
-	Command    string                 `yaml:"command,omitempty"`
-	Env        map[string]interface{} `yaml:"env,omitempty"`
-	Services   []string               `yaml:"services,omitempty"`
-	Stack      string                 `yaml:"stack,omitempty"`
-	Timeout    int                    `yaml:"timeout,omitempty"`
+	Name      string                 `yaml:"name"`
+	Instances int                    `yaml:"instances,omitempty"`
+	Memory    string                 `yaml:"memory,omitempty"`
+	DiskQuota string                 `yaml:"disk_quota,omitempty"`
+	AppPorts  []int                  `yaml:"app-ports,omitempty"`
+	Routes    []ManifestRoute        `yaml:"routes,omitempty"`
+	NoRoute   bool                   `yaml:"no-route,omitempty"`
+	Buildpack string                 `yaml:"buildpack,omitempty"`
+	Command   string                 `yaml:"command,omitempty"`
+	Env       map[string]interface{} `yaml:"env,omitempty"`
+	Services  []string               `yaml:"services,omitempty"`
+	Stack     string                 `yaml:"stack,omitempty"`
+	Timeout   int                    `yaml:"timeout,omitempty"`
+}
+
+type ManifestRoute struct {
+	Route string `yaml:"route"`
 }
 
 type ManifestApplications struct {
@@ -108,13 +108,15 @@ func (m *appManifest) Service(appName string, name string) {
 	})
 }
 
-func (m *appManifest) Domain(appName string, host string, domain string) {
+func (m *appManifest) Route(appName string, host string, domain string, path string, port int) {
 	i := m.findOrCreateApplication(appName)
 	m.contents[i].Routes = append(m.contents[i].Routes, models.RouteSummary{
 		Host: host,
 		Domain: models.DomainFields{
 			Name: domain,
 		},
+		Path: path,
+		Port: port,
 	})
 }
 
@@ -168,38 +170,11 @@ func generateAppMap(app models.Application) (ManifestApplication, error) {
 		AppPorts:  app.AppPorts,
 	}
 
-	switch len(app.Routes) {
-	case 0:
+	if len(app.Routes) == 0 {
 		m.NoRoute = true
-	case 1:
-		const noHostname = ""
-
-		m.Domain = app.Routes[0].Domain.Name
-		host := app.Routes[0].Host
-
-		if host == noHostname {
-			m.NoHostname = true
-		} else {
-			m.Host = host
-		}
-	default:
-		hosts, domains := separateHostsAndDomains(app.Routes)
-
-		switch len(hosts) {
-		case 0:
-			m.NoHostname = true
-		case 1:
-			m.Host = hosts[0]
-		default:
-			m.Hosts = hosts
-		}
-
-		switch len(domains) {
-		case 1:
-			m.Domain = domains[0]
-		default:
-			m.Domains = domains
-		}
+	}
+	for _, route := range app.Routes {
+		m.Routes = append(m.Routes, ManifestRoute{Route: route.URL()})
 	}
 
 	return m, nil
@@ -249,31 +224,3 @@ func (m *appManifest) addApplication(name string) {
 		},
 	})
 }
-
-func separateHostsAndDomains(routes []models.RouteSummary) ([]string, []string) {
-	var (
-		hostSlice    []string
-		domainSlice  []string
-		hostPSlice   *[]string
-		domainPSlice *[]string
-		hosts        []string
-		domains      []string
-	)
-
-	for i := 0; i < len(routes); i++ {
-		hostSlice = append(hostSlice, routes[i].Host)
-		domainSlice = append(domainSlice, routes[i].Domain.Name)
-	}
-
-	hostPSlice = removeDuplicatedValue(hostSlice)
-	domainPSlice = removeDuplicatedValue(domainSlice)
-
-	if hostPSlice != nil {
-		hosts = *hostPSlice
-	}
-	if domainPSlice != nil {
-		domains = *domainPSlice
-	}
-
-	return hosts, domains
-}
@@ -137,116 +137,37 @@ var _ = Describe("generate_manifest", func() {
 				})
 			})
 
-			It("includes no-route when the application has no domains", func() {
+			It("includes no-route when the application has no routes", func() {
 				m.Save(f)
 				contents := getYaml(f)
 				application := contents.Applications[0]
 				Expect(application.NoRoute).To(BeTrue())
+				Expect(application.Routes).To(BeEmpty())
 			})
 
-			Context("when an application has one domain with a hostname", func() {
+			Context("when an application has routes", func() {
 				BeforeEach(func() {
-					m.Domain("app1", "host-name", "domain-name")
+					m.Route("app1", "host-name", "domain-name", "", 0)
+					m.Route("app1", "", "domain-name", "", 0)
+					m.Route("app1", "host-name", "domain-name", "/path", 0)
+					m.Route("app1", "", "tcp-domain-name", "", 1234)
 				})
 
-				It("includes the domain", func() {
+				It("includes each route as a full url", func() {
 					err := m.Save(f)
 					Expect(err).NotTo(HaveOccurred())
-					contents := getYaml(f)
-					application := contents.Applications[0]
-					Expect(application.Domain).To(Equal("domain-name"))
-				})
-
-				It("does not include no-hostname", func() {
-					err := m.Save(f)
-					Expect(err).NotTo(HaveOccurred())
-					contents := getYaml(f)
-					application := contents.Applications[0]
-					Expect(application.NoHostname).To(BeFalse())
-				})
-			})
 
-			Context("when an application has one domain without a hostname", func() {
-				BeforeEach(func() {
-					m.Domain("app1", "", "domain-name")
-				})
-
-				It("includes the domain", func() {
-					err := m.Save(f)
-					Expect(err).NotTo(HaveOccurred())
-					contents := getYaml(f)
-					application := contents.Applications[0]
-					Expect(application.Domain).To(Equal("domain-name"))
-				})
-
-				It("includes no-hostname", func() {
-					m.Save(f)
-					contents := getYaml(f)
-					application := contents.Applications[0]
-					Expect(application.NoHostname).To(BeTrue())
+					application := getYaml(f).Applications[0]
+					Expect(application.Routes).To(Equal([]map[string]string{
+						{"route": "host-name.domain-name"},
+						{"route": "domain-name"},
+						{"route": "host-name.domain-name/path"},
+						{"route": "tcp-domain-name:1234"},
+					}))
+					Expect(application.NoRoute).To(BeFalse())
 				})
 			})
 
-			It("generates a manifest containing two hosts two domains", func() {
-				m.Domain("app1", "foo1", "test1.com")
-				m.Domain("app1", "foo1", "test2.com")
-				m.Domain("app1", "foo2", "test1.com")
-				m.Domain("app1", "foo2", "test2.com")
-				err := m.Save(f)
-				Expect(err).NotTo(HaveOccurred())
-
-				application := getYaml(f).Applications[0]
-
-				Expect(application.Name).To(Equal("app1"))
-				Expect(application.Hosts).To(ContainElement("foo1"))
-				Expect(application.Hosts).To(ContainElement("foo2"))
-				Expect(application.Domains).To(ContainElement("test1.com"))
-				Expect(application.Domains).To(ContainElement("test2.com"))
-
-				Expect(application.Host).To(Equal(""))
-				Expect(application.Domain).To(Equal(""))
-				Expect(application.NoRoute).To(BeFalse())
-				Expect(application.NoHostname).To(BeFalse())
-			})
-
-			It("generates a manifest containing two hosts one domain", func() {
-				m.Domain("app1", "foo1", "test.com")
-				m.Domain("app1", "foo2", "test.com")
-				err := m.Save(f)
-				Expect(err).NotTo(HaveOccurred())
-
-				application := getYaml(f).Applications[0]
-
-				Expect(application.Name).To(Equal("app1"))
-				Expect(application.Hosts).To(ContainElement("foo1"))
-				Expect(application.Hosts).To(ContainElement("foo2"))
-				Expect(application.Domain).To(Equal("test.com"))
-
-				Expect(application.Host).To(Equal(""))
-				Expect(application.Domains).To(BeEmpty())
-				Expect(application.NoRoute).To(BeFalse())
-				Expect(application.NoHostname).To(BeFalse())
-			})
-
-			It("generates a manifest containing one host two domains", func() {
-				m.Domain("app1", "foo", "test1.com")
-				m.Domain("app1", "foo", "test2.com")
-				err := m.Save(f)
-				Expect(err).NotTo(HaveOccurred())
-
-				application := getYaml(f).Applications[0]
-
-				Expect(application.Name).To(Equal("app1"))
-				Expect(application.Host).To(Equal("foo"))
-				Expect(application.Domains).To(ContainElement("test1.com"))
-				Expect(application.Domains).To(ContainElement("test2.com"))
-
-				Expect(application.Hosts).To(BeEmpty())
-				Expect(application.Domain).To(Equal(""))
-				Expect(application.NoRoute).To(BeFalse())
-				Expect(application.NoHostname).To(BeFalse())
-			})
-
 			Context("when the application contains environment vars", func() {
 				BeforeEach(func() {
 					m.EnvironmentVars("app1", "foo", "foo-value")
@@ -313,23 +234,19 @@ type YManifest struct {
 }
 
 type YApplication struct {
-	Name       string                 `yaml:"name"`
-	Services   []string               `yaml:"services"`
-	Buildpack  string                 `yaml:"buildpack"`
-	Memory     string                 `yaml:"memory"`
-	Command    string                 `yaml:"command"`
-	Env        map[string]interface{} `yaml:"env"`
-	Timeout    int                    `yaml:"timeout"`
-	Instances  int                    `yaml:"instances"`
-	Host       string                 `yaml:"host"`
-	Hosts      []string               `yaml:"hosts"`
-	Domain     string                 `yaml:"domain"`
-	Domains    []string               `yaml:"domains"`
-	NoHostname bool                   `yaml:"no-hostname"`
-	NoRoute    bool                   `yaml:"no-route"`
-	DiskQuota  string                 `yaml:"disk_quota"`
-	Stack      string                 `yaml:"stack"`
-	AppPorts   []int                  `yaml:"app-ports"`
+	Name      string                 `yaml:"name"`
+	Services  []string               `yaml:"services"`
+	Buildpack string                 `yaml:"buildpack"`
+	Memory    string                 `yaml:"memory"`
+	Command   string                 `yaml:"command"`
+	Env       map[string]interface{} `yaml:"env"`
+	Timeout   int                    `yaml:"timeout"`
+	Instances int                    `yaml:"instances"`
+	Routes    []map[string]string    `yaml:"routes"`
+	NoRoute   bool                   `yaml:"no-route"`
+	DiskQuota string                 `yaml:"disk_quota"`
+	Stack     string                 `yaml:"stack"`
+	AppPorts  []int                  `yaml:"app-ports"`
 }
 
 func getYaml(f *bytes.Buffer) YManifest {
@@ -165,9 +165,21 @@ func expandProperties(input interface{}, babbler generator.WordGenerator) (inter
 }
 
 func mapToAppParams(basePath string, yamlMap generic.Map) (models.AppParams, error) {
-	err := checkForNulls(yamlMap)
-	if err != nil {
-		return models.AppParams{}, err
+	appParams, errs := parseAppParams(basePath, yamlMap)
+	if len(errs) > 0 {
+		message := ""
+		for _, err := range errs {
+			message = message + fmt.Sprintf("%s\n", err.Error())
+		}
+		return models.AppParams{}, errors.New(message)
+	}
+
+	return appParams, nil
+}
+
+func parseAppParams(basePath string, yamlMap generic.Map) (models.AppParams, []error) {
+	if errs := checkForNulls(yamlMap); len(errs) > 0 {
+		return models.AppParams{}, errs
 	}
 
 	var appParams models.AppParams
@@ -201,6 +213,15 @@ func mapToAppParams(basePath string, yamlMap generic.Map) (models.AppParams, err
 	appParams.EnvironmentVars = envVarOrEmptyMap(yamlMap, &errs)
 	appParams.HealthCheckType = stringVal(yamlMap, "health-check-type", &errs)
 	appParams.AppPorts = intSliceVal(yamlMap, "app-ports", &errs)
+	appParams.Routes = routesVal(yamlMap, &errs)
+
+	if appParams.Routes != nil {
+		for _, key := range []string{"host", "hosts", "domain", "domains", "no-hostname"} {
+			if yamlMap.Has(key) {
+				errs = append(errs, newPropertyError(key, errors.New(T("{{.PropertyName}} cannot be used with routes", map[string]interface{}{"PropertyName": key}))))
+			}
+		}
+	}
 
 	if appParams.Path != nil {
 		path := *appParams.Path
@@ -213,11 +234,7 @@ func mapToAppParams(basePath string, yamlMap generic.Map) (models.AppParams, err
 	}
 
 	if len(errs) > 0 {
-		message := ""
-		for _, err := range errs {
-			message = message + fmt.Sprintf("%s\n", err.Error())
-		}
-		return models.AppParams{}, errors.New(message)
+		return models.AppParams{}, errs
 	}
 
 	return appParams, nil
@@ -240,26 +257,18 @@ func removeDuplicatedValue(ary []string) *[]string {
 	return &newAry
 }
 
-func checkForNulls(yamlMap generic.Map) error {
+func checkForNulls(yamlMap generic.Map) []error {
 	var errs []error
 	generic.Each(yamlMap, func(key interface{}, value interface{}) {
 		if key == "command" || key == "buildpack" {
 			return
 		}
 		if value == nil {
-			errs = append(errs, fmt.Errorf(T("{{.PropertyName}} should not be null", map[string]interface{}{"PropertyName": key})))
+			errs = append(errs, newPropertyError(coerceToString(key), fmt.Errorf(T("{{.PropertyName}} should not be null", map[string]interface{}{"PropertyName": key}))))
 		}
 	})
 
-	if len(errs) > 0 {
-		message := ""
-		for i := range errs {
-			message = message + fmt.Sprintf("%s\n", errs[i].Error())
-		}
-		return errors.New(message)
-	}
-
-	return nil
+	return errs
 }
 
 func stringVal(yamlMap generic.Map, key string, errs *[]error) *string {
@@ -269,7 +278,7 @@ func stringVal(yamlMap generic.Map, key string, errs *[]error) *string {
 	}
 	result, ok := val.(string)
 	if !ok {
-		*errs = append(*errs, fmt.Errorf(T("{{.PropertyName}} must be a string value", map[string]interface{}{"PropertyName": key})))
+		*errs = append(*errs, newPropertyError(key, fmt.Errorf(T("{{.PropertyName}} must be a string value", map[string]interface{}{"PropertyName": key}))))
 		return nil
 	}
 	return &result
@@ -289,7 +298,7 @@ func stringValOrDefault(yamlMap generic.Map, key string, errs *[]error) *string
 	case nil:
 		return &empty
 	default:
-		*errs = append(*errs, fmt.Errorf(T("{{.PropertyName}} must be a string or null value", map[string]interface{}{"PropertyName": key})))
+		*errs = append(*errs, newPropertyError(key, fmt.Errorf(T("{{.PropertyName}} must be a string or null value", map[string]interface{}{"PropertyName": key}))))
 		return nil
 	}
 }
@@ -303,12 +312,12 @@ func bytesVal(yamlMap generic.Map, key string, errs *[]error) *int64 {
 	stringVal := coerceToString(yamlVal)
 	value, err := formatters.ToMegabytes(stringVal)
 	if err != nil {
-		*errs = append(*errs, fmt.Errorf(T("Invalid value for '{{.PropertyName}}': {{.StringVal}}\n{{.Error}}",
+		*errs = append(*errs, newPropertyError(key, fmt.Errorf(T("Invalid value for '{{.PropertyName}}': {{.StringVal}}\n{{.Error}}",
 			map[string]interface{}{
 				"PropertyName": key,
 				"Error":        err.Error(),
 				"StringVal":    stringVal,
-			})))
+			}))))
 		return nil
 	}
 	return &value
@@ -335,7 +344,7 @@ func intVal(yamlMap generic.Map, key string, errs *[]error) *int {
 	}
 
 	if err != nil {
-		*errs = append(*errs, err)
+		*errs = append(*errs, newPropertyError(key, err))
 		return nil
 	}
 
@@ -355,7 +364,7 @@ func boolVal(yamlMap generic.Map, key string, errs *[]error) bool {
 	case string:
 		return val == "true"
 	default:
-		*errs = append(*errs, fmt.Errorf(T("Expected {{.PropertyName}} to be a boolean.", map[string]interface{}{"PropertyName": key})))
+		*errs = append(*errs, newPropertyError(key, fmt.Errorf(T("Expected {{.PropertyName}} to be a boolean.", map[string]interface{}{"PropertyName": key}))))
 		return false
 	}
 }
@@ -370,7 +379,7 @@ func sliceOrEmptyVal(yamlMap generic.Map, key string, errs *[]error) *[]string {
 		err         error
 	)
 
-	sliceErr := fmt.Errorf(T("Expected {{.PropertyName}} to be a list of strings.", map[string]interface{}{"PropertyName": key}))
+	sliceErr := newPropertyError(key, fmt.Errorf(T("Expected {{.PropertyName}} to be a list of strings.", map[string]interface{}{"PropertyName": key})))
 
 	switch input := yamlMap.Get(key).(type) {
 	case []interface{}:
@@ -394,12 +403,43 @@ func sliceOrEmptyVal(yamlMap generic.Map, key string, errs *[]error) *[]string {
 	return &stringSlice
 }
 
+func routesVal(yamlMap generic.Map, errs *[]error) []models.ManifestRoute {
+	if !yamlMap.Has("routes") {
+		return nil
+	}
+
+	routesErr := newPropertyError("routes", errors.New(T("Expected routes to be a list of maps with a route key.")))
+
+	input, ok := yamlMap.Get("routes").([]interface{})
+	if !ok {
+		*errs = append(*errs, routesErr)
+		return nil
+	}
+
+	routes := []models.ManifestRoute{}
+	for _, value := range input {
+		if value == nil || !generic.IsMappable(value) {
+			*errs = append(*errs, routesErr)
+			return nil
+		}
+
+		route, ok := generic.NewMap(value).Get("route").(string)
+		if !ok || route == "" {
+			*errs = append(*errs, routesErr)
+			return nil
+		}
+		routes = append(routes, models.ManifestRoute{Route: route})
+	}
+
+	return routes
+}
+
 func intSliceVal(yamlMap generic.Map, key string, errs *[]error) *[]int {
 	if !yamlMap.Has(key) {
 		return nil
 	}
 
-	err := fmt.Errorf(T("Expected {{.PropertyName}} to be a list of integers.", map[string]interface{}{"PropertyName": key}))
+	err := newPropertyError(key, fmt.Errorf(T("Expected {{.PropertyName}} to be a list of integers.", map[string]interface{}{"PropertyName": key})))
 
 	s, ok := yamlMap.Get(key).([]interface{})
 
@@ -439,7 +479,9 @@ func envVarOrEmptyMap(yamlMap generic.Map, errs *[]error) *map[string]interface{
 	case generic.Map:
 		merrs := validateEnvVars(envVars)
 		if merrs != nil {
-			*errs = append(*errs, merrs...)
+			for _, err := range merrs {
+				*errs = append(*errs, newPropertyError(key, err))
+			}
 			return nil
 		}
 
@@ -450,12 +492,27 @@ func envVarOrEmptyMap(yamlMap generic.Map, errs *[]error) *map[string]interface{
 
 		return &result
 	default:
-		*errs = append(*errs, fmt.Errorf(T("Expected {{.Name}} to be a set of key => value, but it was a {{.Type}}.",
-			map[string]interface{}{"Name": key, "Type": envVars})))
+		*errs = append(*errs, newPropertyError(key, fmt.Errorf(T("Expected {{.Name}} to be a set of key => value, but it was a {{.Type}}.",
+			map[string]interface{}{"Name": key, "Type": envVars}))))
 		return nil
 	}
 }
 
+// propertyError is an error in the value of a property of an app, which
+// Validate uses to find the line of the property.
+type propertyError struct {
+	key string
+	err error
+}
+
+func newPropertyError(key string, err error) error {
+	return propertyError{key: key, err: err}
+}
+
+func (e propertyError) Error() string {
+	return e.err.Error()
+}
+
 func validateEnvVars(input generic.Map) (errs []error) {
 	generic.Each(input, func(key, value interface{}) {
 		if value == nil {
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/cloudfoundry/cli/cf/manifest"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/generic"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -472,6 +473,73 @@ var _ = Describe("Manifests", func() {
 		})
 	})
 
+	Describe("parsing routes", func() {
+		It("parses each route", func() {
+			m := NewManifest("/some/path", generic.NewMap(map[interface{}]interface{}{
+				"applications": []interface{}{
+					map[interface{}]interface{}{
+						"routes": []interface{}{
+							map[interface{}]interface{}{"route": "host.example.com/path"},
+							map[interface{}]interface{}{"route": "tcp.example.com:1234"},
+						},
+					},
+				},
+			}))
+
+			apps, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(apps[0].Routes).To(Equal([]models.ManifestRoute{
+				{Route: "host.example.com/path"},
+				{Route: "tcp.example.com:1234"},
+			}))
+		})
+
+		It("handles omitted field", func() {
+			m := NewManifest("/some/path", generic.NewMap(map[interface{}]interface{}{
+				"applications": []interface{}{
+					map[interface{}]interface{}{},
+				},
+			}))
+
+			apps, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(apps[0].Routes).To(BeNil())
+		})
+
+		It("returns an error when a route is not a map with a route key", func() {
+			m := NewManifest("/some/path", generic.NewMap(map[interface{}]interface{}{
+				"applications": []interface{}{
+					map[interface{}]interface{}{
+						"routes": []interface{}{"host.example.com"},
+					},
+				},
+			}))
+
+			_, err := m.Applications()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Expected routes to be a list of maps with a route key."))
+		})
+
+		It("returns an error when routes are combined with hosts or domains", func() {
+			m := NewManifest("/some/path", generic.NewMap(map[interface{}]interface{}{
+				"applications": []interface{}{
+					map[interface{}]interface{}{
+						"routes": []interface{}{
+							map[interface{}]interface{}{"route": "host.example.com"},
+						},
+						"host":   "other-host",
+						"domain": "example.com",
+					},
+				},
+			}))
+
+			_, err := m.Applications()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("host cannot be used with routes"))
+			Expect(err.Error()).To(ContainSubstring("domain cannot be used with routes"))
+		})
+	})
+
 	Describe("parsing env vars", func() {
 		It("handles values that are not strings", func() {
 			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
@@ -59,12 +59,14 @@ type FakeAppManifest struct {
 		arg1 string
 		arg2 int
 	}
-	DomainStub        func(string, string, string)
-	domainMutex       sync.RWMutex
-	domainArgsForCall []struct {
+	RouteStub        func(string, string, string, string, int)
+	routeMutex       sync.RWMutex
+	routeArgsForCall []struct {
 		arg1 string
 		arg2 string
 		arg3 string
+		arg4 string
+		arg5 int
 	}
 	GetContentsStub        func() []models.Application
 	getContentsMutex       sync.RWMutex
@@ -287,29 +289,31 @@ func (fake *FakeAppManifest) InstancesArgsForCall(i int) (string, int) {
 	return fake.instancesArgsForCall[i].arg1, fake.instancesArgsForCall[i].arg2
 }
 
-func (fake *FakeAppManifest) Domain(arg1 string, arg2 string, arg3 string) {
-	fake.domainMutex.Lock()
-	fake.domainArgsForCall = append(fake.domainArgsForCall, struct {
+func (fake *FakeAppManifest) Route(arg1 string, arg2 string, arg3 string, arg4 string, arg5 int) {
+	fake.routeMutex.Lock()
+	fake.routeArgsForCall = append(fake.routeArgsForCall, struct {
 		arg1 string
 		arg2 string
 		arg3 string
-	}{arg1, arg2, arg3})
-	fake.domainMutex.Unlock()
-	if fake.DomainStub != nil {
-		fake.DomainStub(arg1, arg2, arg3)
+		arg4 string
+		arg5 int
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.routeMutex.Unlock()
+	if fake.RouteStub != nil {
+		fake.RouteStub(arg1, arg2, arg3, arg4, arg5)
 	}
 }
 
-func (fake *FakeAppManifest) DomainCallCount() int {
-	fake.domainMutex.RLock()
-	defer fake.domainMutex.RUnlock()
-	return len(fake.domainArgsForCall)
+func (fake *FakeAppManifest) RouteCallCount() int {
+	fake.routeMutex.RLock()
+	defer fake.routeMutex.RUnlock()
+	return len(fake.routeArgsForCall)
 }
 
-func (fake *FakeAppManifest) DomainArgsForCall(i int) (string, string, string) {
-	fake.domainMutex.RLock()
-	defer fake.domainMutex.RUnlock()
-	return fake.domainArgsForCall[i].arg1, fake.domainArgsForCall[i].arg2, fake.domainArgsForCall[i].arg3
+func (fake *FakeAppManifest) RouteArgsForCall(i int) (string, string, string, string, int) {
+	fake.routeMutex.RLock()
+	defer fake.routeMutex.RUnlock()
+	return fake.routeArgsForCall[i].arg1, fake.routeArgsForCall[i].arg2, fake.routeArgsForCall[i].arg3, fake.routeArgsForCall[i].arg4, fake.routeArgsForCall[i].arg5
 }
 
 func (fake *FakeAppManifest) GetContents() []models.Application {
@@ -361,11 +365,16 @@ func (fake *FakeAppManifest) StackArgsForCall(i int) (string, string) {
 }
 
 func (fake *FakeAppManifest) AppPorts(arg1 string, arg2 []int) {
+	var arg2Copy []int
+	if arg2 != nil {
+		arg2Copy = make([]int, len(arg2))
+		copy(arg2Copy, arg2)
+	}
 	fake.appPortsMutex.Lock()
 	fake.appPortsArgsForCall = append(fake.appPortsArgsForCall, struct {
 		arg1 string
 		arg2 []int
-	}{arg1, arg2})
+	}{arg1, arg2Copy})
 	fake.appPortsMutex.Unlock()
 	if fake.AppPortsStub != nil {
 		fake.AppPortsStub(arg1, arg2)
@@ -0,0 +1,314 @@
+package manifest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/generic"
+	"github.com/cloudfoundry/cli/words/generator"
+)
+
+// ValidationError is a mistake found in a manifest by Validate. AppName is
+// empty for global properties, Key is empty when the mistake is not in a
+// single property and Line is 0 when the line is not known.
+type ValidationError struct {
+	Path    string
+	AppName string
+	Key     string
+	Line    int
+	Message string
+}
+
+var appKeys = []string{
+	"app-ports",
+	"buildpack",
+	"command",
+	"disk_quota",
+	"domain",
+	"domains",
+	"env",
+	"health-check-type",
+	"host",
+	"hosts",
+	"instances",
+	"memory",
+	"name",
+	"no-hostname",
+	"no-route",
+	"path",
+	"random-route",
+	"routes",
+	"services",
+	"stack",
+	"timeout",
+}
+
+var globalKeys = append([]string{"applications", "inherit"}, appKeys...)
+
+var conflictingKeys = []struct {
+	key    string
+	others []string
+}{
+	{"no-route", []string{"host", "hosts", "domain", "domains", "routes", "no-hostname", "random-route"}},
+	{"no-hostname", []string{"host", "hosts"}},
+}
+
+// Validate reports every error that pushing the manifest would run into,
+// along with unknown properties and options that cannot be used together.
+// Line numbers are looked up in the manifest file, so properties inherited
+// from another file are reported without one.
+func (m Manifest) Validate() []ValidationError {
+	contents, _ := ioutil.ReadFile(filepath.Clean(m.Path))
+	v := &validator{path: m.Path, lines: newLineFinder(contents)}
+
+	rawData, err := expandProperties(m.Data, generator.NewWordGenerator())
+	if err != nil {
+		v.addMessages("", "", err.Error())
+		return v.errs
+	}
+	data := generic.NewMap(rawData)
+
+	v.checkUnknownKeys("", data, globalKeys)
+
+	appMaps, err := m.getAppMaps(data)
+	if err != nil {
+		v.addMessages("", "applications", err.Error())
+		return v.errs
+	}
+
+	if appList, ok := data.Get("applications").([]interface{}); ok {
+		for _, appData := range appList {
+			appMap := generic.NewMap(appData)
+			v.checkUnknownKeys(appName(appMap), appMap, appKeys)
+		}
+	}
+
+	for _, appMap := range appMaps {
+		name := appName(appMap)
+
+		appParams, errs := parseAppParams(filepath.Dir(m.Path), appMap)
+		for _, err := range errs {
+			key := ""
+			if propErr, ok := err.(propertyError); ok {
+				key = propErr.key
+			}
+			v.add(name, key, err.Error())
+		}
+		if len(errs) == 0 && appParams.Path != nil {
+			if _, err := os.Stat(*appParams.Path); err != nil {
+				v.add(name, "path", T("path {{.Path}} does not exist", map[string]interface{}{"Path": *appParams.Path}))
+			}
+		}
+
+		v.checkConflicts(name, appMap)
+	}
+
+	return v.errs
+}
+
+// ValidateServices reports the services bound by the apps of the manifest
+// that isKnown does not find. It is separate from Validate because looking
+// up service instances needs a targeted space, and stops at the first error
+// returned by isKnown. Manifests that cannot be parsed are left to Validate.
+func (m Manifest) ValidateServices(isKnown func(name string) (bool, error)) ([]ValidationError, error) {
+	contents, _ := ioutil.ReadFile(filepath.Clean(m.Path))
+	v := &validator{path: m.Path, lines: newLineFinder(contents)}
+
+	rawData, err := expandProperties(m.Data, generator.NewWordGenerator())
+	if err != nil {
+		return nil, nil
+	}
+	appMaps, err := m.getAppMaps(generic.NewMap(rawData))
+	if err != nil {
+		return nil, nil
+	}
+
+	known := map[string]bool{}
+	for _, appMap := range appMaps {
+		var ignored []error
+		for _, service := range *sliceOrEmptyVal(appMap, "services", &ignored) {
+			found, checked := known[service]
+			if !checked {
+				found, err = isKnown(service)
+				if err != nil {
+					return nil, err
+				}
+				known[service] = found
+			}
+
+			if !found {
+				v.add(appName(appMap), "services", T("service instance {{.ServiceName}} not found", map[string]interface{}{"ServiceName": service}))
+			}
+		}
+	}
+
+	return v.errs, nil
+}
+
+func appName(appMap generic.Map) string {
+	name, _ := appMap.Get("name").(string)
+	return name
+}
+
+type validator struct {
+	path  string
+	lines lineFinder
+	errs  []ValidationError
+}
+
+func (v *validator) add(appName, key, message string) {
+	line := 0
+	if key != "" {
+		line = v.lines.keyLine(appName, key)
+	}
+	if line == 0 {
+		line = v.lines.appLine(appName)
+	}
+
+	v.errs = append(v.errs, ValidationError{
+		Path:    v.path,
+		AppName: appName,
+		Key:     key,
+		Line:    line,
+		Message: message,
+	})
+}
+
+func (v *validator) addMessages(appName, key, messages string) {
+	for _, message := range strings.Split(messages, "\n") {
+		if strings.TrimSpace(message) != "" {
+			v.add(appName, key, message)
+		}
+	}
+}
+
+func (v *validator) checkUnknownKeys(appName string, data generic.Map, knownKeys []string) {
+	var unknownKeys []string
+	for _, key := range data.Keys() {
+		name, ok := key.(string)
+		if !ok || !contains(knownKeys, name) {
+			unknownKeys = append(unknownKeys, coerceToString(key))
+		}
+	}
+	sort.Strings(unknownKeys)
+
+	for _, key := range unknownKeys {
+		v.add(appName, key, T("unknown property {{.PropertyName}}", map[string]interface{}{"PropertyName": key}))
+	}
+}
+
+func (v *validator) checkConflicts(appName string, appMap generic.Map) {
+	var ignored []error
+	for _, conflict := range conflictingKeys {
+		if !boolVal(appMap, conflict.key, &ignored) {
+			continue
+		}
+
+		for _, other := range conflict.others {
+			if !appMap.Has(other) {
+				continue
+			}
+			if _, isBool := appMap.Get(other).(bool); isBool && !boolVal(appMap, other, &ignored) {
+				continue
+			}
+
+			v.add(appName, other, T("{{.PropertyName}} cannot be used with {{.OtherPropertyName}}",
+				map[string]interface{}{"PropertyName": other, "OtherPropertyName": conflict.key}))
+		}
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// lineFinder looks up the line numbers of properties in the text of a
+// manifest, which the parsed YAML does not keep.
+type lineFinder struct {
+	lines []string
+}
+
+func newLineFinder(contents []byte) lineFinder {
+	return lineFinder{lines: strings.Split(string(contents), "\n")}
+}
+
+// appLine returns the line of the name of the app, or 0 when it is not found.
+func (f lineFinder) appLine(appName string) int {
+	start, _, _ := f.appBlock(appName)
+	if start == -1 {
+		return 0
+	}
+	return start + 1
+}
+
+// keyLine returns the line of key in the block of the app, falling back to
+// the global properties when the app does not set it.
+func (f lineFinder) keyLine(appName, key string) int {
+	keyRegex := regexp.MustCompile(`^(\s*(?:-\s+)?)` + regexp.QuoteMeta(key) + `\s*:`)
+
+	if start, end, indent := f.appBlock(appName); start != -1 {
+		for i := start; i < end; i++ {
+			if match := keyRegex.FindStringSubmatch(f.lines[i]); match != nil && len(match[1]) == indent {
+				return i + 1
+			}
+		}
+	}
+
+	for i, line := range f.lines {
+		if match := keyRegex.FindStringSubmatch(line); match != nil && len(match[1]) == 0 {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+// appBlock returns the first line of the app, the line after its last one
+// and the column of its properties, or -1 when the app is not found.
+func (f lineFinder) appBlock(appName string) (int, int, int) {
+	if appName == "" {
+		return -1, -1, 0
+	}
+
+	nameRegex := regexp.MustCompile(`^(\s*(?:-\s+)?)name\s*:\s*["']?` + regexp.QuoteMeta(appName) + `["']?\s*(#.*)?$`)
+
+	for i, line := range f.lines {
+		match := nameRegex.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		indent := len(match[1])
+		start := i
+		for start > 0 && !strings.HasPrefix(strings.TrimSpace(f.lines[start]), "-") && indentOf(f.lines[start-1]) >= indent-2 {
+			start--
+		}
+
+		end := i + 1
+		for end < len(f.lines) {
+			trimmed := strings.TrimSpace(f.lines[end])
+			if trimmed != "" && !strings.HasPrefix(trimmed, "#") && indentOf(f.lines[end]) < indent {
+				break
+			}
+			end++
+		}
+
+		return start, end, indent
+	}
+
+	return -1, -1, 0
+}
+
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
@@ -0,0 +1,181 @@
+package manifest_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudfoundry/cli/cf/manifest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Validate", func() {
+	var (
+		dir          string
+		manifestPath string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "validate-manifest")
+		Expect(err).NotTo(HaveOccurred())
+		manifestPath = filepath.Join(dir, "manifest.yml")
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	readManifest := func(contents string) *manifest.Manifest {
+		err := ioutil.WriteFile(manifestPath, []byte(contents), 0600)
+		Expect(err).NotTo(HaveOccurred())
+
+		m, err := manifest.NewManifestDiskRepository().ReadManifest(manifestPath)
+		Expect(err).NotTo(HaveOccurred())
+		return m
+	}
+
+	It("returns no errors for a valid manifest", func() {
+		m := readManifest(`---
+memory: 256M
+applications:
+- name: app1
+  host: app1-host
+  path: .
+`)
+
+		Expect(m.Validate()).To(BeEmpty())
+	})
+
+	It("reports unknown properties with their line", func() {
+		m := readManifest(`---
+memory: 256M
+colour: blue
+applications:
+- name: app1
+  hots: app1-host
+- instances: 2
+  name: app2
+  path: .
+  sevices:
+  - my-db
+`)
+
+		Expect(m.Validate()).To(ConsistOf(
+			manifest.ValidationError{Path: manifestPath, Key: "colour", Line: 3, Message: "unknown property colour"},
+			manifest.ValidationError{Path: manifestPath, AppName: "app1", Key: "hots", Line: 6, Message: "unknown property hots"},
+			manifest.ValidationError{Path: manifestPath, AppName: "app2", Key: "sevices", Line: 10, Message: "unknown property sevices"},
+		))
+	})
+
+	It("reports options that cannot be used together", func() {
+		m := readManifest(`---
+applications:
+- name: app1
+  path: .
+  no-route: true
+  hosts:
+  - app1-host
+  random-route: false
+- name: app2
+  path: .
+  no-hostname: true
+  host: app2-host
+`)
+
+		Expect(m.Validate()).To(ConsistOf(
+			manifest.ValidationError{Path: manifestPath, AppName: "app1", Key: "hosts", Line: 6, Message: "hosts cannot be used with no-route"},
+			manifest.ValidationError{Path: manifestPath, AppName: "app2", Key: "host", Line: 12, Message: "host cannot be used with no-hostname"},
+		))
+	})
+
+	It("reports the errors of each app with their property", func() {
+		m := readManifest(`---
+applications:
+- name: app1
+  memory: 256M
+  path: does-not-exist
+- name: app2
+  path: .
+  memory: 256
+  instances: [2]
+`)
+
+		errs := m.Validate()
+		Expect(errs).To(HaveLen(3))
+
+		Expect(errs[0]).To(Equal(manifest.ValidationError{
+			Path:    manifestPath,
+			AppName: "app1",
+			Key:     "path",
+			Line:    5,
+			Message: "path " + filepath.Join(dir, "does-not-exist") + " does not exist",
+		}))
+
+		Expect(errs[1].AppName).To(Equal("app2"))
+		Expect(errs[1].Key).To(Equal("memory"))
+		Expect(errs[1].Line).To(Equal(8))
+		Expect(errs[1].Message).To(ContainSubstring("Invalid value for 'memory': 256"))
+
+		Expect(errs[2].AppName).To(Equal("app2"))
+		Expect(errs[2].Key).To(Equal("instances"))
+		Expect(errs[2].Line).To(Equal(9))
+		Expect(errs[2].Message).To(ContainSubstring("Expected instances to be a number"))
+	})
+
+	It("reports errors that are not in a single property without a key", func() {
+		m := readManifest(`---
+applications:
+- name: app1
+  path: ${app-path}
+`)
+
+		errs := m.Validate()
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Key).To(BeEmpty())
+		Expect(errs[0].Message).To(ContainSubstring("Property '${app-path}' found in manifest"))
+	})
+
+	Describe("ValidateServices", func() {
+		It("reports the services that are not known", func() {
+			m := readManifest(`---
+applications:
+- name: app1
+  services:
+  - my-db
+  - my-dbb
+- name: app2
+  services:
+  - my-db
+`)
+
+			var looked []string
+			errs, err := m.ValidateServices(func(name string) (bool, error) {
+				looked = append(looked, name)
+				return name == "my-db", nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(looked).To(Equal([]string{"my-db", "my-dbb"}))
+			Expect(errs).To(Equal([]manifest.ValidationError{
+				{Path: manifestPath, AppName: "app1", Key: "services", Line: 4, Message: "service instance my-dbb not found"},
+			}))
+		})
+
+		It("returns the error of the lookup", func() {
+			m := readManifest(`---
+applications:
+- name: app1
+  services:
+  - my-db
+`)
+
+			_, err := m.ValidateServices(func(name string) (bool, error) {
+				return false, errors.New("lookup-error")
+			})
+			Expect(err).To(MatchError("lookup-error"))
+		})
+	})
+})
@@ -0,0 +1,131 @@
+package manifest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/generic"
+	"gopkg.in/yaml.v2"
+)
+
+// Vars holds the values of the ((name)) placeholders of a manifest.
+type Vars map[string]interface{}
+
+var variableRegex = regexp.MustCompile(`\(\(([-\w\.]+)\)\)`)
+
+// ReadVarsFiles reads the YAML files at paths into a single set of vars.
+// A variable defined in several files takes its value from the last one.
+func ReadVarsFiles(paths []string) (Vars, error) {
+	vars := Vars{}
+
+	for _, path := range paths {
+		contents, err := ioutil.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", T("Error reading vars file {{.Path}}", map[string]interface{}{"Path": path}), err.Error())
+		}
+
+		fileVars := make(map[interface{}]interface{})
+		err = yaml.Unmarshal(contents, &fileVars)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", T("Error reading vars file {{.Path}}", map[string]interface{}{"Path": path}), err.Error())
+		}
+
+		for key, value := range fileVars {
+			name, ok := key.(string)
+			if !ok {
+				return nil, errors.New(T("Invalid vars file {{.Path}}. Expected variable names to be strings", map[string]interface{}{"Path": path}))
+			}
+			vars[name] = value
+		}
+	}
+
+	return vars, nil
+}
+
+// AddVarFlags adds the KEY=VALUE pairs given with --var to the vars,
+// overriding the values read from vars files.
+func (vars Vars) AddVarFlags(flagValues []string) error {
+	for _, flagValue := range flagValues {
+		parts := strings.SplitN(flagValue, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return errors.New(T("Invalid variable '{{.Var}}'. Expected KEY=VALUE", map[string]interface{}{"Var": flagValue}))
+		}
+		vars[parts[0]] = parts[1]
+	}
+
+	return nil
+}
+
+// Interpolate replaces the ((name)) placeholders in the values of the
+// manifest with the given vars. A value made of a single placeholder takes
+// the variable's value as is, so numbers, lists and maps keep their type.
+// It fails listing every variable that has no value, leaving the manifest
+// untouched.
+func (m *Manifest) Interpolate(vars Vars) error {
+	missing := map[string]bool{}
+	data := interpolate(m.Data, vars, missing)
+
+	if len(missing) > 0 {
+		var names []string
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		return errors.New(T("Expected to find variables: {{.VariableNames}}",
+			map[string]interface{}{"VariableNames": strings.Join(names, ", ")}))
+	}
+
+	m.Data = data.(generic.Map)
+	return nil
+}
+
+func interpolate(input interface{}, vars Vars, missing map[string]bool) interface{} {
+	switch input := input.(type) {
+	case string:
+		if match := variableRegex.FindStringSubmatch(input); match != nil && match[0] == input {
+			value, ok := vars[match[1]]
+			if !ok {
+				missing[match[1]] = true
+				return input
+			}
+			return value
+		}
+
+		return variableRegex.ReplaceAllStringFunc(input, func(placeholder string) string {
+			name := variableRegex.FindStringSubmatch(placeholder)[1]
+			value, ok := vars[name]
+			if !ok {
+				missing[name] = true
+				return placeholder
+			}
+			return fmt.Sprintf("%v", value)
+		})
+	case []interface{}:
+		output := make([]interface{}, len(input))
+		for index, item := range input {
+			output[index] = interpolate(item, vars, missing)
+		}
+		return output
+	case map[interface{}]interface{}:
+		output := make(map[interface{}]interface{})
+		for key, value := range input {
+			output[key] = interpolate(value, vars, missing)
+		}
+		return output
+	case generic.Map:
+		output := generic.NewMap()
+		generic.Each(input, func(key, value interface{}) {
+			output.Set(key, interpolate(value, vars, missing))
+		})
+		return output
+	default:
+		return input
+	}
+}
@@ -0,0 +1,123 @@
+package manifest_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/cloudfoundry/cli/cf/manifest"
+	"github.com/cloudfoundry/cli/generic"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Vars", func() {
+	Describe("ReadVarsFiles", func() {
+		var paths []string
+
+		writeVarsFile := func(contents string) string {
+			file, err := ioutil.TempFile("", "vars")
+			Expect(err).NotTo(HaveOccurred())
+			defer file.Close()
+
+			_, err = file.WriteString(contents)
+			Expect(err).NotTo(HaveOccurred())
+
+			paths = append(paths, file.Name())
+			return file.Name()
+		}
+
+		BeforeEach(func() {
+			paths = []string{}
+		})
+
+		AfterEach(func() {
+			for _, path := range paths {
+				os.Remove(path)
+			}
+		})
+
+		It("merges the files, later files taking precedence", func() {
+			first := writeVarsFile("host: first-host\ninstances: 2\n")
+			second := writeVarsFile("host: second-host\n")
+
+			vars, err := manifest.ReadVarsFiles([]string{first, second})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vars).To(Equal(manifest.Vars{"host": "second-host", "instances": 2}))
+		})
+
+		It("returns an error when a file does not exist", func() {
+			_, err := manifest.ReadVarsFiles([]string{"/does/not/exist.yml"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("/does/not/exist.yml"))
+		})
+
+		It("returns an error when a file is not a YAML map", func() {
+			path := writeVarsFile("- just\n- a list\n")
+
+			_, err := manifest.ReadVarsFiles([]string{path})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(path))
+		})
+	})
+
+	Describe("AddVarFlags", func() {
+		It("overrides the existing values", func() {
+			vars := manifest.Vars{"host": "file-host"}
+
+			err := vars.AddVarFlags([]string{"host=flag-host", "command=a=b"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vars).To(Equal(manifest.Vars{"host": "flag-host", "command": "a=b"}))
+		})
+
+		It("returns an error when a value is not KEY=VALUE", func() {
+			err := manifest.Vars{}.AddVarFlags([]string{"host"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Invalid variable 'host'"))
+		})
+	})
+
+	Describe("Interpolate", func() {
+		var m *manifest.Manifest
+
+		BeforeEach(func() {
+			m = NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"instances": "((instances))",
+				"applications": []interface{}{
+					map[interface{}]interface{}{
+						"name":    "app-((env))",
+						"host":    "((host))",
+						"command": "bundle exec rake",
+						"services": []interface{}{
+							"((db))",
+						},
+					},
+				},
+			}))
+		})
+
+		It("replaces the placeholders, keeping the type of whole values", func() {
+			err := m.Interpolate(manifest.Vars{
+				"instances": 3,
+				"env":       "staging",
+				"host":      "my-host",
+				"db":        "staging-db",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			apps, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*apps[0].Name).To(Equal("app-staging"))
+			Expect(*apps[0].InstanceCount).To(Equal(3))
+			Expect(*apps[0].Hosts).To(Equal([]string{"my-host"}))
+			Expect(*apps[0].ServicesToBind).To(Equal([]string{"staging-db"}))
+			Expect(*apps[0].Command).To(Equal("bundle exec rake"))
+		})
+
+		It("lists every unresolved variable once and leaves the manifest untouched", func() {
+			err := m.Interpolate(manifest.Vars{"env": "staging"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Expected to find variables: db, host, instances"))
+			Expect(m.Data.Get("instances")).To(Equal("((instances))"))
+		})
+	})
+})
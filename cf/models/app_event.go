@@ -9,4 +9,19 @@ type EventFields struct {
 	Description string
 	Actor       string
 	ActorName   string
+	ActeeType   string
+	ActeeName   string
+}
+
+// EventFilter narrows down the events that are listed. Zero values match
+// everything. Exactly one of ActeeGUID, SpaceGUID and OrganizationGUID is
+// expected to be set.
+type EventFilter struct {
+	ActeeGUID        string
+	SpaceGUID        string
+	OrganizationGUID string
+	Types            []string
+	Actor            string
+	Since            time.Time
+	Until            time.Time
 }
@@ -87,6 +87,7 @@ type AppParams struct {
 	EnableSSH          *bool
 	Hosts              *[]string
 	RoutePath          *string
+	Routes             []ManifestRoute
 	InstanceCount      *int
 	Memory             *int64
 	Name               *string
@@ -104,6 +105,12 @@ type AppParams struct {
 	AppPorts           *[]int
 }
 
+// ManifestRoute is an entry of the routes of a manifest, a full route URL
+// such as host.domain/path or tcp-domain:port.
+type ManifestRoute struct {
+	Route string
+}
+
 func (app *AppParams) Merge(other *AppParams) {
 	if other.AppPorts != nil {
 		app.AppPorts = other.AppPorts
@@ -156,6 +163,9 @@ func (app *AppParams) Merge(other *AppParams) {
 	if other.RoutePath != nil {
 		app.RoutePath = other.RoutePath
 	}
+	if other.Routes != nil {
+		app.Routes = other.Routes
+	}
 	if other.ServicesToBind != nil {
 		app.ServicesToBind = other.ServicesToBind
 	}
@@ -14,6 +14,14 @@ import (
 type ccErrorResponse struct {
 	Code        int
 	Description string
+	Errors      []ccV3Error
+}
+
+// ccV3Error is a single entry of the errors list returned by the v3 API.
+type ccV3Error struct {
+	Code   int
+	Title  string
+	Detail string
 }
 
 const invalidTokenCode = 1000
@@ -22,6 +30,11 @@ func cloudControllerErrorHandler(statusCode int, body []byte) error {
 	response := ccErrorResponse{}
 	_ = json.Unmarshal(body, &response)
 
+	if len(response.Errors) > 0 {
+		response.Code = response.Errors[0].Code
+		response.Description = response.Errors[0].Detail
+	}
+
 	if response.Code == invalidTokenCode {
 		return errors.NewInvalidTokenError(response.Description)
 	}
@@ -26,6 +26,12 @@ var invalidTokenCloudControllerRequest = func(writer http.ResponseWriter, reques
 	fmt.Fprintln(writer, jsonResponse)
 }
 
+var failingV3CloudControllerRequest = func(writer http.ResponseWriter, request *http.Request) {
+	writer.WriteHeader(http.StatusUnprocessableEntity)
+	jsonResponse := `{ "errors": [{ "code": 10008, "title": "CF-UnprocessableEntity", "detail": "name must be unique in space" }] }`
+	fmt.Fprintln(writer, jsonResponse)
+}
+
 var _ = Describe("Cloud Controller Gateway", func() {
 	var gateway Gateway
 	var config coreconfig.Reader
@@ -48,6 +54,19 @@ var _ = Describe("Cloud Controller Gateway", func() {
 		Expect(apiErr.(errors.HTTPError).ErrorCode()).To(ContainSubstring("210003"))
 	})
 
+	It("parses v3 error responses", func() {
+		ts := httptest.NewTLSServer(http.HandlerFunc(failingV3CloudControllerRequest))
+		defer ts.Close()
+		gateway.SetTrustedCerts(ts.TLS.Certificates)
+
+		request, apiErr := gateway.NewRequest("POST", ts.URL, "TOKEN", nil)
+		_, apiErr = gateway.PerformRequest(request)
+
+		Expect(apiErr).NotTo(BeNil())
+		Expect(apiErr.Error()).To(ContainSubstring("name must be unique in space"))
+		Expect(apiErr.(errors.HTTPError).ErrorCode()).To(Equal("10008"))
+	})
+
 	It("parses invalid token responses", func() {
 		ts := httptest.NewTLSServer(http.HandlerFunc(invalidTokenCloudControllerRequest))
 		defer ts.Close()
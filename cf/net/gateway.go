@@ -12,7 +12,9 @@ import (
 	"net/url"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cloudfoundry/cli/cf"
@@ -27,8 +29,18 @@ const (
 	JOB_FINISHED             = "finished"
 	JOB_FAILED               = "failed"
 	DEFAULT_POLLING_THROTTLE = 5 * time.Second
+
+	DEFAULT_RETRY_DELAY        = 1 * time.Second
+	DEFAULT_MAX_RETRY_DELAY    = 30 * time.Second
+	DEFAULT_CONNECTION_RETRIES = 2
+
+	DEFAULT_PAGINATION_WORKERS = 4
 )
 
+// warningsMutex guards the warnings of all gateways, which are collected by
+// requests that can run concurrently.
+var warningsMutex sync.Mutex
+
 type JobResource struct {
 	Entity struct {
 		Status       string
@@ -50,34 +62,54 @@ type tokenRefresher interface {
 	RefreshAuthToken() (string, error)
 }
 
+// tokenRefreshState remembers the last auth token refresh of a gateway, so that
+// requests that failed concurrently with the same expired token refresh it
+// only once.
+type tokenRefreshState struct {
+	sync.Mutex
+	expiredToken string
+	newToken     string
+}
+
 type Request struct {
 	HTTPReq      *http.Request
 	SeekableBody io.ReadSeeker
 }
 
 type Gateway struct {
-	authenticator   tokenRefresher
-	errHandler      apiErrorHandler
-	PollingEnabled  bool
-	PollingThrottle time.Duration
-	trustedCerts    []tls.Certificate
-	config          coreconfig.Reader
-	warnings        *[]string
-	Clock           func() time.Time
-	transport       *http.Transport
-	ui              terminal.UI
-	logger          trace.Printer
+	authenticator     tokenRefresher
+	tokenRefresh      *tokenRefreshState
+	errHandler        apiErrorHandler
+	PollingEnabled    bool
+	PollingThrottle   time.Duration
+	trustedCerts      []tls.Certificate
+	config            coreconfig.Reader
+	warnings          *[]string
+	Clock             func() time.Time
+	RetryDelay        time.Duration
+	MaxRetryDelay     time.Duration
+	Sleep             func(time.Duration)
+	PaginationWorkers int
+	Cache             *ResponseCache
+	transport         *http.Transport
+	ui                terminal.UI
+	logger            trace.Printer
 }
 
 func newGateway(errHandler apiErrorHandler, config coreconfig.Reader, ui terminal.UI, logger trace.Printer) Gateway {
 	return Gateway{
-		errHandler:      errHandler,
-		config:          config,
-		PollingThrottle: DEFAULT_POLLING_THROTTLE,
-		warnings:        &[]string{},
-		Clock:           time.Now,
-		ui:              ui,
-		logger:          logger,
+		errHandler:        errHandler,
+		config:            config,
+		PollingThrottle:   DEFAULT_POLLING_THROTTLE,
+		warnings:          &[]string{},
+		tokenRefresh:      &tokenRefreshState{},
+		Clock:             time.Now,
+		RetryDelay:        DEFAULT_RETRY_DELAY,
+		MaxRetryDelay:     DEFAULT_MAX_RETRY_DELAY,
+		Sleep:             time.Sleep,
+		PaginationWorkers: DEFAULT_PAGINATION_WORKERS,
+		ui:                ui,
+		logger:            logger,
 	}
 }
 
@@ -141,23 +173,39 @@ func (gateway Gateway) DeleteResource(endpoint, apiURL string) error {
 	return gateway.createUpdateOrDeleteResource("DELETE", endpoint, apiURL, nil, false, &AsyncResource{})
 }
 
+// ListPaginatedResources calls cb with every resource of every page, in order,
+// until cb returns false. Once the first page tells how many pages there are,
+// the other pages are fetched concurrently by up to PaginationWorkers workers.
 func (gateway Gateway) ListPaginatedResources(
 	target string,
 	path string,
 	resource interface{},
 	cb func(interface{}) bool,
 ) error {
-	for path != "" {
-		pagination := NewPaginatedResources(resource)
+	pagination, resources, err := gateway.getPage(target, path, resource)
+	if err != nil {
+		return err
+	}
 
-		apiErr := gateway.GetResource(fmt.Sprintf("%s%s", target, path), &pagination)
-		if apiErr != nil {
-			return apiErr
+	for _, resource := range resources {
+		if !cb(resource) {
+			return nil
 		}
+	}
+
+	paths := pagination.RemainingPageURLs()
+	if paths == nil || gateway.PaginationWorkers < 1 {
+		return gateway.listPagesSequentially(target, pagination.NextURL, resource, cb)
+	}
+
+	return gateway.listPagesConcurrently(target, paths, resource, cb)
+}
 
-		resources, err := pagination.Resources()
+func (gateway Gateway) listPagesSequentially(target string, path string, resource interface{}, cb func(interface{}) bool) error {
+	for path != "" {
+		pagination, resources, err := gateway.getPage(target, path, resource)
 		if err != nil {
-			return fmt.Errorf("%s: %s", T("Error parsing JSON"), err.Error())
+			return err
 		}
 
 		for _, resource := range resources {
@@ -172,6 +220,85 @@ func (gateway Gateway) ListPaginatedResources(
 	return nil
 }
 
+type pageResult struct {
+	resources []interface{}
+	err       error
+}
+
+func (gateway Gateway) listPagesConcurrently(target string, paths []string, resource interface{}, cb func(interface{}) bool) error {
+	results := make([]chan pageResult, len(paths))
+	for i := range results {
+		results[i] = make(chan pageResult, 1)
+	}
+
+	// done stops handing out pages once cb returns false or a page fails, and
+	// the pages being fetched are waited for so that no request outlives the
+	// call.
+	var workersRunning sync.WaitGroup
+	defer workersRunning.Wait()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	pages := make(chan int)
+	go func() {
+		defer close(pages)
+		for i := range paths {
+			select {
+			case pages <- i:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	workers := gateway.PaginationWorkers
+	if workers > len(paths) {
+		workers = len(paths)
+	}
+	workersRunning.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer workersRunning.Done()
+			for i := range pages {
+				_, resources, err := gateway.getPage(target, paths[i], resource)
+				results[i] <- pageResult{resources: resources, err: err}
+			}
+		}()
+	}
+
+	for i := range paths {
+		result := <-results[i]
+		if result.err != nil {
+			return result.err
+		}
+
+		for _, resource := range result.resources {
+			if !cb(resource) {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+func (gateway Gateway) getPage(target string, path string, resource interface{}) (PaginatedResources, []interface{}, error) {
+	pagination := NewPaginatedResources(resource)
+
+	apiErr := gateway.GetResource(fmt.Sprintf("%s%s", target, path), &pagination)
+	if apiErr != nil {
+		return pagination, nil, apiErr
+	}
+
+	resources, err := pagination.Resources()
+	if err != nil {
+		return pagination, nil, fmt.Errorf("%s: %s", T("Error parsing JSON"), err.Error())
+	}
+
+	return pagination, resources, nil
+}
+
 func (gateway Gateway) createUpdateOrDeleteResource(verb, endpoint, apiURL string, body io.ReadSeeker, sync bool, optionalResource ...interface{}) error {
 	var resource interface{}
 	if len(optionalResource) > 0 {
@@ -333,6 +460,9 @@ func (gateway Gateway) PerformPollingRequestForJSONResponse(endpoint string, req
 }
 
 func (gateway Gateway) Warnings() []string {
+	warningsMutex.Lock()
+	defer warningsMutex.Unlock()
+
 	return *gateway.warnings
 }
 
@@ -381,7 +511,7 @@ func (gateway Gateway) doRequestHandlingAuth(request *Request) (*http.Response,
 	case *errors.InvalidTokenError:
 		// refresh the auth token
 		var newToken string
-		newToken, err = gateway.authenticator.RefreshAuthToken()
+		newToken, err = gateway.refreshAuthToken(httpReq.Header.Get("Authorization"))
 		if err != nil {
 			return rawResponse, err
 		}
@@ -400,8 +530,29 @@ func (gateway Gateway) doRequestHandlingAuth(request *Request) (*http.Response,
 	return rawResponse, err
 }
 
+// refreshAuthToken refreshes the expired token, unless another request has
+// already done so while this one waited.
+func (gateway Gateway) refreshAuthToken(expiredToken string) (string, error) {
+	refresh := gateway.tokenRefresh
+	refresh.Lock()
+	defer refresh.Unlock()
+
+	if refresh.newToken != "" && refresh.expiredToken == expiredToken {
+		return refresh.newToken, nil
+	}
+
+	newToken, err := gateway.authenticator.RefreshAuthToken()
+	if err != nil {
+		return newToken, err
+	}
+
+	refresh.expiredToken = expiredToken
+	refresh.newToken = newToken
+	return newToken, nil
+}
+
 func (gateway Gateway) doRequestAndHandlerError(request *Request) (*http.Response, error) {
-	rawResponse, err := gateway.doRequest(request.HTTPReq)
+	rawResponse, err := gateway.doRequestCaching(request)
 	if err != nil {
 		return rawResponse, WrapNetworkErrors(request.HTTPReq.URL.Host, err)
 	}
@@ -416,6 +567,180 @@ func (gateway Gateway) doRequestAndHandlerError(request *Request) (*http.Respons
 	return rawResponse, err
 }
 
+// doRequestCaching answers the lookups by name that the cache keeps from the
+// cache when it is enabled, and drops the cached responses that other
+// requests may have changed. All other GETs are always sent, so that polling
+// sees every change.
+func (gateway Gateway) doRequestCaching(request *Request) (*http.Response, error) {
+	httpReq := request.HTTPReq
+	if gateway.Cache == nil {
+		return gateway.doRequestRetrying(request)
+	}
+
+	if httpReq.Method != "GET" {
+		_ = gateway.Cache.invalidate(httpReq)
+		return gateway.doRequestRetrying(request)
+	}
+
+	if !gateway.Cache.cacheable(httpReq) {
+		return gateway.doRequestRetrying(request)
+	}
+
+	entry, fresh := gateway.Cache.get(httpReq)
+	if fresh {
+		gateway.logger.Printf("\n%s %s\n", terminal.HeaderColor(T("CACHED RESPONSE:")), httpReq.URL.String())
+		return entry.response(httpReq), nil
+	}
+
+	if entry != nil {
+		httpReq.Header.Set("If-None-Match", entry.ETag)
+		defer httpReq.Header.Del("If-None-Match")
+	}
+
+	response, err := gateway.doRequestRetrying(request)
+	if err != nil {
+		return response, err
+	}
+
+	switch {
+	case response.StatusCode == http.StatusNotModified && entry != nil:
+		_ = response.Body.Close()
+		_ = gateway.Cache.put(httpReq, entry.StatusCode, entry.Header, entry.Body)
+		return entry.response(httpReq), nil
+	case response.StatusCode == http.StatusOK:
+		body, err := ioutil.ReadAll(response.Body)
+		_ = response.Body.Close()
+		if err != nil {
+			return response, err
+		}
+		response.Body = ioutil.NopCloser(bytes.NewReader(body))
+		_ = gateway.Cache.put(httpReq, response.StatusCode, response.Header, body)
+	}
+
+	return response, nil
+}
+
+// doRequestRetrying retries idempotent requests that fail with a connection
+// error or a 502, 503 or 504 as many times as configured, waiting twice as long
+// before every retry unless the response has a Retry-After header. Requests
+// that could not reach the server are sent at least DEFAULT_CONNECTION_RETRIES
+// more times, without waiting when no retries are configured.
+func (gateway Gateway) doRequestRetrying(request *Request) (*http.Response, error) {
+	retries := gateway.config.RequestRetries()
+	if !isRetryable(request) {
+		retries = 0
+	}
+	connectionRetries := retries
+	if connectionRetries < DEFAULT_CONNECTION_RETRIES {
+		connectionRetries = DEFAULT_CONNECTION_RETRIES
+	}
+
+	delay := gateway.RetryDelay
+	for attempt := uint(1); ; attempt++ {
+		response, err := gateway.doRequest(request.HTTPReq)
+		if !shouldRetry(response, err) {
+			return response, err
+		}
+
+		attempts := retries + 1
+		if response == nil {
+			attempts = connectionRetries + 1
+		}
+		if attempt >= attempts {
+			return response, err
+		}
+
+		var wait time.Duration
+		if attempt <= retries {
+			wait = retryAfter(response, gateway.Clock())
+			if wait < 0 {
+				wait = delay
+				if delay < gateway.MaxRetryDelay {
+					delay *= 2
+				}
+			}
+			if wait > gateway.MaxRetryDelay {
+				wait = gateway.MaxRetryDelay
+			}
+		}
+
+		var reason string
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = response.Status
+			_ = response.Body.Close()
+		}
+		gateway.logger.Printf("\n%s\n", T("RETRYING REQUEST: attempt {{.Attempt}} of {{.Attempts}} in {{.Wait}} after {{.Reason}}",
+			map[string]interface{}{
+				"Attempt":  attempt + 1,
+				"Attempts": attempts,
+				"Wait":     wait,
+				"Reason":   reason,
+			}))
+
+		if wait > 0 {
+			gateway.Sleep(wait)
+		}
+
+		if request.SeekableBody != nil {
+			_, _ = request.SeekableBody.Seek(0, 0)
+			request.HTTPReq.Body = ioutil.NopCloser(request.SeekableBody)
+		}
+	}
+}
+
+// isRetryable is true for requests that can be sent again without changing
+// the outcome: GETs, and PUTs and DELETEs whose body can be sent again.
+func isRetryable(request *Request) bool {
+	switch request.HTTPReq.Method {
+	case "GET", "HEAD":
+		return true
+	case "PUT", "DELETE":
+		return request.HTTPReq.Body == nil || request.SeekableBody != nil
+	}
+	return false
+}
+
+func shouldRetry(response *http.Response, err error) bool {
+	if response == nil {
+		return err != nil
+	}
+
+	switch response.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryAfter returns the wait asked for by the Retry-After header of the
+// response, given in seconds or as a date, or -1 when there is none.
+func retryAfter(response *http.Response, now time.Time) time.Duration {
+	if response == nil {
+		return -1
+	}
+
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return -1
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return -1
+	}
+	if wait := date.Sub(now); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
 func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error) {
 	var response *http.Response
 	var err error
@@ -428,16 +753,9 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 
 	httpClient.DumpRequest(request)
 
-	for i := 0; i < 3; i++ {
-		response, err = httpClient.Do(request)
-		if response == nil && err != nil {
-			continue
-		} else {
-			break
-		}
-	}
-
+	response, err = httpClient.Do(request)
 	if err != nil {
+		httpClient.DumpError(request, err)
 		return response, err
 	}
 
@@ -445,10 +763,12 @@ func (gateway Gateway) doRequest(request *http.Request) (*http.Response, error)
 
 	header := http.CanonicalHeaderKey("X-Cf-Warnings")
 	rawWarnings := response.Header[header]
+	warningsMutex.Lock()
 	for _, rawWarning := range rawWarnings {
 		warning, _ := url.QueryUnescape(rawWarning)
 		*gateway.warnings = append(*gateway.warnings, warning)
 	}
+	warningsMutex.Unlock()
 
 	return response, err
 }
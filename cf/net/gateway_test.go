@@ -15,6 +15,7 @@ import (
 
 	"github.com/cloudfoundry/cli/cf"
 	"github.com/cloudfoundry/cli/cf/api/authentication"
+	"github.com/cloudfoundry/cli/cf/api/authentication/authenticationfakes"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/errors"
 	. "github.com/cloudfoundry/cli/cf/net"
@@ -97,6 +98,54 @@ var _ = Describe("Gateway", func() {
 			Expect(apiErr).To(HaveOccurred())
 			Expect(client.DoCallCount()).To(Equal(3))
 		})
+
+		It("traces every attempt and why it failed", func() {
+			client.DoReturns(nil, errors.New("Connection refused"))
+			request, apiErr := ccGateway.NewRequest("GET", "https://example.com/v2/apps", "BEARER my-access-token", nil)
+			Expect(apiErr).ToNot(HaveOccurred())
+
+			_, apiErr = ccGateway.PerformRequest(request)
+			Expect(apiErr).To(HaveOccurred())
+			Expect(client.DumpRequestCallCount()).To(Equal(3))
+			Expect(client.DumpErrorCallCount()).To(Equal(3))
+		})
+
+		Context("when retries are configured", func() {
+			var (
+				logger *tracefakes.FakePrinter
+				sleeps []time.Duration
+			)
+
+			BeforeEach(func() {
+				config.SetRequestRetries(3)
+				logger = new(tracefakes.FakePrinter)
+				ccGateway = NewCloudControllerGateway(config, clock, &testterm.FakeUI{}, logger)
+				sleeps = []time.Duration{}
+				ccGateway.Sleep = func(d time.Duration) {
+					sleeps = append(sleeps, d)
+				}
+			})
+
+			It("sends the request as many more times as configured, and says so in the trace", func() {
+				client.DoReturns(nil, errors.New("Connection refused"))
+				request, apiErr := ccGateway.NewRequest("GET", "https://example.com/v2/apps", "BEARER my-access-token", nil)
+				Expect(apiErr).ToNot(HaveOccurred())
+
+				_, apiErr = ccGateway.PerformRequest(request)
+				Expect(apiErr).To(HaveOccurred())
+				Expect(client.DoCallCount()).To(Equal(4))
+				Expect(sleeps).To(Equal([]time.Duration{time.Second, 2 * time.Second, 4 * time.Second}))
+
+				traces := []string{}
+				for i := 0; i < logger.PrintfCallCount(); i++ {
+					format, args := logger.PrintfArgsForCall(i)
+					traces = append(traces, fmt.Sprintf(format, args...))
+				}
+				Expect(strings.Join(traces, "")).To(ContainSubstring("RETRYING REQUEST: attempt 2 of 4 in 1s after Connection refused"))
+				Expect(strings.Join(traces, "")).To(ContainSubstring("RETRYING REQUEST: attempt 4 of 4 in 4s after Connection refused"))
+				Expect(strings.Join(traces, "")).NotTo(ContainSubstring("attempt 5"))
+			})
+		})
 	})
 
 	Describe("NewRequest", func() {
@@ -143,6 +192,285 @@ var _ = Describe("Gateway", func() {
 
 	})
 
+	Describe("Retries", func() {
+		var (
+			logger *tracefakes.FakePrinter
+			sleeps []time.Duration
+		)
+
+		BeforeEach(func() {
+			ccServer = ghttp.NewServer()
+			config.SetAPIEndpoint(ccServer.URL())
+			config.SetRequestRetries(3)
+
+			logger = new(tracefakes.FakePrinter)
+			ccGateway = NewCloudControllerGateway(config, clock, &testterm.FakeUI{}, logger)
+			sleeps = []time.Duration{}
+			ccGateway.Sleep = func(d time.Duration) {
+				sleeps = append(sleeps, d)
+			}
+		})
+
+		AfterEach(func() {
+			ccServer.Close()
+		})
+
+		Context("when a GET fails with a 503 and then succeeds", func() {
+			BeforeEach(func() {
+				ccServer.AppendHandlers(
+					ghttp.RespondWith(http.StatusServiceUnavailable, ""),
+					ghttp.RespondWith(http.StatusBadGateway, ""),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/v2/apps"),
+						ghttp.RespondWith(http.StatusOK, `{"guid":"some-guid"}`),
+					),
+				)
+			})
+
+			It("retries with exponential backoff and traces the attempts", func() {
+				request, err := ccGateway.NewRequest("GET", config.APIEndpoint()+"/v2/apps", config.AccessToken(), nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				response := map[string]string{}
+				_, err = ccGateway.PerformRequestForJSONResponse(request, &response)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response["guid"]).To(Equal("some-guid"))
+
+				Expect(ccServer.ReceivedRequests()).To(HaveLen(3))
+				Expect(sleeps).To(Equal([]time.Duration{time.Second, 2 * time.Second}))
+
+				traces := []string{}
+				for i := 0; i < logger.PrintfCallCount(); i++ {
+					format, args := logger.PrintfArgsForCall(i)
+					traces = append(traces, fmt.Sprintf(format, args...))
+				}
+				Expect(strings.Join(traces, "")).To(ContainSubstring("RETRYING REQUEST: attempt 2 of 4 in 1s after 503 Service Unavailable"))
+				Expect(strings.Join(traces, "")).To(ContainSubstring("RETRYING REQUEST: attempt 3 of 4 in 2s after 502 Bad Gateway"))
+			})
+		})
+
+		Context("when the response has a Retry-After header", func() {
+			BeforeEach(func() {
+				ccServer.AppendHandlers(
+					ghttp.RespondWith(http.StatusServiceUnavailable, "", http.Header{"Retry-After": []string{"7"}}),
+					ghttp.RespondWith(http.StatusServiceUnavailable, "", http.Header{"Retry-After": []string{"3600"}}),
+					ghttp.RespondWith(http.StatusOK, "{}"),
+				)
+			})
+
+			It("waits as long as asked, up to the max retry delay", func() {
+				request, err := ccGateway.NewRequest("GET", config.APIEndpoint()+"/v2/apps", config.AccessToken(), nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = ccGateway.PerformRequest(request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sleeps).To(Equal([]time.Duration{7 * time.Second, DEFAULT_MAX_RETRY_DELAY}))
+			})
+		})
+
+		Context("when all attempts fail", func() {
+			BeforeEach(func() {
+				for i := 0; i < 4; i++ {
+					ccServer.AppendHandlers(ghttp.RespondWith(http.StatusServiceUnavailable, ""))
+				}
+			})
+
+			It("returns the error of the last attempt", func() {
+				request, err := ccGateway.NewRequest("GET", config.APIEndpoint()+"/v2/apps", config.AccessToken(), nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = ccGateway.PerformRequest(request)
+				Expect(err).To(HaveOccurred())
+				Expect(ccServer.ReceivedRequests()).To(HaveLen(4))
+			})
+		})
+
+		Context("when a PUT fails", func() {
+			BeforeEach(func() {
+				ccServer.AppendHandlers(
+					ghttp.RespondWith(http.StatusGatewayTimeout, ""),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/v2/apps/some-guid"),
+						ghttp.VerifyJSON(`{"name":"new-name"}`),
+						ghttp.RespondWith(http.StatusCreated, "{}"),
+					),
+				)
+			})
+
+			It("sends the body again", func() {
+				err := ccGateway.UpdateResourceSync(config.APIEndpoint(), "/v2/apps/some-guid", strings.NewReader(`{"name":"new-name"}`))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ccServer.ReceivedRequests()).To(HaveLen(2))
+			})
+		})
+
+		Context("when a POST fails", func() {
+			BeforeEach(func() {
+				ccServer.AppendHandlers(ghttp.RespondWith(http.StatusServiceUnavailable, ""))
+			})
+
+			It("does not retry", func() {
+				err := ccGateway.CreateResource(config.APIEndpoint(), "/v2/apps", strings.NewReader(`{"name":"app"}`))
+				Expect(err).To(HaveOccurred())
+				Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
+				Expect(sleeps).To(BeEmpty())
+			})
+		})
+
+		Context("when retries are not configured", func() {
+			BeforeEach(func() {
+				config.SetRequestRetries(0)
+				ccServer.AppendHandlers(ghttp.RespondWith(http.StatusServiceUnavailable, ""))
+			})
+
+			It("does not retry", func() {
+				request, err := ccGateway.NewRequest("GET", config.APIEndpoint()+"/v2/apps", config.AccessToken(), nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = ccGateway.PerformRequest(request)
+				Expect(err).To(HaveOccurred())
+				Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("ListPaginatedResources", func() {
+		type thing struct {
+			Name string `json:"name"`
+		}
+
+		var names []string
+
+		page := func(number int, delay time.Duration, names ...string) http.HandlerFunc {
+			resources := []string{}
+			for _, name := range names {
+				resources = append(resources, fmt.Sprintf(`{"name":"%s"}`, name))
+			}
+
+			nextURL := ""
+			if number < 4 {
+				nextURL = fmt.Sprintf("/v2/things?order-direction=asc&page=%d&results-per-page=2", number+1)
+			}
+
+			body := fmt.Sprintf(`{"total_pages":4,"next_url":"%s","resources":[%s]}`, nextURL, strings.Join(resources, ","))
+			return func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(delay)
+				_, _ = w.Write([]byte(body))
+			}
+		}
+
+		collect := func(resource interface{}) bool {
+			names = append(names, resource.(thing).Name)
+			return true
+		}
+
+		BeforeEach(func() {
+			ccServer = ghttp.NewServer()
+			config.SetAPIEndpoint(ccServer.URL())
+			ccGateway = NewCloudControllerGateway(config, clock, &testterm.FakeUI{}, new(tracefakes.FakePrinter))
+			names = []string{}
+
+			ccServer.RouteToHandler("GET", "/v2/things", func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Query().Get("page") {
+				case "":
+					page(1, 0, "a", "b")(w, r)
+				case "2":
+					page(2, 50*time.Millisecond, "c", "d")(w, r)
+				case "3":
+					page(3, 0, "e", "f")(w, r)
+				case "4":
+					page(4, 10*time.Millisecond, "g")(w, r)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+		})
+
+		AfterEach(func() {
+			ccServer.Close()
+		})
+
+		It("fetches the remaining pages concurrently and calls back in order", func() {
+			err := ccGateway.ListPaginatedResources(ccServer.URL(), "/v2/things", thing{}, collect)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names).To(Equal([]string{"a", "b", "c", "d", "e", "f", "g"}))
+			Expect(ccServer.ReceivedRequests()).To(HaveLen(4))
+		})
+
+		It("stops calling back when the callback returns false", func() {
+			err := ccGateway.ListPaginatedResources(ccServer.URL(), "/v2/things", thing{}, func(resource interface{}) bool {
+				collect(resource)
+				return len(names) < 3
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names).To(Equal([]string{"a", "b", "c"}))
+		})
+
+		It("follows the next URLs one by one when concurrency is disabled", func() {
+			ccGateway.PaginationWorkers = 0
+
+			err := ccGateway.ListPaginatedResources(ccServer.URL(), "/v2/things", thing{}, collect)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names).To(Equal([]string{"a", "b", "c", "d", "e", "f", "g"}))
+		})
+
+		Context("when the token expires while the pages are fetched", func() {
+			var refresher *authenticationfakes.FakeTokenRefresher
+
+			BeforeEach(func() {
+				ccServer.RouteToHandler("GET", "/v2/things", func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Query().Get("page") == "" {
+						page(1, 0, "a", "b")(w, r)
+						return
+					}
+					if r.Header.Get("Authorization") != "bearer new-token" {
+						w.WriteHeader(http.StatusUnauthorized)
+						_, _ = w.Write([]byte(`{"code":1000,"description":"Auth token is invalid"}`))
+						return
+					}
+					page(2, 0, "x")(w, r)
+				})
+
+				refresher = new(authenticationfakes.FakeTokenRefresher)
+				refresher.RefreshAuthTokenStub = func() (string, error) {
+					time.Sleep(20 * time.Millisecond)
+					return "bearer new-token", nil
+				}
+				ccGateway.SetTokenRefresher(refresher)
+			})
+
+			It("refreshes the token once", func() {
+				err := ccGateway.ListPaginatedResources(ccServer.URL(), "/v2/things", thing{}, collect)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(names).To(Equal([]string{"a", "b", "x", "x", "x"}))
+				Expect(refresher.RefreshAuthTokenCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when a page fails", func() {
+			BeforeEach(func() {
+				ccServer.RouteToHandler("GET", "/v2/things", func(w http.ResponseWriter, r *http.Request) {
+					switch r.URL.Query().Get("page") {
+					case "":
+						page(1, 0, "a", "b")(w, r)
+					case "3":
+						w.WriteHeader(http.StatusInternalServerError)
+						_, _ = w.Write([]byte(`{"code":10001,"description":"page 3 failed"}`))
+					default:
+						page(2, 0, "x")(w, r)
+					}
+				})
+			})
+
+			It("returns the error after calling back with the pages before it", func() {
+				err := ccGateway.ListPaginatedResources(ccServer.URL(), "/v2/things", thing{}, collect)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("page 3 failed"))
+				Expect(names).To(Equal([]string{"a", "b", "x"}))
+			})
+		})
+	})
+
 	Describe("PerformRequestForJSONResponse()", func() {
 		BeforeEach(func() {
 			ccServer = ghttp.NewServer()
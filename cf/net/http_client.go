@@ -73,6 +73,10 @@ func (cl *client) DumpResponse(res *http.Response) {
 	cl.dumper.DumpResponse(res)
 }
 
+func (cl *client) DumpError(req *http.Request, err error) {
+	cl.dumper.DumpError(req, err)
+}
+
 func WrapNetworkErrors(host string, err error) error {
 	var innerErr error
 	switch typedErr := err.(type) {
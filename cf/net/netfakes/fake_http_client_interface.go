@@ -20,6 +20,12 @@ type FakeHTTPClientInterface struct {
 	dumpResponseArgsForCall []struct {
 		arg1 *http.Response
 	}
+	DumpErrorStub        func(*http.Request, error)
+	dumpErrorMutex       sync.RWMutex
+	dumpErrorArgsForCall []struct {
+		arg1 *http.Request
+		arg2 error
+	}
 	DoStub        func(*http.Request) (*http.Response, error)
 	doMutex       sync.RWMutex
 	doArgsForCall []struct {
@@ -86,6 +92,30 @@ func (fake *FakeHTTPClientInterface) DumpResponseArgsForCall(i int) *http.Respon
 	return fake.dumpResponseArgsForCall[i].arg1
 }
 
+func (fake *FakeHTTPClientInterface) DumpError(arg1 *http.Request, arg2 error) {
+	fake.dumpErrorMutex.Lock()
+	fake.dumpErrorArgsForCall = append(fake.dumpErrorArgsForCall, struct {
+		arg1 *http.Request
+		arg2 error
+	}{arg1, arg2})
+	fake.dumpErrorMutex.Unlock()
+	if fake.DumpErrorStub != nil {
+		fake.DumpErrorStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeHTTPClientInterface) DumpErrorCallCount() int {
+	fake.dumpErrorMutex.RLock()
+	defer fake.dumpErrorMutex.RUnlock()
+	return len(fake.dumpErrorArgsForCall)
+}
+
+func (fake *FakeHTTPClientInterface) DumpErrorArgsForCall(i int) (*http.Request, error) {
+	fake.dumpErrorMutex.RLock()
+	defer fake.dumpErrorMutex.RUnlock()
+	return fake.dumpErrorArgsForCall[i].arg1, fake.dumpErrorArgsForCall[i].arg2
+}
+
 func (fake *FakeHTTPClientInterface) Do(arg1 *http.Request) (*http.Response, error) {
 	fake.doMutex.Lock()
 	fake.doArgsForCall = append(fake.doArgsForCall, struct {
@@ -19,6 +19,12 @@ type FakeRequestDumperInterface struct {
 	dumpResponseArgsForCall []struct {
 		arg1 *http.Response
 	}
+	DumpErrorStub        func(*http.Request, error)
+	dumpErrorMutex       sync.RWMutex
+	dumpErrorArgsForCall []struct {
+		arg1 *http.Request
+		arg2 error
+	}
 }
 
 func (fake *FakeRequestDumperInterface) DumpRequest(arg1 *http.Request) {
@@ -67,4 +73,28 @@ func (fake *FakeRequestDumperInterface) DumpResponseArgsForCall(i int) *http.Res
 	return fake.dumpResponseArgsForCall[i].arg1
 }
 
+func (fake *FakeRequestDumperInterface) DumpError(arg1 *http.Request, arg2 error) {
+	fake.dumpErrorMutex.Lock()
+	fake.dumpErrorArgsForCall = append(fake.dumpErrorArgsForCall, struct {
+		arg1 *http.Request
+		arg2 error
+	}{arg1, arg2})
+	fake.dumpErrorMutex.Unlock()
+	if fake.DumpErrorStub != nil {
+		fake.DumpErrorStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeRequestDumperInterface) DumpErrorCallCount() int {
+	fake.dumpErrorMutex.RLock()
+	defer fake.dumpErrorMutex.RUnlock()
+	return len(fake.dumpErrorArgsForCall)
+}
+
+func (fake *FakeRequestDumperInterface) DumpErrorArgsForCall(i int) (*http.Request, error) {
+	fake.dumpErrorMutex.RLock()
+	defer fake.dumpErrorMutex.RUnlock()
+	return fake.dumpErrorArgsForCall[i].arg1, fake.dumpErrorArgsForCall[i].arg2
+}
+
 var _ net.RequestDumperInterface = new(FakeRequestDumperInterface)
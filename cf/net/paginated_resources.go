@@ -2,7 +2,9 @@ package net
 
 import (
 	"encoding/json"
+	"net/url"
 	"reflect"
+	"strconv"
 )
 
 func NewPaginatedResources(exampleResource interface{}) PaginatedResources {
@@ -12,6 +14,7 @@ func NewPaginatedResources(exampleResource interface{}) PaginatedResources {
 }
 
 type PaginatedResources struct {
+	TotalPages     int             `json:"total_pages"`
 	NextURL        string          `json:"next_url"`
 	ResourcesBytes json.RawMessage `json:"resources"`
 	resourceType   reflect.Type
@@ -28,3 +31,31 @@ func (pr PaginatedResources) Resources() ([]interface{}, error) {
 	}
 	return contents, err
 }
+
+// RemainingPageURLs returns the URLs of the pages after this one, built from
+// the next URL and the total number of pages. It returns nil when the next URL
+// does not give a page number, and the pages have to be followed one by one.
+func (pr PaginatedResources) RemainingPageURLs() []string {
+	if pr.NextURL == "" {
+		return []string{}
+	}
+
+	nextURL, err := url.Parse(pr.NextURL)
+	if err != nil {
+		return nil
+	}
+
+	query := nextURL.Query()
+	nextPage, err := strconv.Atoi(query.Get("page"))
+	if err != nil || nextPage < 1 || nextPage > pr.TotalPages {
+		return nil
+	}
+
+	urls := []string{pr.NextURL}
+	for page := nextPage + 1; page <= pr.TotalPages; page++ {
+		query.Set("page", strconv.Itoa(page))
+		nextURL.RawQuery = query.Encode()
+		urls = append(urls, nextURL.String())
+	}
+	return urls
+}
@@ -0,0 +1,45 @@
+package net_test
+
+import (
+	"encoding/json"
+
+	. "github.com/cloudfoundry/cli/cf/net"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PaginatedResources", func() {
+	Describe("RemainingPageURLs", func() {
+		parse := func(body string) PaginatedResources {
+			pagination := NewPaginatedResources(struct{}{})
+			err := json.Unmarshal([]byte(body), &pagination)
+			Expect(err).NotTo(HaveOccurred())
+			return pagination
+		}
+
+		It("returns the URLs of the pages after the next one", func() {
+			pagination := parse(`{"total_pages":4,"next_url":"/v2/apps?page=2&results-per-page=50"}`)
+			Expect(pagination.RemainingPageURLs()).To(Equal([]string{
+				"/v2/apps?page=2&results-per-page=50",
+				"/v2/apps?page=3&results-per-page=50",
+				"/v2/apps?page=4&results-per-page=50",
+			}))
+		})
+
+		It("returns no URLs on the last page", func() {
+			pagination := parse(`{"total_pages":1,"next_url":null}`)
+			Expect(pagination.RemainingPageURLs()).To(BeEmpty())
+			Expect(pagination.RemainingPageURLs()).NotTo(BeNil())
+		})
+
+		It("returns nil when the next URL has no page number", func() {
+			pagination := parse(`{"total_pages":4,"next_url":"/v2/apps?token=abc"}`)
+			Expect(pagination.RemainingPageURLs()).To(BeNil())
+		})
+
+		It("returns nil when the total number of pages is unknown", func() {
+			pagination := parse(`{"next_url":"/v2/apps?page=2"}`)
+			Expect(pagination.RemainingPageURLs()).To(BeNil())
+		})
+	})
+})
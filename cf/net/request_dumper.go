@@ -1,9 +1,14 @@
 package net
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
 	"net/http"
 	"net/http/httputil"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	. "github.com/cloudfoundry/cli/cf/i18n"
@@ -16,12 +21,36 @@ import (
 type RequestDumperInterface interface {
 	DumpRequest(*http.Request)
 	DumpResponse(*http.Response)
+	DumpError(*http.Request, error)
 }
 
 type RequestDumper struct {
 	printer trace.Printer
 }
 
+// tracedRequest is what is remembered of a dumped request until its response
+// is dumped. It is kept in the context of the request.
+type tracedRequest struct {
+	id      string
+	started time.Time
+}
+
+type tracedRequestKey struct{}
+
+// Every request of an invocation gets an ID made of the invocation ID and a
+// counter, so that requests and responses can be matched in the trace, also
+// when traces of several invocations are in the same file.
+var (
+	invocationID        = newInvocationID()
+	tracedRequestsCount int64
+)
+
+func newInvocationID() string {
+	bytes := make([]byte, 4)
+	_, _ = rand.Read(bytes)
+	return hex.EncodeToString(bytes)
+}
+
 func NewRequestDumper(printer trace.Printer) RequestDumper {
 	return RequestDumper{printer: printer}
 }
@@ -32,7 +61,7 @@ func (p RequestDumper) DumpRequest(req *http.Request) {
 	if err != nil {
 		p.printer.Printf(T("Error dumping request\n{{.Err}}\n", map[string]interface{}{"Err": err}))
 	} else {
-		p.printer.Printf("\n%s [%s]\n%s\n", terminal.HeaderColor(T("REQUEST:")), time.Now().Format(time.RFC3339), trace.Sanitize(string(dumpedRequest)))
+		p.printer.Printf("\n%s [%s] [%s]\n%s\n", terminal.HeaderColor(T("REQUEST:")), time.Now().Format(time.RFC3339), T("ID: {{.ID}}", map[string]interface{}{"ID": startTrace(req)}), trace.Sanitize(string(dumpedRequest)))
 		if !shouldDisplayBody {
 			p.printer.Println(T("[MULTIPART/FORM-DATA CONTENT HIDDEN]"))
 		}
@@ -44,6 +73,39 @@ func (p RequestDumper) DumpResponse(res *http.Response) {
 	if err != nil {
 		p.printer.Printf(T("Error dumping response\n{{.Err}}\n", map[string]interface{}{"Err": err}))
 	} else {
-		p.printer.Printf("\n%s [%s]\n%s\n", terminal.HeaderColor(T("RESPONSE:")), time.Now().Format(time.RFC3339), trace.Sanitize(string(dumpedResponse)))
+		p.printer.Printf("\n%s [%s]%s\n%s\n", terminal.HeaderColor(T("RESPONSE:")), time.Now().Format(time.RFC3339), finishTrace(res.Request), trace.Sanitize(string(dumpedResponse)))
+	}
+}
+
+// DumpError traces a request that got no response, so that its ID is not
+// left waiting for one.
+func (p RequestDumper) DumpError(req *http.Request, err error) {
+	p.printer.Printf("\n%s [%s]%s\n%s\n", terminal.HeaderColor(T("REQUEST FAILED:")), time.Now().Format(time.RFC3339), finishTrace(req), err.Error())
+}
+
+// startTrace stores the ID and start of the request in its context. The
+// request is replaced in place, because neither the gateway nor the
+// redirect check of the client can send a copy instead.
+func startTrace(req *http.Request) string {
+	id := fmt.Sprintf("%s-%d", invocationID, atomic.AddInt64(&tracedRequestsCount, 1))
+	*req = *req.WithContext(context.WithValue(req.Context(), tracedRequestKey{}, tracedRequest{id: id, started: time.Now()}))
+
+	return id
+}
+
+// finishTrace returns the ID and duration of the request, or nothing when
+// the request was not dumped.
+func finishTrace(req *http.Request) string {
+	if req == nil {
+		return ""
+	}
+
+	traced, ok := req.Context().Value(tracedRequestKey{}).(tracedRequest)
+	if !ok {
+		return ""
 	}
+
+	return fmt.Sprintf(" [%s] [%s]",
+		T("ID: {{.ID}}", map[string]interface{}{"ID": traced.id}),
+		T("ELAPSED: {{.Duration}}", map[string]interface{}{"Duration": time.Since(traced.started).Round(time.Millisecond)}))
 }
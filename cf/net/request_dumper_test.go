@@ -0,0 +1,96 @@
+package net_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+
+	. "github.com/cloudfoundry/cli/cf/net"
+	"github.com/cloudfoundry/cli/cf/trace"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RequestDumper", func() {
+	var (
+		output *bytes.Buffer
+		dumper RequestDumper
+	)
+
+	BeforeEach(func() {
+		output = new(bytes.Buffer)
+		dumper = NewRequestDumper(trace.NewWriterPrinter(output, false))
+	})
+
+	newResponse := func(req *http.Request) *http.Response {
+		return &http.Response{
+			Status:     "200 OK",
+			StatusCode: http.StatusOK,
+			Proto:      "HTTP/1.1",
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"guid":"some-guid"}`)),
+			Request:    req,
+		}
+	}
+
+	It("gives every request an ID and shows it with the elapsed time on the response", func() {
+		firstReq, err := http.NewRequest("GET", "https://api.example.com/v2/apps", nil)
+		Expect(err).NotTo(HaveOccurred())
+		secondReq, err := http.NewRequest("GET", "https://api.example.com/v2/spaces", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		dumper.DumpRequest(firstReq)
+		dumper.DumpRequest(secondReq)
+		dumper.DumpResponse(newResponse(secondReq))
+		dumper.DumpResponse(newResponse(firstReq))
+
+		requestIDs := regexp.MustCompile(`REQUEST: \[[^\]]+\] \[ID: ([0-9a-f]+-\d+)\]`).FindAllStringSubmatch(output.String(), -1)
+		Expect(requestIDs).To(HaveLen(2))
+		Expect(requestIDs[0][1]).NotTo(Equal(requestIDs[1][1]))
+
+		responseIDs := regexp.MustCompile(`RESPONSE: \[[^\]]+\] \[ID: ([0-9a-f]+-\d+)\] \[ELAPSED: [0-9.]+m?s\]`).FindAllStringSubmatch(output.String(), -1)
+		Expect(responseIDs).To(HaveLen(2))
+		Expect(responseIDs[0][1]).To(Equal(requestIDs[1][1]))
+		Expect(responseIDs[1][1]).To(Equal(requestIDs[0][1]))
+	})
+
+	It("traces requests that failed with their ID", func() {
+		req, err := http.NewRequest("GET", "https://api.example.com/v2/apps", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		dumper.DumpRequest(req)
+		dumper.DumpError(req, errors.New("connection reset by peer"))
+
+		requestID := regexp.MustCompile(`REQUEST: \[[^\]]+\] \[ID: ([0-9a-f]+-\d+)\]`).FindStringSubmatch(output.String())
+		Expect(requestID).To(HaveLen(2))
+		Expect(output.String()).To(MatchRegexp(`REQUEST FAILED: \[[^\]]+\] \[ID: %s\] \[ELAPSED: [0-9.]+m?s\]\nconnection reset by peer`, requestID[1]))
+	})
+
+	It("finds the ID of responses to a copy of the dumped request", func() {
+		req, err := http.NewRequest("GET", "https://api.example.com/v2/apps", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		dumper.DumpRequest(req)
+		copied := *req
+		dumper.DumpResponse(newResponse(&copied))
+
+		requestID := regexp.MustCompile(`REQUEST: \[[^\]]+\] \[ID: ([0-9a-f]+-\d+)\]`).FindStringSubmatch(output.String())
+		Expect(requestID).To(HaveLen(2))
+		Expect(output.String()).To(MatchRegexp(`RESPONSE: \[[^\]]+\] \[ID: %s\] \[ELAPSED: [0-9.]+m?s\]`, requestID[1]))
+	})
+
+	It("dumps responses to requests that were not dumped without an ID", func() {
+		req, err := http.NewRequest("GET", "https://api.example.com/v2/apps", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		dumper.DumpResponse(newResponse(req))
+
+		Expect(output.String()).To(MatchRegexp(`RESPONSE: \[[^\]]+\]\n`))
+		Expect(output.String()).To(ContainSubstring(`{"guid":"some-guid"}`))
+	})
+})
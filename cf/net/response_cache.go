@@ -0,0 +1,235 @@
+package net
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const cacheEntryExtension = ".json"
+
+var apiVersionRegexp = regexp.MustCompile(`^v[0-9]+$`)
+
+// cacheablePathRegexp matches the collections whose lookups by name are
+// cached: organizations, spaces, domains and stacks. Nothing that commands
+// poll for changes, such as jobs, apps, instances, stats or summaries, may
+// be added here.
+var cacheablePathRegexp = regexp.MustCompile(`^/v2/(organizations|spaces|organizations/[^/]+/spaces|domains|shared_domains|private_domains|organizations/[^/]+/(domains|private_domains)|stacks)$`)
+
+// domainResourceTypes are invalidated together, because /v2/domains lists
+// both shared and private domains and either of them can be looked up
+// through the other collections.
+var domainResourceTypes = []string{"domains", "shared_domains", "private_domains"}
+
+// ResponseCache keeps successful responses to lookups of organizations,
+// spaces, domains and stacks by name on disk, so that the same lookups made by
+// successive commands are not sent again. Entries are keyed by URL and access
+// token, are fresh for the TTL and are revalidated with their ETag afterwards.
+// Any other request drops the entries of the resource types in its path, so
+// /v2/organizations/guid/spaces?q=name:x is dropped by a PUT /v2/spaces/guid.
+type ResponseCache struct {
+	dir   string
+	ttl   time.Duration
+	clock func() time.Time
+}
+
+type cacheEntry struct {
+	URL        string
+	StoredAt   time.Time
+	ETag       string
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+func NewResponseCache(dir string, ttl time.Duration, clock func() time.Time) *ResponseCache {
+	return &ResponseCache{
+		dir:   dir,
+		ttl:   ttl,
+		clock: clock,
+	}
+}
+
+// cacheable is true for GET requests that look up one of the cached resource
+// types by name.
+func (cache *ResponseCache) cacheable(request *http.Request) bool {
+	if request.Method != "GET" || !cacheablePathRegexp.MatchString(request.URL.Path) {
+		return false
+	}
+
+	for _, filter := range request.URL.Query()["q"] {
+		if strings.HasPrefix(filter, "name:") {
+			return true
+		}
+	}
+	return false
+}
+
+// get returns the entry for the request, and whether it is still fresh. An
+// expired entry is only returned when it can be revalidated with its ETag.
+func (cache *ResponseCache) get(request *http.Request) (*cacheEntry, bool) {
+	data, err := ioutil.ReadFile(cache.path(request))
+	if err != nil {
+		return nil, false
+	}
+
+	entry := &cacheEntry{}
+	err = json.Unmarshal(data, entry)
+	if err != nil || entry.URL != request.URL.String() {
+		return nil, false
+	}
+
+	if cache.clock().Sub(entry.StoredAt) < cache.ttl {
+		return entry, true
+	}
+
+	if entry.ETag == "" {
+		_ = os.Remove(cache.path(request))
+		return nil, false
+	}
+
+	return entry, false
+}
+
+// put stores the response to the request, replacing any previous entry.
+func (cache *ResponseCache) put(request *http.Request, statusCode int, header http.Header, body []byte) error {
+	data, err := json.Marshal(cacheEntry{
+		URL:        request.URL.String(),
+		StoredAt:   cache.clock(),
+		ETag:       header.Get("ETag"),
+		StatusCode: statusCode,
+		Header:     header,
+		Body:       body,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(cache.dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	// Entries are renamed into place so that concurrent commands never read a
+	// partially written entry.
+	file, err := ioutil.TempFile(cache.dir, "tmp-")
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), cache.path(request))
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+	}
+
+	return err
+}
+
+// invalidate removes the entries that share a resource type with the request,
+// along with the domain collections that list the same domains and, when an
+// organization is deleted, the spaces that are deleted with it.
+func (cache *ResponseCache) invalidate(request *http.Request) error {
+	files, err := ioutil.ReadDir(cache.dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	types := map[string]bool{}
+	requestTypes := resourceTypes(request.URL.Path)
+	for _, resourceType := range requestTypes {
+		types[resourceType] = true
+	}
+	for _, domainType := range domainResourceTypes {
+		if types[domainType] {
+			for _, other := range domainResourceTypes {
+				types[other] = true
+			}
+			break
+		}
+	}
+	if request.Method == "DELETE" && len(requestTypes) > 0 && requestTypes[len(requestTypes)-1] == "organizations" {
+		types["spaces"] = true
+	}
+
+	for _, file := range files {
+		name := strings.TrimSuffix(file.Name(), cacheEntryExtension)
+		separator := strings.LastIndex(name, "-")
+		if name == file.Name() || separator < 0 {
+			continue
+		}
+
+		for _, resourceType := range strings.Split(name[:separator], ",") {
+			if types[resourceType] {
+				_ = os.Remove(filepath.Join(cache.dir, file.Name()))
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+func (entry *cacheEntry) response(request *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(entry.StatusCode),
+		StatusCode:    entry.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        entry.Header,
+		Body:          ioutil.NopCloser(bytes.NewReader(entry.Body)),
+		ContentLength: int64(len(entry.Body)),
+		Request:       request,
+	}
+}
+
+// path names the entry file after the resource types of the request, so that
+// invalidate does not need to read the entries, and a hash of the URL and
+// access token, so that users never see each other's responses.
+func (cache *ResponseCache) path(request *http.Request) string {
+	hash := sha256.Sum256([]byte(request.Header.Get("Authorization") + "\n" + request.URL.String()))
+	name := strings.Join(resourceTypes(request.URL.Path), ",") + "-" + hex.EncodeToString(hash[:]) + cacheEntryExtension
+	return filepath.Join(cache.dir, name)
+}
+
+// resourceTypes returns the collections named in an API path, which alternate
+// with GUIDs after the version: /v2/spaces/guid/routes has spaces and routes.
+func resourceTypes(path string) []string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if apiVersionRegexp.MatchString(segments[0]) {
+		segments = segments[1:]
+	}
+
+	types := []string{}
+	for i := 0; i < len(segments); i += 2 {
+		resourceType := strings.Map(func(r rune) rune {
+			if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_' {
+				return r
+			}
+			return -1
+		}, strings.ToLower(segments[i]))
+
+		if resourceType != "" {
+			types = append(types, resourceType)
+		}
+	}
+	return types
+}
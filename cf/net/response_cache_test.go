@@ -0,0 +1,246 @@
+package net_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	. "github.com/cloudfoundry/cli/cf/net"
+	"github.com/cloudfoundry/cli/cf/trace/tracefakes"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ResponseCache", func() {
+	var (
+		ccServer    *ghttp.Server
+		ccGateway   Gateway
+		config      coreconfig.ReadWriter
+		cacheDir    string
+		currentTime time.Time
+	)
+
+	get := func(path string, token string) string {
+		request, err := ccGateway.NewRequest("GET", config.APIEndpoint()+path, token, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		body, _, err := ccGateway.PerformRequestForTextResponse(request)
+		Expect(err).NotTo(HaveOccurred())
+		return body
+	}
+
+	BeforeEach(func() {
+		var err error
+		cacheDir, err = ioutil.TempDir("", "http-cache")
+		Expect(err).NotTo(HaveOccurred())
+
+		ccServer = ghttp.NewServer()
+		config = testconfig.NewRepository()
+		config.SetAPIEndpoint(ccServer.URL())
+
+		currentTime = time.Unix(1000, 0)
+		ccGateway = NewCloudControllerGateway(config, time.Now, &testterm.FakeUI{}, new(tracefakes.FakePrinter))
+		ccGateway.Cache = NewResponseCache(cacheDir, 30*time.Second, func() time.Time { return currentTime })
+	})
+
+	AfterEach(func() {
+		ccServer.Close()
+		_ = os.RemoveAll(cacheDir)
+	})
+
+	It("answers repeated GET requests from the cache until the TTL is over", func() {
+		ccServer.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, `{"name":"first"}`),
+			ghttp.RespondWith(http.StatusOK, `{"name":"second"}`),
+		)
+
+		Expect(get("/v2/organizations?q=name:my-org", "BEARER token")).To(Equal(`{"name":"first"}`))
+		currentTime = currentTime.Add(29 * time.Second)
+		Expect(get("/v2/organizations?q=name:my-org", "BEARER token")).To(Equal(`{"name":"first"}`))
+		Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
+
+		currentTime = currentTime.Add(time.Second)
+		Expect(get("/v2/organizations?q=name:my-org", "BEARER token")).To(Equal(`{"name":"second"}`))
+		Expect(ccServer.ReceivedRequests()).To(HaveLen(2))
+	})
+
+	It("does not share responses between tokens", func() {
+		ccServer.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, `{"name":"first"}`),
+			ghttp.RespondWith(http.StatusOK, `{"name":"second"}`),
+		)
+
+		Expect(get("/v2/stacks?q=name:cflinuxfs2", "BEARER token")).To(Equal(`{"name":"first"}`))
+		Expect(get("/v2/stacks?q=name:cflinuxfs2", "BEARER other-token")).To(Equal(`{"name":"second"}`))
+	})
+
+	It("does not cache errors", func() {
+		ccServer.AppendHandlers(
+			ghttp.RespondWith(http.StatusNotFound, `{"code":10000,"description":"not found"}`),
+			ghttp.RespondWith(http.StatusOK, `{"name":"found"}`),
+		)
+
+		request, err := ccGateway.NewRequest("GET", config.APIEndpoint()+"/v2/stacks?q=name:cflinuxfs2", "BEARER token", nil)
+		Expect(err).NotTo(HaveOccurred())
+		_, _, err = ccGateway.PerformRequestForTextResponse(request)
+		Expect(err).To(HaveOccurred())
+
+		Expect(get("/v2/stacks?q=name:cflinuxfs2", "BEARER token")).To(Equal(`{"name":"found"}`))
+	})
+
+	Context("when an expired response has an ETag", func() {
+		BeforeEach(func() {
+			ccServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusOK, `{"name":"cached"}`, http.Header{"ETag": []string{`"v1"`}}),
+				ghttp.CombineHandlers(
+					ghttp.VerifyHeader(http.Header{"If-None-Match": []string{`"v1"`}}),
+					ghttp.RespondWith(http.StatusNotModified, ""),
+				),
+			)
+		})
+
+		It("revalidates it and keeps using it while it is not modified", func() {
+			Expect(get("/v2/shared_domains?q=name:example.com", "BEARER token")).To(Equal(`{"name":"cached"}`))
+			currentTime = currentTime.Add(time.Minute)
+			Expect(get("/v2/shared_domains?q=name:example.com", "BEARER token")).To(Equal(`{"name":"cached"}`))
+			Expect(get("/v2/shared_domains?q=name:example.com", "BEARER token")).To(Equal(`{"name":"cached"}`))
+			Expect(ccServer.ReceivedRequests()).To(HaveLen(2))
+		})
+	})
+
+	Context("when a request changes a resource", func() {
+		BeforeEach(func() {
+			ccServer.RouteToHandler("GET", "/v2/organizations/org-guid/spaces", ghttp.RespondWith(http.StatusOK, `{"resources":[]}`))
+			ccServer.RouteToHandler("GET", "/v2/stacks", ghttp.RespondWith(http.StatusOK, `{"resources":[]}`))
+			ccServer.RouteToHandler("PUT", "/v2/spaces/space-guid", ghttp.RespondWith(http.StatusCreated, `{}`))
+			ccServer.RouteToHandler("GET", "/v2/spaces", ghttp.RespondWith(http.StatusOK, `{"resources":[]}`))
+			ccServer.RouteToHandler("GET", "/v2/domains", ghttp.RespondWith(http.StatusOK, `{"resources":[]}`))
+			ccServer.RouteToHandler("POST", "/v2/shared_domains", ghttp.RespondWith(http.StatusCreated, `{}`))
+			ccServer.RouteToHandler("DELETE", "/v2/organizations/org-guid", ghttp.RespondWith(http.StatusNoContent, ""))
+
+			get("/v2/organizations/org-guid/spaces?q=name:my-space", "BEARER token")
+			get("/v2/stacks?q=name:cflinuxfs2", "BEARER token")
+		})
+
+		receivedPaths := func() []string {
+			paths := []string{}
+			for _, request := range ccServer.ReceivedRequests() {
+				paths = append(paths, request.Method+" "+request.URL.Path)
+			}
+			return paths
+		}
+
+		It("drops the cached responses of the same resource type", func() {
+			err := ccGateway.UpdateResource(config.APIEndpoint(), "/v2/spaces/space-guid", strings.NewReader(`{"name":"new-space"}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			get("/v2/organizations/org-guid/spaces?q=name:my-space", "BEARER token")
+			get("/v2/stacks?q=name:cflinuxfs2", "BEARER token")
+
+			Expect(receivedPaths()).To(Equal([]string{
+				"GET /v2/organizations/org-guid/spaces",
+				"GET /v2/stacks",
+				"PUT /v2/spaces/space-guid",
+				"GET /v2/organizations/org-guid/spaces",
+			}))
+		})
+
+		It("drops the cached domains when a shared domain is created", func() {
+			get("/v2/domains?q=name:example.com", "BEARER token")
+
+			err := ccGateway.CreateResource(config.APIEndpoint(), "/v2/shared_domains", strings.NewReader(`{"name":"example.com"}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			get("/v2/domains?q=name:example.com", "BEARER token")
+
+			Expect(receivedPaths()[2:]).To(Equal([]string{
+				"GET /v2/domains",
+				"POST /v2/shared_domains",
+				"GET /v2/domains",
+			}))
+		})
+
+		It("drops the cached spaces when an organization is deleted", func() {
+			get("/v2/spaces?q=name:my-space", "BEARER token")
+
+			err := ccGateway.DeleteResourceSynchronously(config.APIEndpoint(), "/v2/organizations/org-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			get("/v2/spaces?q=name:my-space", "BEARER token")
+			get("/v2/stacks?q=name:cflinuxfs2", "BEARER token")
+
+			Expect(receivedPaths()[2:]).To(Equal([]string{
+				"GET /v2/spaces",
+				"DELETE /v2/organizations/org-guid",
+				"GET /v2/spaces",
+			}))
+		})
+	})
+
+	It("only caches lookups of organizations, spaces, domains and stacks by name", func() {
+		for _, path := range []string{
+			"/v2/stacks",
+			"/v2/apps?q=name:my-app",
+			"/v2/spaces/space-guid/summary",
+			"/v2/apps/app-guid/stats",
+		} {
+			ccServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusOK, `{"name":"first"}`),
+				ghttp.RespondWith(http.StatusOK, `{"name":"second"}`),
+			)
+
+			Expect(get(path, "BEARER token")).To(Equal(`{"name":"first"}`))
+			Expect(get(path, "BEARER token")).To(Equal(`{"name":"second"}`))
+		}
+	})
+
+	It("lets cf start see the app and its instances change while it polls", func() {
+		ccServer.AppendHandlers(
+			ghttp.RespondWith(http.StatusOK, `{"entity":{"state":"STOPPED","package_state":"PENDING"}}`),
+			ghttp.RespondWith(http.StatusOK, `{"entity":{"state":"STARTED","package_state":"STAGED"}}`),
+			ghttp.RespondWith(http.StatusOK, `{"0":{"state":"STARTING"}}`),
+			ghttp.RespondWith(http.StatusOK, `{"0":{"state":"RUNNING"}}`),
+		)
+
+		Expect(get("/v2/apps/app-guid", "BEARER token")).To(ContainSubstring("PENDING"))
+		Expect(get("/v2/apps/app-guid", "BEARER token")).To(ContainSubstring("STAGED"))
+		Expect(get("/v2/apps/app-guid/instances", "BEARER token")).To(ContainSubstring("STARTING"))
+		Expect(get("/v2/apps/app-guid/instances", "BEARER token")).To(ContainSubstring("RUNNING"))
+		Expect(ccServer.ReceivedRequests()).To(HaveLen(4))
+	})
+
+	It("lets jobs be polled until they finish", func() {
+		ccGateway.PollingEnabled = true
+		ccGateway.PollingThrottle = time.Millisecond
+		config.SetAsyncTimeout(1)
+		pollTime := time.Unix(0, 0)
+		ccGateway.Clock = func() time.Time {
+			pollTime = pollTime.Add(time.Second)
+			return pollTime
+		}
+		ccServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("DELETE", "/v2/organizations/org-guid"),
+				ghttp.RespondWith(http.StatusAccepted, `{"metadata":{"url":"/v2/jobs/job-guid"},"entity":{"status":"queued"}}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/v2/jobs/job-guid"),
+				ghttp.RespondWith(http.StatusOK, `{"entity":{"status":"running"}}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/v2/jobs/job-guid"),
+				ghttp.RespondWith(http.StatusOK, `{"entity":{"status":"finished"}}`),
+			),
+		)
+
+		err := ccGateway.DeleteResource(config.APIEndpoint(), "/v2/organizations/org-guid")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ccServer.ReceivedRequests()).To(HaveLen(3))
+	})
+})
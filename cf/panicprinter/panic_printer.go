@@ -13,6 +13,7 @@ var UI terminal.UI
 func DisplayCrashDialog(err interface{}, commandArgs string, stackTrace string) {
 	if err != nil && err != terminal.QuietPanic {
 		switch err := err.(type) {
+		case terminal.QuietExit:
 		case errors.Exception:
 			if err.DisplayCrashDialog {
 				UI.Say(CrashDialog(err.Message, commandArgs, stackTrace))
@@ -38,6 +38,11 @@ var _ = Describe("Panic Printer", func() {
 			Expect(len(ui.Outputs)).To(Equal(0))
 		})
 
+		It("does not print anything when given a terminal.QuietExit", func() {
+			panicprinter.DisplayCrashDialog(terminal.QuietExit(2), "some command", "some trace")
+			Expect(len(ui.Outputs)).To(Equal(0))
+		})
+
 		It("prints the unexpected error type message when not given a string or an error", func() {
 			panicprinter.DisplayCrashDialog(struct{}{}, "some command", "some trace")
 			Expect(len(ui.Outputs)).To(BeNumerically(">", 0))
@@ -142,3 +142,12 @@ func LogSysHeaderColor(message string) string {
 func isTerminal() bool {
 	return terminal.IsTerminal(int(os.Stdout.Fd()))
 }
+
+// ClearScreen returns the escape sequence that clears the screen and moves
+// the cursor to the top left corner, or "" when stdout is not a terminal.
+func ClearScreen() string {
+	if !TerminalSupportsColors {
+		return ""
+	}
+	return "\033[H\033[2J"
+}
@@ -0,0 +1,67 @@
+package terminal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"gopkg.in/yaml.v2"
+)
+
+// OutputFormat selects how a UI renders the results of a command. The
+// zero value is the human readable, column aligned table output.
+type OutputFormat string
+
+const (
+	TableOutput OutputFormat = ""
+	JSONOutput  OutputFormat = "json"
+	YAMLOutput  OutputFormat = "yaml"
+)
+
+// ParseOutputFormat converts the value given to the global --output
+// option into an OutputFormat.
+func ParseOutputFormat(value string) (OutputFormat, error) {
+	switch strings.ToLower(value) {
+	case "json":
+		return JSONOutput, nil
+	case "yaml", "yml":
+		return YAMLOutput, nil
+	case "table":
+		return TableOutput, nil
+	}
+
+	return TableOutput, errors.New(T("Invalid output format {{.Format}}. Supported formats are: json, yaml, table",
+		map[string]interface{}{"Format": value}))
+}
+
+// IsStructured reports whether the format is a machine readable one,
+// in which case informational messages are not printed.
+func (f OutputFormat) IsStructured() bool {
+	return f == JSONOutput || f == YAMLOutput
+}
+
+// EncodeOutput writes v to w using the structured encoder for the
+// given format.
+func EncodeOutput(w io.Writer, format OutputFormat, v interface{}) error {
+	switch format {
+	case JSONOutput:
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", data)
+		return err
+	case YAMLOutput:
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "---\n%s", data)
+		return err
+	}
+
+	return fmt.Errorf("output format %q is not a structured format", string(format))
+}
@@ -0,0 +1,120 @@
+package terminal_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	"github.com/cloudfoundry/cli/cf/trace/tracefakes"
+	"gopkg.in/yaml.v2"
+
+	. "github.com/cloudfoundry/cli/cf/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Structured output", func() {
+	type record struct {
+		Name string `json:"name" yaml:"name"`
+	}
+
+	Describe("ParseOutputFormat", func() {
+		It("accepts json, yaml and table", func() {
+			Expect(ParseOutputFormat("json")).To(Equal(JSONOutput))
+			Expect(ParseOutputFormat("YAML")).To(Equal(YAMLOutput))
+			Expect(ParseOutputFormat("yml")).To(Equal(YAMLOutput))
+			Expect(ParseOutputFormat("table")).To(Equal(TableOutput))
+		})
+
+		It("returns an error for unknown formats", func() {
+			_, err := ParseOutputFormat("xml")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("xml"))
+		})
+	})
+
+	Describe("EncodeOutput", func() {
+		It("encodes json", func() {
+			buffer := &bytes.Buffer{}
+			Expect(EncodeOutput(buffer, JSONOutput, []record{{Name: "app1"}})).To(Succeed())
+
+			var decoded []record
+			Expect(json.Unmarshal(buffer.Bytes(), &decoded)).To(Succeed())
+			Expect(decoded).To(Equal([]record{{Name: "app1"}}))
+		})
+
+		It("encodes yaml", func() {
+			buffer := &bytes.Buffer{}
+			Expect(EncodeOutput(buffer, YAMLOutput, []record{{Name: "app1"}})).To(Succeed())
+
+			var decoded []record
+			Expect(yaml.Unmarshal(buffer.Bytes(), &decoded)).To(Succeed())
+			Expect(decoded).To(Equal([]record{{Name: "app1"}}))
+		})
+
+		It("refuses to encode the table format", func() {
+			Expect(EncodeOutput(&bytes.Buffer{}, TableOutput, nil)).NotTo(Succeed())
+		})
+	})
+
+	Describe("a UI with a structured output format", func() {
+		var (
+			output *bytes.Buffer
+			ui     UI
+		)
+
+		BeforeEach(func() {
+			output = &bytes.Buffer{}
+			ui = NewUI(nil, output, NewTeePrinter(output), new(tracefakes.FakePrinter))
+			ui.SetOutputFormat(JSONOutput)
+		})
+
+		It("does not print informational messages", func() {
+			ui.Say("Getting apps...")
+			ui.Ok()
+			Expect(output.String()).To(BeEmpty())
+		})
+
+		It("prints warnings to stderr", func() {
+			r, w, err := os.Pipe()
+			Expect(err).NotTo(HaveOccurred())
+
+			oldStderr := os.Stderr
+			os.Stderr = w
+			ui.Warn("careful")
+			os.Stderr = oldStderr
+			w.Close()
+
+			warning, err := ioutil.ReadAll(r)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(warning)).To(ContainSubstring("careful"))
+			Expect(output.String()).To(BeEmpty())
+		})
+
+		It("prints structured values", func() {
+			ui.PrintStructured(record{Name: "app1"})
+
+			var decoded record
+			Expect(json.Unmarshal(output.Bytes(), &decoded)).To(Succeed())
+			Expect(decoded.Name).To(Equal("app1"))
+		})
+
+		It("does not print tables", func() {
+			table := ui.Table([]string{"name", "requested state"})
+			table.Add("app1", "started")
+			table.Print()
+
+			Expect(output.String()).To(BeEmpty())
+		})
+	})
+
+	Describe("a UI with the table output format", func() {
+		It("ignores structured values", func() {
+			output := &bytes.Buffer{}
+			ui := NewUI(nil, output, NewTeePrinter(output), new(tracefakes.FakePrinter))
+			ui.PrintStructured(record{Name: "app1"})
+			Expect(output.String()).To(BeEmpty())
+		})
+	})
+})
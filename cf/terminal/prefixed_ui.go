@@ -0,0 +1,83 @@
+package terminal
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	. "github.com/cloudfoundry/cli/cf/i18n"
+)
+
+var prefixedOutputLock sync.Mutex
+
+// PrefixedUI is a UI which prepends a fixed prefix to every line it
+// prints. It is used when several operations report their progress to
+// the same terminal at the same time, so that interleaved lines can
+// still be told apart.
+type PrefixedUI struct {
+	UI
+	prefix string
+}
+
+func NewPrefixedUI(ui UI, prefix string) UI {
+	return &PrefixedUI{
+		UI:     ui,
+		prefix: prefix,
+	}
+}
+
+func (ui *PrefixedUI) Say(message string, args ...interface{}) {
+	if len(args) > 0 {
+		message = fmt.Sprintf(message, args...)
+	}
+
+	lines := strings.Split(message, "\n")
+	for i, line := range lines {
+		lines[i] = ui.prefix + line
+	}
+
+	prefixedOutputLock.Lock()
+	defer prefixedOutputLock.Unlock()
+	ui.UI.Say("%s", strings.Join(lines, "\n"))
+}
+
+func (ui *PrefixedUI) PrintPaginator(rows []string, err error) {
+	if err != nil {
+		ui.Failed(err.Error())
+		return
+	}
+
+	for _, row := range rows {
+		ui.Say(row)
+	}
+}
+
+func (ui *PrefixedUI) Warn(message string, args ...interface{}) {
+	message = fmt.Sprintf(message, args...)
+	ui.Say(WarningColor(message))
+}
+
+func (ui *PrefixedUI) Ok() {
+	ui.Say(SuccessColor(T("OK")))
+}
+
+func (ui *PrefixedUI) Failed(message string, args ...interface{}) {
+	message = fmt.Sprintf(message, args...)
+
+	ui.Say(FailureColor(T("FAILED")))
+	ui.Say(message)
+
+	panic(QuietPanic)
+}
+
+// LoadingIndication prints nothing, the progress dots of concurrent
+// operations would only run into each other's lines.
+func (ui *PrefixedUI) LoadingIndication() {
+}
+
+func (ui *PrefixedUI) Table(headers []string) *UITable {
+	return &UITable{
+		UI:    ui,
+		Table: NewTable(headers),
+	}
+}
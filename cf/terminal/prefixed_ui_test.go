@@ -0,0 +1,43 @@
+package terminal_test
+
+import (
+	"bytes"
+
+	"github.com/cloudfoundry/cli/cf/trace/tracefakes"
+
+	. "github.com/cloudfoundry/cli/cf/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PrefixedUI", func() {
+	var (
+		output *bytes.Buffer
+		ui     UI
+	)
+
+	BeforeEach(func() {
+		output = &bytes.Buffer{}
+		ui = NewPrefixedUI(NewUI(nil, output, NewTeePrinter(output), new(tracefakes.FakePrinter)), "[app1] ")
+	})
+
+	It("prefixes every line it says", func() {
+		ui.Say("Creating app %s...\nDone", "app1")
+		Expect(output.String()).To(Equal("[app1] Creating app app1...\n[app1] Done\n"))
+	})
+
+	It("prefixes the rows of tables", func() {
+		table := ui.Table([]string{"name", "state"})
+		table.Add("app1", "started")
+		table.Print()
+
+		Expect(output.String()).To(ContainSubstring("[app1] name"))
+		Expect(output.String()).To(ContainSubstring("[app1] app1"))
+	})
+
+	It("prefixes failures and panics quietly", func() {
+		Expect(func() { ui.Failed("it broke") }).To(Panic())
+		Expect(output.String()).To(ContainSubstring("[app1] FAILED"))
+		Expect(output.String()).To(ContainSubstring("[app1] it broke"))
+	})
+})
@@ -109,6 +109,22 @@ type FakeUI struct {
 	notifyUpdateIfNeededArgsForCall []struct {
 		arg1 coreconfig.Reader
 	}
+	SetOutputFormatStub        func(format terminal.OutputFormat)
+	setOutputFormatMutex       sync.RWMutex
+	setOutputFormatArgsForCall []struct {
+		format terminal.OutputFormat
+	}
+	OutputFormatStub        func() terminal.OutputFormat
+	outputFormatMutex       sync.RWMutex
+	outputFormatArgsForCall []struct{}
+	outputFormatReturns     struct {
+		result1 terminal.OutputFormat
+	}
+	PrintStructuredStub        func(v interface{})
+	printStructuredMutex       sync.RWMutex
+	printStructuredArgsForCall []struct {
+		v interface{}
+	}
 	WriterStub        func() io.Writer
 	writerMutex       sync.RWMutex
 	writerArgsForCall []struct{}
@@ -532,6 +548,76 @@ func (fake *FakeUI) NotifyUpdateIfNeededArgsForCall(i int) coreconfig.Reader {
 	return fake.notifyUpdateIfNeededArgsForCall[i].arg1
 }
 
+func (fake *FakeUI) SetOutputFormat(format terminal.OutputFormat) {
+	fake.setOutputFormatMutex.Lock()
+	fake.setOutputFormatArgsForCall = append(fake.setOutputFormatArgsForCall, struct {
+		format terminal.OutputFormat
+	}{format})
+	fake.setOutputFormatMutex.Unlock()
+	if fake.SetOutputFormatStub != nil {
+		fake.SetOutputFormatStub(format)
+	}
+}
+
+func (fake *FakeUI) SetOutputFormatCallCount() int {
+	fake.setOutputFormatMutex.RLock()
+	defer fake.setOutputFormatMutex.RUnlock()
+	return len(fake.setOutputFormatArgsForCall)
+}
+
+func (fake *FakeUI) SetOutputFormatArgsForCall(i int) terminal.OutputFormat {
+	fake.setOutputFormatMutex.RLock()
+	defer fake.setOutputFormatMutex.RUnlock()
+	return fake.setOutputFormatArgsForCall[i].format
+}
+
+func (fake *FakeUI) OutputFormat() terminal.OutputFormat {
+	fake.outputFormatMutex.Lock()
+	fake.outputFormatArgsForCall = append(fake.outputFormatArgsForCall, struct{}{})
+	fake.outputFormatMutex.Unlock()
+	if fake.OutputFormatStub != nil {
+		return fake.OutputFormatStub()
+	} else {
+		return fake.outputFormatReturns.result1
+	}
+}
+
+func (fake *FakeUI) OutputFormatCallCount() int {
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
+	return len(fake.outputFormatArgsForCall)
+}
+
+func (fake *FakeUI) OutputFormatReturns(result1 terminal.OutputFormat) {
+	fake.OutputFormatStub = nil
+	fake.outputFormatReturns = struct {
+		result1 terminal.OutputFormat
+	}{result1}
+}
+
+func (fake *FakeUI) PrintStructured(v interface{}) {
+	fake.printStructuredMutex.Lock()
+	fake.printStructuredArgsForCall = append(fake.printStructuredArgsForCall, struct {
+		v interface{}
+	}{v})
+	fake.printStructuredMutex.Unlock()
+	if fake.PrintStructuredStub != nil {
+		fake.PrintStructuredStub(v)
+	}
+}
+
+func (fake *FakeUI) PrintStructuredCallCount() int {
+	fake.printStructuredMutex.RLock()
+	defer fake.printStructuredMutex.RUnlock()
+	return len(fake.printStructuredArgsForCall)
+}
+
+func (fake *FakeUI) PrintStructuredArgsForCall(i int) interface{} {
+	fake.printStructuredMutex.RLock()
+	defer fake.printStructuredMutex.RUnlock()
+	return fake.printStructuredArgsForCall[i].v
+}
+
 func (fake *FakeUI) Writer() io.Writer {
 	fake.writerMutex.Lock()
 	fake.writerArgsForCall = append(fake.writerArgsForCall, struct{}{})
@@ -3,6 +3,7 @@ package terminal
 import (
 	"fmt"
 	"io"
+	"os"
 	"strings"
 
 	. "github.com/cloudfoundry/cli/cf/i18n"
@@ -43,6 +44,10 @@ type UI interface {
 	Table(headers []string) *UITable
 	NotifyUpdateIfNeeded(coreconfig.Reader)
 
+	SetOutputFormat(format OutputFormat)
+	OutputFormat() OutputFormat
+	PrintStructured(v interface{})
+
 	Writer() io.Writer
 }
 
@@ -53,10 +58,11 @@ type Printer interface {
 }
 
 type terminalUI struct {
-	stdin   io.Reader
-	stdout  io.Writer
-	printer Printer
-	logger  trace.Printer
+	stdin        io.Reader
+	stdout       io.Writer
+	printer      Printer
+	logger       trace.Printer
+	outputFormat OutputFormat
 }
 
 func NewUI(r io.Reader, w io.Writer, printer Printer, logger trace.Printer) UI {
@@ -72,6 +78,32 @@ func (ui terminalUI) Writer() io.Writer {
 	return ui.stdout
 }
 
+func (ui *terminalUI) SetOutputFormat(format OutputFormat) {
+	ui.outputFormat = format
+}
+
+func (ui *terminalUI) OutputFormat() OutputFormat {
+	return ui.outputFormat
+}
+
+// PrintStructured encodes v in the selected output format. It is a
+// no-op when the UI renders tables, so commands can call it
+// unconditionally next to their table output.
+func (ui *terminalUI) PrintStructured(v interface{}) {
+	if !ui.outputFormat.IsStructured() {
+		return
+	}
+
+	buffer := &bytes.Buffer{}
+	err := EncodeOutput(buffer, ui.outputFormat, v)
+	if err != nil {
+		ui.Failed(err.Error())
+		return
+	}
+
+	_, _ = ui.printer.Print(buffer.String())
+}
+
 func (ui *terminalUI) PrintPaginator(rows []string, err error) {
 	if err != nil {
 		ui.Failed(err.Error())
@@ -92,6 +124,14 @@ func (ui *terminalUI) PrintCapturingNoOutput(message string, args ...interface{}
 }
 
 func (ui *terminalUI) Say(message string, args ...interface{}) {
+	if ui.outputFormat.IsStructured() {
+		return
+	}
+
+	ui.say(message, args...)
+}
+
+func (ui *terminalUI) say(message string, args ...interface{}) {
 	if len(args) == 0 {
 		_, _ = ui.printer.Printf("%s\n", message)
 	} else {
@@ -101,6 +141,12 @@ func (ui *terminalUI) Say(message string, args ...interface{}) {
 
 func (ui *terminalUI) Warn(message string, args ...interface{}) {
 	message = fmt.Sprintf(message, args...)
+	if ui.outputFormat.IsStructured() {
+		// keep warnings out of the records on stdout without losing them
+		fmt.Fprintln(os.Stderr, WarningColor(message))
+		return
+	}
+
 	ui.Say(WarningColor(message))
 	return
 }
@@ -157,6 +203,10 @@ func (ui *terminalUI) Ok() {
 
 const QuietPanic = "This shouldn't print anything"
 
+// QuietExit is panicked with instead of QuietPanic by commands that exit
+// with a status other than 1 once they have printed their failure.
+type QuietExit int
+
 func (ui *terminalUI) Failed(message string, args ...interface{}) {
 	message = fmt.Sprintf(message, args...)
 
@@ -169,8 +219,8 @@ func (ui *terminalUI) Failed(message string, args ...interface{}) {
 	ui.logger.Print(message)
 
 	if !ui.logger.WritesToConsole() {
-		ui.Say(FailureColor(failed))
-		ui.Say(message)
+		ui.say(FailureColor(failed))
+		ui.say(message)
 	}
 
 	ui.PanicQuietly()
@@ -245,6 +295,10 @@ func (ui *terminalUI) ShowConfiguration(config coreconfig.Reader) {
 }
 
 func (ui *terminalUI) LoadingIndication() {
+	if ui.outputFormat.IsStructured() {
+		return
+	}
+
 	_, _ = ui.printer.Print(".")
 }
 
@@ -271,6 +325,13 @@ func (u *UITable) Print() {
 	result := &bytes.Buffer{}
 	t := u.Table
 
+	// Commands supporting a structured output format print their
+	// models with PrintStructured instead of the table.
+	if u.UI.OutputFormat().IsStructured() {
+		t.rows = [][]string{}
+		return
+	}
+
 	t.PrintTo(result)
 
 	// DevNote. With the change to printing into a buffer all
@@ -5,8 +5,6 @@ import (
 	"strconv"
 
 	. "github.com/cloudfoundry/cli/cf/i18n"
-
-	"github.com/cloudfoundry/gofileutils/fileutils"
 )
 
 func NewLogger(writer io.Writer, verbose bool, cf_trace, config_trace string) Printer {
@@ -21,7 +19,7 @@ func NewLogger(writer io.Writer, verbose bool, cf_trace, config_trace string) Pr
 		LoggingToStdout = LoggingToStdout || b
 
 		if path != "" && err != nil {
-			file, err := fileutils.Open(path)
+			file, err := NewRotatingFile(path, MaxFileSize, MaxFileBackups)
 
 			if err == nil {
 				printers = append(printers, NewWriterPrinter(file, false))
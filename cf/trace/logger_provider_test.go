@@ -181,4 +181,34 @@ var _ = Describe("NewLogger", func() {
 			Expect(buffer).To(gbytes.Say("Hello World"))
 		}
 	})
+
+	Context("when the trace file grows past the max size", func() {
+		var originalMaxFileSize int64
+
+		BeforeEach(func() {
+			originalMaxFileSize = MaxFileSize
+			MaxFileSize = 20
+		})
+
+		AfterEach(func() {
+			MaxFileSize = originalMaxFileSize
+		})
+
+		It("rotates the file", func() {
+			fileutils.TempFile("trace_test", func(file *os.File, err error) {
+				defer os.Remove(file.Name() + ".1")
+
+				logger := NewLogger(buffer, false, file.Name(), "")
+
+				logger.Println("Hello World")
+				logger.Println("Goodbye World")
+
+				fileContents, _ := ioutil.ReadFile(file.Name())
+				Expect(string(fileContents)).To(Equal("Goodbye World\n"))
+
+				backupContents, _ := ioutil.ReadFile(file.Name() + ".1")
+				Expect(string(backupContents)).To(Equal("Hello World\n"))
+			})
+		})
+	})
 })
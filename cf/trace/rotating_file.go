@@ -0,0 +1,112 @@
+package trace
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/cloudfoundry/gofileutils/fileutils"
+)
+
+// MaxFileSize is the size in bytes at which trace files are rotated, and
+// MaxFileBackups the number of rotated files that are kept next to the trace
+// file as FILE.1, FILE.2 and so on. A MaxFileSize of 0 disables rotation.
+var (
+	MaxFileSize    int64 = 10 * 1024 * 1024
+	MaxFileBackups       = 3
+)
+
+type rotatingFile struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	mutex sync.Mutex
+	file  *os.File
+	size  int64
+}
+
+// NewRotatingFile opens path for appending. Once a write would grow the file
+// past maxSize, the file is moved to path.1, older backups are shifted and
+// a new file is started.
+func NewRotatingFile(path string, maxSize int64, maxBackups int) (io.WriteCloser, error) {
+	f := &rotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+
+	err := f.open()
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if f.maxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		err := f.rotate()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+func (f *rotatingFile) Close() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return f.file.Close()
+}
+
+func (f *rotatingFile) open() error {
+	file, err := fileutils.Open(f.path)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+func (f *rotatingFile) rotate() error {
+	err := f.file.Close()
+	if err != nil {
+		return err
+	}
+
+	if f.maxBackups < 1 {
+		err = os.Remove(f.path)
+	} else {
+		_ = os.Remove(f.backupPath(f.maxBackups))
+		for i := f.maxBackups - 1; i > 0; i-- {
+			_ = os.Rename(f.backupPath(i), f.backupPath(i+1))
+		}
+		err = os.Rename(f.path, f.backupPath(1))
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return f.open()
+}
+
+func (f *rotatingFile) backupPath(n int) string {
+	return fmt.Sprintf("%s.%d", f.path, n)
+}
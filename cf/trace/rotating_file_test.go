@@ -0,0 +1,96 @@
+package trace_test
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/cloudfoundry/cli/cf/trace"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewRotatingFile", func() {
+	var (
+		dir  string
+		path string
+		file io.WriteCloser
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "rotating-file-test")
+		Expect(err).NotTo(HaveOccurred())
+		path = filepath.Join(dir, "trace.log")
+	})
+
+	AfterEach(func() {
+		if file != nil {
+			file.Close()
+		}
+		os.RemoveAll(dir)
+	})
+
+	readFile := func(path string) string {
+		contents, err := ioutil.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		return string(contents)
+	}
+
+	It("appends to an existing file", func() {
+		err := ioutil.WriteFile(path, []byte("old\n"), 0600)
+		Expect(err).NotTo(HaveOccurred())
+
+		file, err = NewRotatingFile(path, 100, 1)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = file.Write([]byte("new\n"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(readFile(path)).To(Equal("old\nnew\n"))
+	})
+
+	It("rotates the file when it would grow past the max size and keeps the backups", func() {
+		var err error
+		file, err = NewRotatingFile(path, 10, 2)
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+			_, err = file.Write([]byte(line))
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		Expect(readFile(path)).To(Equal("fourth\n"))
+		Expect(readFile(path + ".1")).To(Equal("third\n"))
+		Expect(readFile(path + ".2")).To(Equal("second\n"))
+		Expect(path + ".3").NotTo(BeAnExistingFile())
+	})
+
+	It("starts over without backups when none are kept", func() {
+		var err error
+		file, err = NewRotatingFile(path, 10, 0)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = file.Write([]byte("first\n"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = file.Write([]byte("second\n"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(readFile(path)).To(Equal("second\n"))
+		Expect(path + ".1").NotTo(BeAnExistingFile())
+	})
+
+	It("never rotates when the max size is 0", func() {
+		var err error
+		file, err = NewRotatingFile(path, 0, 2)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = file.Write([]byte("first\n"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = file.Write([]byte("second\n"))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(readFile(path)).To(Equal("first\nsecond\n"))
+	})
+})
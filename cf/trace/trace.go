@@ -1,14 +1,29 @@
 package trace
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"sort"
+	"strings"
 
 	. "github.com/cloudfoundry/cli/cf/i18n"
 )
 
 var LoggingToStdout bool
 
+// RedactedFields are additional JSON properties whose values are hidden from
+// the trace, whatever their type. Set from CF_TRACE_REDACT.
+var RedactedFields []string
+
+// secretFields hold service credentials, including those in VCAP_SERVICES,
+// and the -c parameters of services. Their whole value is hidden.
+var secretFields = []string{"credentials", "parameters"}
+
+// environmentFields hold environment variables. Only the values of the
+// variables are hidden, so that the trace still shows which were set.
+var environmentFields = []string{"environment_json", "running_env_json", "staging_env_json", "environment_variables"}
+
 func Sanitize(input string) string {
 	re := regexp.MustCompile(`(?m)^Authorization: .*`)
 	sanitized := re.ReplaceAllString(input, "Authorization: "+PRIVATE_DATA_PLACEHOLDER())
@@ -22,9 +37,114 @@ func Sanitize(input string) string {
 	sanitized = sanitizeJSON("password", sanitized)
 	sanitized = sanitizeJSON("oldPassword", sanitized)
 
+	for _, field := range environmentFields {
+		sanitized = replaceJSONValues(field, sanitized, hideObjectValues)
+	}
+	for _, field := range append(secretFields, RedactedFields...) {
+		sanitized = replaceJSONValues(field, sanitized, func(string) string {
+			return `"` + PRIVATE_DATA_PLACEHOLDER() + `"`
+		})
+	}
+
 	return sanitized
 }
 
+// replaceJSONValues replaces the value of every propertyName in the JSON
+// embedded in input. Values can be of any type, including nested objects.
+func replaceJSONValues(propertyName string, input string, replace func(value string) string) string {
+	regex := regexp.MustCompile(fmt.Sprintf(`"%s"\s*:\s*`, regexp.QuoteMeta(propertyName)))
+
+	var result []string
+	cursor := 0
+	for _, match := range regex.FindAllStringIndex(input, -1) {
+		if match[0] < cursor {
+			continue
+		}
+
+		end := jsonValueEnd(input, match[1])
+		result = append(result, input[cursor:match[1]], replace(input[match[1]:end]))
+		cursor = end
+	}
+	result = append(result, input[cursor:])
+
+	return strings.Join(result, "")
+}
+
+// jsonValueEnd returns the index just past the JSON value starting at start.
+// Truncated values end at the end of the input.
+func jsonValueEnd(input string, start int) int {
+	if start >= len(input) {
+		return start
+	}
+
+	switch input[start] {
+	case '"':
+		for i := start + 1; i < len(input); i++ {
+			switch input[i] {
+			case '\\':
+				i++
+			case '"':
+				return i + 1
+			}
+		}
+	case '{', '[':
+		depth := 0
+		inString := false
+		for i := start; i < len(input); i++ {
+			switch {
+			case inString && input[i] == '\\':
+				i++
+			case input[i] == '"':
+				inString = !inString
+			case inString:
+			case input[i] == '{' || input[i] == '[':
+				depth++
+			case input[i] == '}' || input[i] == ']':
+				depth--
+				if depth == 0 {
+					return i + 1
+				}
+			}
+		}
+	default:
+		for i := start; i < len(input); i++ {
+			if strings.ContainsRune(",}] \t\r\n", rune(input[i])) {
+				return i
+			}
+		}
+	}
+
+	return len(input)
+}
+
+// hideObjectValues keeps the keys of a JSON object and hides their values.
+// Anything that is not a complete object is hidden as a whole.
+func hideObjectValues(value string) string {
+	var object map[string]json.RawMessage
+	err := json.Unmarshal([]byte(value), &object)
+	if err != nil {
+		return `"` + PRIVATE_DATA_PLACEHOLDER() + `"`
+	}
+	if object == nil {
+		return value
+	}
+
+	keys := []string{}
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	placeholder, _ := json.Marshal(PRIVATE_DATA_PLACEHOLDER())
+	parts := []string{}
+	for _, key := range keys {
+		name, _ := json.Marshal(key)
+		parts = append(parts, string(name)+":"+string(placeholder))
+	}
+
+	return "{" + strings.Join(parts, ",") + "}"
+}
+
 func sanitizeJSON(propertyName string, json string) string {
 	regex := regexp.MustCompile(fmt.Sprintf(`"%s":\s*"[^\,]*"`, propertyName))
 	return regex.ReplaceAllString(json, fmt.Sprintf(`"%s":"%s"`, propertyName, PRIVATE_DATA_PLACEHOLDER()))
@@ -1,7 +1,9 @@
 package models
 
 type V3Application struct {
+	GUID                  string `json:"guid"`
 	Name                  string `json:"name"`
+	State                 string `json:"state"`
 	DesiredState          string `json:"desired_state"`
 	TotalDesiredInstances int    `json:"total_desired_instances"`
 	Links                 Links  `json:"links"`
@@ -10,6 +12,8 @@ type V3Application struct {
 type Links struct {
 	Processes Link `json:"processes"`
 	Routes    Link `json:"routes"`
+	Droplets  Link `json:"droplets"`
+	Tasks     Link `json:"tasks"`
 }
 
 type Link struct {
@@ -17,13 +21,84 @@ type Link struct {
 }
 
 type V3Process struct {
+	GUID       string `json:"guid"`
 	Type       string `json:"type"`
 	Instances  int    `json:"instances"`
 	MemoryInMB int64  `json:"memory_in_mb"`
 	DiskInMB   int64  `json:"disk_in_mb"`
 }
 
+// V3ProcessScale holds the new scale of a process. Fields left nil are
+// not changed.
+type V3ProcessScale struct {
+	Instances  *int   `json:"instances,omitempty"`
+	MemoryInMB *int64 `json:"memory_in_mb,omitempty"`
+	DiskInMB   *int64 `json:"disk_in_mb,omitempty"`
+}
+
 type V3Route struct {
 	Host string `json:"host"`
 	Path string `json:"path"`
 }
+
+const (
+	V3PackageReady   = "READY"
+	V3PackageFailed  = "FAILED"
+	V3PackageExpired = "EXPIRED"
+)
+
+type V3Package struct {
+	GUID  string `json:"guid"`
+	Type  string `json:"type"`
+	State string `json:"state"`
+}
+
+const (
+	V3BuildStaging = "STAGING"
+	V3BuildStaged  = "STAGED"
+	V3BuildFailed  = "FAILED"
+)
+
+type V3Build struct {
+	GUID    string        `json:"guid"`
+	State   string        `json:"state"`
+	Error   string        `json:"error"`
+	Droplet *V3DropletRef `json:"droplet"`
+}
+
+type V3DropletRef struct {
+	GUID string `json:"guid"`
+}
+
+type V3Droplet struct {
+	GUID       string        `json:"guid"`
+	State      string        `json:"state"`
+	Error      string        `json:"error"`
+	Stack      string        `json:"stack"`
+	Buildpacks []V3Buildpack `json:"buildpacks"`
+	CreatedAt  string        `json:"created_at"`
+}
+
+type V3Buildpack struct {
+	Name string `json:"name"`
+}
+
+type V3Task struct {
+	GUID       string `json:"guid"`
+	SequenceID int    `json:"sequence_id"`
+	Name       string `json:"name"`
+	Command    string `json:"command"`
+	State      string `json:"state"`
+	MemoryInMB int64  `json:"memory_in_mb"`
+	DiskInMB   int64  `json:"disk_in_mb"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// V3TaskParams holds the attributes of a task to run. Name, memory and
+// disk are chosen by the Cloud Controller when left empty.
+type V3TaskParams struct {
+	Command    string `json:"command"`
+	Name       string `json:"name,omitempty"`
+	MemoryInMB int64  `json:"memory_in_mb,omitempty"`
+	DiskInMB   int64  `json:"disk_in_mb,omitempty"`
+}
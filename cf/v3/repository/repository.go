@@ -1,31 +1,66 @@
 package repository
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
 	"net/url"
+	"os"
 
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/net"
 	"github.com/cloudfoundry/cli/cf/v3/models"
 	"github.com/cloudfoundry/go-ccapi/v3/client"
+	"github.com/cloudfoundry/gofileutils/fileutils"
 )
 
 //go:generate counterfeiter . Repository
 
 type Repository interface {
 	GetApplications() ([]models.V3Application, error)
+	GetApplicationByName(spaceGUID, name string) (models.V3Application, error)
+	CreateApplication(spaceGUID, name string) (models.V3Application, error)
+	StartApplication(appGUID string) error
+	StopApplication(appGUID string) error
+
 	GetProcesses(path string) ([]models.V3Process, error)
+	ScaleProcess(appGUID, processType string, scale models.V3ProcessScale) (models.V3Process, error)
 	GetRoutes(path string) ([]models.V3Route, error)
+
+	CreatePackage(appGUID string) (models.V3Package, error)
+	UploadPackage(packageGUID string, zipFile *os.File) error
+	GetPackage(packageGUID string) (models.V3Package, error)
+
+	CreateBuild(packageGUID string) (models.V3Build, error)
+	GetBuild(buildGUID string) (models.V3Build, error)
+
+	GetDroplets(appGUID string) ([]models.V3Droplet, error)
+	GetCurrentDroplet(appGUID string) (models.V3Droplet, error)
+	SetCurrentDroplet(appGUID, dropletGUID string) error
+
+	CreateTask(appGUID string, params models.V3TaskParams) (models.V3Task, error)
+	GetTasks(appGUID string) ([]models.V3Task, error)
+	CancelTask(taskGUID string) (models.V3Task, error)
 }
 
 type repository struct {
-	client client.Client
-	config coreconfig.ReadWriter
+	client  client.Client
+	gateway net.Gateway
+	config  coreconfig.ReadWriter
 }
 
-func NewRepository(config coreconfig.ReadWriter, client client.Client) Repository {
+// NewRepository returns a Repository which lists resources through the
+// v3 client and creates and modifies them through the Cloud Controller
+// gateway.
+func NewRepository(config coreconfig.ReadWriter, client client.Client, gateway net.Gateway) Repository {
 	return &repository{
-		client: client,
-		config: config,
+		client:  client,
+		gateway: gateway,
+		config:  config,
 	}
 }
 
@@ -38,7 +73,27 @@ func (r *repository) handleUpdatedTokens() {
 }
 
 func (r *repository) GetApplications() ([]models.V3Application, error) {
-	jsonResponse, err := r.client.GetApplications(url.Values{})
+	return r.getApplications(url.Values{})
+}
+
+func (r *repository) GetApplicationByName(spaceGUID, name string) (models.V3Application, error) {
+	applications, err := r.getApplications(url.Values{
+		"names":       []string{name},
+		"space_guids": []string{spaceGUID},
+	})
+	if err != nil {
+		return models.V3Application{}, err
+	}
+
+	if len(applications) == 0 {
+		return models.V3Application{}, errors.NewModelNotFoundError("App", name)
+	}
+
+	return applications[0], nil
+}
+
+func (r *repository) getApplications(queryParams url.Values) ([]models.V3Application, error) {
+	jsonResponse, err := r.client.GetApplications(queryParams)
 	if err != nil {
 		return []models.V3Application{}, err
 	}
@@ -54,16 +109,32 @@ func (r *repository) GetApplications() ([]models.V3Application, error) {
 	return applications, nil
 }
 
-func (r *repository) GetProcesses(path string) ([]models.V3Process, error) {
-	jsonResponse, err := r.client.GetResources(path, 0)
-	if err != nil {
-		return []models.V3Process{}, err
+func (r *repository) CreateApplication(spaceGUID, name string) (models.V3Application, error) {
+	body := map[string]interface{}{
+		"name": name,
+		"relationships": map[string]interface{}{
+			"space": map[string]interface{}{
+				"data": map[string]string{"guid": spaceGUID},
+			},
+		},
 	}
 
-	r.handleUpdatedTokens()
+	application := models.V3Application{}
+	err := r.perform("POST", "/v3/apps", body, &application)
+	return application, err
+}
+
+func (r *repository) StartApplication(appGUID string) error {
+	return r.perform("POST", fmt.Sprintf("/v3/apps/%s/actions/start", appGUID), nil, nil)
+}
+
+func (r *repository) StopApplication(appGUID string) error {
+	return r.perform("POST", fmt.Sprintf("/v3/apps/%s/actions/stop", appGUID), nil, nil)
+}
 
+func (r *repository) GetProcesses(path string) ([]models.V3Process, error) {
 	processes := []models.V3Process{}
-	err = json.Unmarshal(jsonResponse, &processes)
+	err := r.getResources(path, &processes)
 	if err != nil {
 		return []models.V3Process{}, err
 	}
@@ -71,19 +142,183 @@ func (r *repository) GetProcesses(path string) ([]models.V3Process, error) {
 	return processes, nil
 }
 
+func (r *repository) ScaleProcess(appGUID, processType string, scale models.V3ProcessScale) (models.V3Process, error) {
+	process := models.V3Process{}
+	err := r.perform("POST", fmt.Sprintf("/v3/apps/%s/processes/%s/actions/scale", appGUID, processType), scale, &process)
+	return process, err
+}
+
 func (r *repository) GetRoutes(path string) ([]models.V3Route, error) {
-	jsonResponse, err := r.client.GetResources(path, 0)
+	routes := []models.V3Route{}
+	err := r.getResources(path, &routes)
 	if err != nil {
 		return []models.V3Route{}, err
 	}
 
+	return routes, nil
+}
+
+func (r *repository) CreatePackage(appGUID string) (models.V3Package, error) {
+	body := map[string]interface{}{
+		"type": "bits",
+		"relationships": map[string]interface{}{
+			"app": map[string]interface{}{
+				"data": map[string]string{"guid": appGUID},
+			},
+		},
+	}
+
+	pkg := models.V3Package{}
+	err := r.perform("POST", "/v3/packages", body, &pkg)
+	return pkg, err
+}
+
+func (r *repository) UploadPackage(packageGUID string, zipFile *os.File) (apiErr error) {
+	apiURL := fmt.Sprintf("%s/v3/packages/%s/upload", r.config.APIEndpoint(), packageGUID)
+
+	fileutils.TempFile("requests", func(requestFile *os.File, err error) {
+		if err != nil {
+			apiErr = fmt.Errorf("%s: %s", T("Error creating tmp file: {{.Err}}", map[string]interface{}{"Err": err}), err.Error())
+			return
+		}
+
+		boundary, err := writePackageUploadBody(zipFile, requestFile)
+		if err != nil {
+			apiErr = fmt.Errorf("%s: %s", T("Error writing to tmp file: {{.Err}}", map[string]interface{}{"Err": err}), err.Error())
+			return
+		}
+
+		var request *net.Request
+		request, apiErr = r.gateway.NewRequestForFile("POST", apiURL, r.config.AccessToken(), requestFile)
+		if apiErr != nil {
+			return
+		}
+		request.HTTPReq.Header.Set("Content-Type", fmt.Sprintf("multipart/form-data; boundary=%s", boundary))
+
+		_, apiErr = r.gateway.PerformRequestForJSONResponse(request, &models.V3Package{})
+	})
+
+	return
+}
+
+func writePackageUploadBody(zipFile *os.File, body *os.File) (string, error) {
+	writer := multipart.NewWriter(body)
+
+	part, err := writer.CreateFormFile("bits", "package.zip")
+	if err != nil {
+		return "", err
+	}
+
+	_, err = io.Copy(part, zipFile)
+	if err != nil {
+		return "", err
+	}
+
+	return writer.Boundary(), writer.Close()
+}
+
+func (r *repository) GetPackage(packageGUID string) (models.V3Package, error) {
+	pkg := models.V3Package{}
+	err := r.perform("GET", fmt.Sprintf("/v3/packages/%s", packageGUID), nil, &pkg)
+	return pkg, err
+}
+
+func (r *repository) CreateBuild(packageGUID string) (models.V3Build, error) {
+	body := map[string]interface{}{
+		"package": map[string]string{"guid": packageGUID},
+	}
+
+	build := models.V3Build{}
+	err := r.perform("POST", "/v3/builds", body, &build)
+	return build, err
+}
+
+func (r *repository) GetBuild(buildGUID string) (models.V3Build, error) {
+	build := models.V3Build{}
+	err := r.perform("GET", fmt.Sprintf("/v3/builds/%s", buildGUID), nil, &build)
+	return build, err
+}
+
+func (r *repository) GetDroplets(appGUID string) ([]models.V3Droplet, error) {
+	droplets := []models.V3Droplet{}
+	err := r.getResources(fmt.Sprintf("/v3/apps/%s/droplets", appGUID), &droplets)
+	if err != nil {
+		return []models.V3Droplet{}, err
+	}
+
+	return droplets, nil
+}
+
+func (r *repository) GetCurrentDroplet(appGUID string) (models.V3Droplet, error) {
+	droplet := models.V3Droplet{}
+	err := r.perform("GET", fmt.Sprintf("/v3/apps/%s/droplets/current", appGUID), nil, &droplet)
+	if httpErr, ok := err.(errors.HTTPError); ok && httpErr.StatusCode() == 404 {
+		return models.V3Droplet{}, errors.NewModelNotFoundError("Droplet", appGUID)
+	}
+
+	return droplet, err
+}
+
+func (r *repository) SetCurrentDroplet(appGUID, dropletGUID string) error {
+	body := map[string]interface{}{
+		"data": map[string]string{"guid": dropletGUID},
+	}
+
+	return r.perform("PATCH", fmt.Sprintf("/v3/apps/%s/relationships/current_droplet", appGUID), body, nil)
+}
+
+func (r *repository) CreateTask(appGUID string, params models.V3TaskParams) (models.V3Task, error) {
+	task := models.V3Task{}
+	err := r.perform("POST", fmt.Sprintf("/v3/apps/%s/tasks", appGUID), params, &task)
+	return task, err
+}
+
+func (r *repository) GetTasks(appGUID string) ([]models.V3Task, error) {
+	tasks := []models.V3Task{}
+	err := r.getResources(fmt.Sprintf("/v3/apps/%s/tasks", appGUID), &tasks)
+	if err != nil {
+		return []models.V3Task{}, err
+	}
+
+	return tasks, nil
+}
+
+func (r *repository) CancelTask(taskGUID string) (models.V3Task, error) {
+	task := models.V3Task{}
+	err := r.perform("POST", fmt.Sprintf("/v3/tasks/%s/actions/cancel", taskGUID), nil, &task)
+	return task, err
+}
+
+// getResources fetches all pages of the list at path through the v3
+// client and decodes the resources into resources.
+func (r *repository) getResources(path string, resources interface{}) error {
+	jsonResponse, err := r.client.GetResources(path, 0)
+	if err != nil {
+		return err
+	}
+
 	r.handleUpdatedTokens()
 
-	routes := []models.V3Route{}
-	err = json.Unmarshal(jsonResponse, &routes)
+	return json.Unmarshal(jsonResponse, resources)
+}
+
+// perform sends a request with body encoded as JSON to the Cloud
+// Controller and decodes the response into response.
+func (r *repository) perform(method, path string, body interface{}, response interface{}) error {
+	var requestBody io.ReadSeeker
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("%s: %s", T("Error marshaling JSON"), err.Error())
+		}
+		requestBody = bytes.NewReader(data)
+	}
+
+	request, err := r.gateway.NewRequest(method, r.config.APIEndpoint()+path, r.config.AccessToken(), requestBody)
 	if err != nil {
-		return []models.V3Route{}, err
+		return err
 	}
 
-	return routes, nil
+	_, err = r.gateway.PerformRequestForJSONResponse(request, response)
+	return err
 }
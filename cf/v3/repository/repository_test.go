@@ -2,29 +2,44 @@ package repository_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
 
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	cferrors "github.com/cloudfoundry/cli/cf/errors"
 	"github.com/cloudfoundry/cli/cf/v3/models"
 	"github.com/cloudfoundry/cli/cf/v3/repository"
+	"github.com/cloudfoundry/cli/testhelpers/cloudcontrollergateway"
 	"github.com/cloudfoundry/cli/testhelpers/configuration"
 
 	ccClientFakes "github.com/cloudfoundry/go-ccapi/v3/client/fakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
 )
 
 var _ = Describe("Repository", func() {
 	var (
 		r        repository.Repository
 		ccClient *ccClientFakes.FakeClient
+		ccServer *ghttp.Server
 		config   coreconfig.ReadWriter
 	)
 
 	BeforeEach(func() {
 		ccClient = &ccClientFakes.FakeClient{}
+		ccServer = ghttp.NewServer()
 		config = configuration.NewRepositoryWithDefaults()
-		r = repository.NewRepository(config, ccClient)
+		config.SetAPIEndpoint(ccServer.URL())
+		gateway := cloudcontrollergateway.NewTestCloudControllerGateway(config)
+		r = repository.NewRepository(config, ccClient, gateway)
+	})
+
+	AfterEach(func() {
+		ccServer.Close()
 	})
 
 	Describe("GetApplications", func() {
@@ -56,6 +71,7 @@ var _ = Describe("Repository", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(applications).To(Equal([]models.V3Application{
 					{
+						GUID:                  "app-1-guid",
 						Name:                  "app-1-name",
 						DesiredState:          "STOPPED",
 						TotalDesiredInstances: 1,
@@ -66,9 +82,13 @@ var _ = Describe("Repository", func() {
 							Routes: models.Link{
 								Href: "/v3/apps/app-1-guid/routes",
 							},
+							Droplets: models.Link{
+								Href: "/v3/apps/app-1-guid/droplets",
+							},
 						},
 					},
 					{
+						GUID:                  "app-2-guid",
 						Name:                  "app-2-name",
 						DesiredState:          "RUNNING",
 						TotalDesiredInstances: 2,
@@ -79,6 +99,9 @@ var _ = Describe("Repository", func() {
 							Routes: models.Link{
 								Href: "/v3/apps/app-2-guid/routes",
 							},
+							Droplets: models.Link{
+								Href: "/v3/apps/app-2-guid/droplets",
+							},
 						},
 					},
 				}))
@@ -139,12 +162,14 @@ var _ = Describe("Repository", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(processes).To(Equal([]models.V3Process{
 					{
+						GUID:       "process-1-guid",
 						Type:       "web",
 						Instances:  1,
 						MemoryInMB: 1024,
 						DiskInMB:   1024,
 					},
 					{
+						GUID:       "process-2-guid",
 						Type:       "web",
 						Instances:  2,
 						MemoryInMB: 512,
@@ -208,6 +233,230 @@ var _ = Describe("Repository", func() {
 			})
 		})
 	})
+
+	Describe("GetApplicationByName", func() {
+		It("filters the applications by name and space", func() {
+			ccClient.GetApplicationsReturns(getApplicationsJSON, nil)
+
+			application, err := r.GetApplicationByName("space-guid", "app-1-name")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(application.GUID).To(Equal("app-1-guid"))
+			Expect(ccClient.GetApplicationsArgsForCall(0)).To(Equal(url.Values{
+				"names":       []string{"app-1-name"},
+				"space_guids": []string{"space-guid"},
+			}))
+		})
+
+		It("returns a ModelNotFoundError when there is no such app", func() {
+			ccClient.GetApplicationsReturns([]byte(`[]`), nil)
+
+			_, err := r.GetApplicationByName("space-guid", "app-1-name")
+			Expect(err).To(BeAssignableToTypeOf(&cferrors.ModelNotFoundError{}))
+		})
+	})
+
+	Describe("CreateApplication", func() {
+		BeforeEach(func() {
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v3/apps"),
+					ghttp.VerifyJSON(`{"name":"app-name","relationships":{"space":{"data":{"guid":"space-guid"}}}}`),
+					ghttp.RespondWith(http.StatusCreated, `{"guid":"app-guid","name":"app-name","state":"STOPPED"}`),
+				),
+			)
+		})
+
+		It("creates the app in the space", func() {
+			application, err := r.CreateApplication("space-guid", "app-name")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
+			Expect(application).To(Equal(models.V3Application{GUID: "app-guid", Name: "app-name", State: "STOPPED"}))
+		})
+	})
+
+	Describe("CreateApplication when the Cloud Controller refuses", func() {
+		BeforeEach(func() {
+			ccServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusUnprocessableEntity, `{"errors":[{"code":10008,"title":"CF-UnprocessableEntity","detail":"name must be unique in space"}]}`),
+			)
+		})
+
+		It("returns the error of the Cloud Controller", func() {
+			_, err := r.CreateApplication("space-guid", "app-name")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("name must be unique in space"))
+		})
+	})
+
+	Describe("StartApplication", func() {
+		It("starts the app", func() {
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v3/apps/app-guid/actions/start"),
+					ghttp.RespondWith(http.StatusOK, `{"guid":"app-guid"}`),
+				),
+			)
+
+			Expect(r.StartApplication("app-guid")).To(Succeed())
+			Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("ScaleProcess", func() {
+		It("scales the process of the given type", func() {
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v3/apps/app-guid/processes/worker/actions/scale"),
+					ghttp.VerifyJSON(`{"instances":3,"memory_in_mb":256}`),
+					ghttp.RespondWith(http.StatusAccepted, `{"guid":"process-guid","type":"worker","instances":3,"memory_in_mb":256,"disk_in_mb":1024}`),
+				),
+			)
+
+			instances := 3
+			memory := int64(256)
+			process, err := r.ScaleProcess("app-guid", "worker", models.V3ProcessScale{Instances: &instances, MemoryInMB: &memory})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(process).To(Equal(models.V3Process{GUID: "process-guid", Type: "worker", Instances: 3, MemoryInMB: 256, DiskInMB: 1024}))
+		})
+	})
+
+	Describe("UploadPackage", func() {
+		var zipFile *os.File
+
+		BeforeEach(func() {
+			var err error
+			zipFile, err = ioutil.TempFile("", "package")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = zipFile.WriteString("zip-contents")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = zipFile.Seek(0, 0)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			zipFile.Close()
+			os.Remove(zipFile.Name())
+		})
+
+		It("uploads the zip file as the bits of the package", func() {
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v3/packages/package-guid/upload"),
+					func(w http.ResponseWriter, req *http.Request) {
+						file, _, err := req.FormFile("bits")
+						Expect(err).NotTo(HaveOccurred())
+						contents, err := ioutil.ReadAll(file)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(contents)).To(Equal("zip-contents"))
+					},
+					ghttp.RespondWith(http.StatusOK, `{"guid":"package-guid","state":"PROCESSING_UPLOAD"}`),
+				),
+			)
+
+			Expect(r.UploadPackage("package-guid", zipFile)).To(Succeed())
+			Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("CreateBuild", func() {
+		It("stages the package", func() {
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v3/builds"),
+					ghttp.VerifyJSON(`{"package":{"guid":"package-guid"}}`),
+					ghttp.RespondWith(http.StatusCreated, `{"guid":"build-guid","state":"STAGING"}`),
+				),
+			)
+
+			build, err := r.CreateBuild("package-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build).To(Equal(models.V3Build{GUID: "build-guid", State: models.V3BuildStaging}))
+		})
+	})
+
+	Describe("GetCurrentDroplet", func() {
+		It("returns a ModelNotFoundError when the app has no current droplet", func() {
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v3/apps/app-guid/droplets/current"),
+					ghttp.RespondWith(http.StatusNotFound, `{"errors":[{"code":10010,"title":"CF-ResourceNotFound","detail":"Droplet not found"}]}`),
+				),
+			)
+
+			_, err := r.GetCurrentDroplet("app-guid")
+			Expect(err).To(BeAssignableToTypeOf(&cferrors.ModelNotFoundError{}))
+		})
+	})
+
+	Describe("SetCurrentDroplet", func() {
+		It("sets the current droplet of the app", func() {
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PATCH", "/v3/apps/app-guid/relationships/current_droplet"),
+					ghttp.VerifyJSON(`{"data":{"guid":"droplet-guid"}}`),
+					ghttp.RespondWith(http.StatusOK, `{"data":{"guid":"droplet-guid"}}`),
+				),
+			)
+
+			Expect(r.SetCurrentDroplet("app-guid", "droplet-guid")).To(Succeed())
+			Expect(ccServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("GetDroplets", func() {
+		It("lists the droplets of the app", func() {
+			ccClient.GetResourcesReturns([]byte(`[{"guid":"droplet-guid","state":"STAGED"}]`), nil)
+
+			droplets, err := r.GetDroplets("app-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(droplets).To(Equal([]models.V3Droplet{{GUID: "droplet-guid", State: "STAGED"}}))
+			path, _ := ccClient.GetResourcesArgsForCall(0)
+			Expect(path).To(Equal("/v3/apps/app-guid/droplets"))
+		})
+	})
+
+	Describe("CreateTask", func() {
+		It("runs the task on the app", func() {
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v3/apps/app-guid/tasks"),
+					ghttp.VerifyJSON(`{"command":"rake db:migrate","name":"migrate"}`),
+					ghttp.RespondWith(http.StatusAccepted, `{"guid":"task-guid","sequence_id":3,"name":"migrate","state":"RUNNING"}`),
+				),
+			)
+
+			task, err := r.CreateTask("app-guid", models.V3TaskParams{Command: "rake db:migrate", Name: "migrate"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(task).To(Equal(models.V3Task{GUID: "task-guid", SequenceID: 3, Name: "migrate", State: "RUNNING"}))
+		})
+	})
+
+	Describe("GetTasks", func() {
+		It("lists the tasks of the app", func() {
+			ccClient.GetResourcesReturns([]byte(`[{"guid":"task-guid","sequence_id":1}]`), nil)
+
+			tasks, err := r.GetTasks("app-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tasks).To(Equal([]models.V3Task{{GUID: "task-guid", SequenceID: 1}}))
+			path, _ := ccClient.GetResourcesArgsForCall(0)
+			Expect(path).To(Equal("/v3/apps/app-guid/tasks"))
+		})
+	})
+
+	Describe("CancelTask", func() {
+		It("cancels the task", func() {
+			ccServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v3/tasks/task-guid/actions/cancel"),
+					ghttp.RespondWith(http.StatusAccepted, `{"guid":"task-guid","state":"CANCELING"}`),
+				),
+			)
+
+			task, err := r.CancelTask("task-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(task.State).To(Equal("CANCELING"))
+		})
+	})
 })
 
 var getApplicationsJSON = []byte(`[
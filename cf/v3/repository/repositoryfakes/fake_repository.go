@@ -2,6 +2,7 @@
 package repositoryfakes
 
 import (
+	"os"
 	"sync"
 
 	"github.com/cloudfoundry/cli/cf/v3/models"
@@ -16,6 +17,42 @@ type FakeRepository struct {
 		result1 []models.V3Application
 		result2 error
 	}
+	GetApplicationByNameStub        func(spaceGUID, name string) (models.V3Application, error)
+	getApplicationByNameMutex       sync.RWMutex
+	getApplicationByNameArgsForCall []struct {
+		spaceGUID string
+		name      string
+	}
+	getApplicationByNameReturns struct {
+		result1 models.V3Application
+		result2 error
+	}
+	CreateApplicationStub        func(spaceGUID, name string) (models.V3Application, error)
+	createApplicationMutex       sync.RWMutex
+	createApplicationArgsForCall []struct {
+		spaceGUID string
+		name      string
+	}
+	createApplicationReturns struct {
+		result1 models.V3Application
+		result2 error
+	}
+	StartApplicationStub        func(appGUID string) error
+	startApplicationMutex       sync.RWMutex
+	startApplicationArgsForCall []struct {
+		appGUID string
+	}
+	startApplicationReturns struct {
+		result1 error
+	}
+	StopApplicationStub        func(appGUID string) error
+	stopApplicationMutex       sync.RWMutex
+	stopApplicationArgsForCall []struct {
+		appGUID string
+	}
+	stopApplicationReturns struct {
+		result1 error
+	}
 	GetProcessesStub        func(path string) ([]models.V3Process, error)
 	getProcessesMutex       sync.RWMutex
 	getProcessesArgsForCall []struct {
@@ -25,6 +62,17 @@ type FakeRepository struct {
 		result1 []models.V3Process
 		result2 error
 	}
+	ScaleProcessStub        func(appGUID, processType string, scale models.V3ProcessScale) (models.V3Process, error)
+	scaleProcessMutex       sync.RWMutex
+	scaleProcessArgsForCall []struct {
+		appGUID     string
+		processType string
+		scale       models.V3ProcessScale
+	}
+	scaleProcessReturns struct {
+		result1 models.V3Process
+		result2 error
+	}
 	GetRoutesStub        func(path string) ([]models.V3Route, error)
 	getRoutesMutex       sync.RWMutex
 	getRoutesArgsForCall []struct {
@@ -34,6 +82,106 @@ type FakeRepository struct {
 		result1 []models.V3Route
 		result2 error
 	}
+	CreatePackageStub        func(appGUID string) (models.V3Package, error)
+	createPackageMutex       sync.RWMutex
+	createPackageArgsForCall []struct {
+		appGUID string
+	}
+	createPackageReturns struct {
+		result1 models.V3Package
+		result2 error
+	}
+	UploadPackageStub        func(packageGUID string, zipFile *os.File) error
+	uploadPackageMutex       sync.RWMutex
+	uploadPackageArgsForCall []struct {
+		packageGUID string
+		zipFile     *os.File
+	}
+	uploadPackageReturns struct {
+		result1 error
+	}
+	GetPackageStub        func(packageGUID string) (models.V3Package, error)
+	getPackageMutex       sync.RWMutex
+	getPackageArgsForCall []struct {
+		packageGUID string
+	}
+	getPackageReturns struct {
+		result1 models.V3Package
+		result2 error
+	}
+	CreateBuildStub        func(packageGUID string) (models.V3Build, error)
+	createBuildMutex       sync.RWMutex
+	createBuildArgsForCall []struct {
+		packageGUID string
+	}
+	createBuildReturns struct {
+		result1 models.V3Build
+		result2 error
+	}
+	GetBuildStub        func(buildGUID string) (models.V3Build, error)
+	getBuildMutex       sync.RWMutex
+	getBuildArgsForCall []struct {
+		buildGUID string
+	}
+	getBuildReturns struct {
+		result1 models.V3Build
+		result2 error
+	}
+	GetDropletsStub        func(appGUID string) ([]models.V3Droplet, error)
+	getDropletsMutex       sync.RWMutex
+	getDropletsArgsForCall []struct {
+		appGUID string
+	}
+	getDropletsReturns struct {
+		result1 []models.V3Droplet
+		result2 error
+	}
+	GetCurrentDropletStub        func(appGUID string) (models.V3Droplet, error)
+	getCurrentDropletMutex       sync.RWMutex
+	getCurrentDropletArgsForCall []struct {
+		appGUID string
+	}
+	getCurrentDropletReturns struct {
+		result1 models.V3Droplet
+		result2 error
+	}
+	SetCurrentDropletStub        func(appGUID, dropletGUID string) error
+	setCurrentDropletMutex       sync.RWMutex
+	setCurrentDropletArgsForCall []struct {
+		appGUID     string
+		dropletGUID string
+	}
+	setCurrentDropletReturns struct {
+		result1 error
+	}
+	CreateTaskStub        func(appGUID string, params models.V3TaskParams) (models.V3Task, error)
+	createTaskMutex       sync.RWMutex
+	createTaskArgsForCall []struct {
+		appGUID string
+		params  models.V3TaskParams
+	}
+	createTaskReturns struct {
+		result1 models.V3Task
+		result2 error
+	}
+	GetTasksStub        func(appGUID string) ([]models.V3Task, error)
+	getTasksMutex       sync.RWMutex
+	getTasksArgsForCall []struct {
+		appGUID string
+	}
+	getTasksReturns struct {
+		result1 []models.V3Task
+		result2 error
+	}
+	CancelTaskStub        func(taskGUID string) (models.V3Task, error)
+	cancelTaskMutex       sync.RWMutex
+	cancelTaskArgsForCall []struct {
+		taskGUID string
+	}
+	cancelTaskReturns struct {
+		result1 models.V3Task
+		result2 error
+	}
 }
 
 func (fake *FakeRepository) GetApplications() ([]models.V3Application, error) {
@@ -61,6 +209,138 @@ func (fake *FakeRepository) GetApplicationsReturns(result1 []models.V3Applicatio
 	}{result1, result2}
 }
 
+func (fake *FakeRepository) GetApplicationByName(spaceGUID string, name string) (models.V3Application, error) {
+	fake.getApplicationByNameMutex.Lock()
+	fake.getApplicationByNameArgsForCall = append(fake.getApplicationByNameArgsForCall, struct {
+		spaceGUID string
+		name      string
+	}{spaceGUID, name})
+	fake.getApplicationByNameMutex.Unlock()
+	if fake.GetApplicationByNameStub != nil {
+		return fake.GetApplicationByNameStub(spaceGUID, name)
+	} else {
+		return fake.getApplicationByNameReturns.result1, fake.getApplicationByNameReturns.result2
+	}
+}
+
+func (fake *FakeRepository) GetApplicationByNameCallCount() int {
+	fake.getApplicationByNameMutex.RLock()
+	defer fake.getApplicationByNameMutex.RUnlock()
+	return len(fake.getApplicationByNameArgsForCall)
+}
+
+func (fake *FakeRepository) GetApplicationByNameArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameMutex.RLock()
+	defer fake.getApplicationByNameMutex.RUnlock()
+	return fake.getApplicationByNameArgsForCall[i].spaceGUID, fake.getApplicationByNameArgsForCall[i].name
+}
+
+func (fake *FakeRepository) GetApplicationByNameReturns(result1 models.V3Application, result2 error) {
+	fake.GetApplicationByNameStub = nil
+	fake.getApplicationByNameReturns = struct {
+		result1 models.V3Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) CreateApplication(spaceGUID string, name string) (models.V3Application, error) {
+	fake.createApplicationMutex.Lock()
+	fake.createApplicationArgsForCall = append(fake.createApplicationArgsForCall, struct {
+		spaceGUID string
+		name      string
+	}{spaceGUID, name})
+	fake.createApplicationMutex.Unlock()
+	if fake.CreateApplicationStub != nil {
+		return fake.CreateApplicationStub(spaceGUID, name)
+	} else {
+		return fake.createApplicationReturns.result1, fake.createApplicationReturns.result2
+	}
+}
+
+func (fake *FakeRepository) CreateApplicationCallCount() int {
+	fake.createApplicationMutex.RLock()
+	defer fake.createApplicationMutex.RUnlock()
+	return len(fake.createApplicationArgsForCall)
+}
+
+func (fake *FakeRepository) CreateApplicationArgsForCall(i int) (string, string) {
+	fake.createApplicationMutex.RLock()
+	defer fake.createApplicationMutex.RUnlock()
+	return fake.createApplicationArgsForCall[i].spaceGUID, fake.createApplicationArgsForCall[i].name
+}
+
+func (fake *FakeRepository) CreateApplicationReturns(result1 models.V3Application, result2 error) {
+	fake.CreateApplicationStub = nil
+	fake.createApplicationReturns = struct {
+		result1 models.V3Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) StartApplication(appGUID string) error {
+	fake.startApplicationMutex.Lock()
+	fake.startApplicationArgsForCall = append(fake.startApplicationArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.startApplicationMutex.Unlock()
+	if fake.StartApplicationStub != nil {
+		return fake.StartApplicationStub(appGUID)
+	} else {
+		return fake.startApplicationReturns.result1
+	}
+}
+
+func (fake *FakeRepository) StartApplicationCallCount() int {
+	fake.startApplicationMutex.RLock()
+	defer fake.startApplicationMutex.RUnlock()
+	return len(fake.startApplicationArgsForCall)
+}
+
+func (fake *FakeRepository) StartApplicationArgsForCall(i int) string {
+	fake.startApplicationMutex.RLock()
+	defer fake.startApplicationMutex.RUnlock()
+	return fake.startApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeRepository) StartApplicationReturns(result1 error) {
+	fake.StartApplicationStub = nil
+	fake.startApplicationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) StopApplication(appGUID string) error {
+	fake.stopApplicationMutex.Lock()
+	fake.stopApplicationArgsForCall = append(fake.stopApplicationArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.stopApplicationMutex.Unlock()
+	if fake.StopApplicationStub != nil {
+		return fake.StopApplicationStub(appGUID)
+	} else {
+		return fake.stopApplicationReturns.result1
+	}
+}
+
+func (fake *FakeRepository) StopApplicationCallCount() int {
+	fake.stopApplicationMutex.RLock()
+	defer fake.stopApplicationMutex.RUnlock()
+	return len(fake.stopApplicationArgsForCall)
+}
+
+func (fake *FakeRepository) StopApplicationArgsForCall(i int) string {
+	fake.stopApplicationMutex.RLock()
+	defer fake.stopApplicationMutex.RUnlock()
+	return fake.stopApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeRepository) StopApplicationReturns(result1 error) {
+	fake.StopApplicationStub = nil
+	fake.stopApplicationReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRepository) GetProcesses(path string) ([]models.V3Process, error) {
 	fake.getProcessesMutex.Lock()
 	fake.getProcessesArgsForCall = append(fake.getProcessesArgsForCall, struct {
@@ -94,6 +374,41 @@ func (fake *FakeRepository) GetProcessesReturns(result1 []models.V3Process, resu
 	}{result1, result2}
 }
 
+func (fake *FakeRepository) ScaleProcess(appGUID string, processType string, scale models.V3ProcessScale) (models.V3Process, error) {
+	fake.scaleProcessMutex.Lock()
+	fake.scaleProcessArgsForCall = append(fake.scaleProcessArgsForCall, struct {
+		appGUID     string
+		processType string
+		scale       models.V3ProcessScale
+	}{appGUID, processType, scale})
+	fake.scaleProcessMutex.Unlock()
+	if fake.ScaleProcessStub != nil {
+		return fake.ScaleProcessStub(appGUID, processType, scale)
+	} else {
+		return fake.scaleProcessReturns.result1, fake.scaleProcessReturns.result2
+	}
+}
+
+func (fake *FakeRepository) ScaleProcessCallCount() int {
+	fake.scaleProcessMutex.RLock()
+	defer fake.scaleProcessMutex.RUnlock()
+	return len(fake.scaleProcessArgsForCall)
+}
+
+func (fake *FakeRepository) ScaleProcessArgsForCall(i int) (string, string, models.V3ProcessScale) {
+	fake.scaleProcessMutex.RLock()
+	defer fake.scaleProcessMutex.RUnlock()
+	return fake.scaleProcessArgsForCall[i].appGUID, fake.scaleProcessArgsForCall[i].processType, fake.scaleProcessArgsForCall[i].scale
+}
+
+func (fake *FakeRepository) ScaleProcessReturns(result1 models.V3Process, result2 error) {
+	fake.ScaleProcessStub = nil
+	fake.scaleProcessReturns = struct {
+		result1 models.V3Process
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRepository) GetRoutes(path string) ([]models.V3Route, error) {
 	fake.getRoutesMutex.Lock()
 	fake.getRoutesArgsForCall = append(fake.getRoutesArgsForCall, struct {
@@ -127,4 +442,368 @@ func (fake *FakeRepository) GetRoutesReturns(result1 []models.V3Route, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeRepository) CreatePackage(appGUID string) (models.V3Package, error) {
+	fake.createPackageMutex.Lock()
+	fake.createPackageArgsForCall = append(fake.createPackageArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.createPackageMutex.Unlock()
+	if fake.CreatePackageStub != nil {
+		return fake.CreatePackageStub(appGUID)
+	} else {
+		return fake.createPackageReturns.result1, fake.createPackageReturns.result2
+	}
+}
+
+func (fake *FakeRepository) CreatePackageCallCount() int {
+	fake.createPackageMutex.RLock()
+	defer fake.createPackageMutex.RUnlock()
+	return len(fake.createPackageArgsForCall)
+}
+
+func (fake *FakeRepository) CreatePackageArgsForCall(i int) string {
+	fake.createPackageMutex.RLock()
+	defer fake.createPackageMutex.RUnlock()
+	return fake.createPackageArgsForCall[i].appGUID
+}
+
+func (fake *FakeRepository) CreatePackageReturns(result1 models.V3Package, result2 error) {
+	fake.CreatePackageStub = nil
+	fake.createPackageReturns = struct {
+		result1 models.V3Package
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) UploadPackage(packageGUID string, zipFile *os.File) error {
+	fake.uploadPackageMutex.Lock()
+	fake.uploadPackageArgsForCall = append(fake.uploadPackageArgsForCall, struct {
+		packageGUID string
+		zipFile     *os.File
+	}{packageGUID, zipFile})
+	fake.uploadPackageMutex.Unlock()
+	if fake.UploadPackageStub != nil {
+		return fake.UploadPackageStub(packageGUID, zipFile)
+	} else {
+		return fake.uploadPackageReturns.result1
+	}
+}
+
+func (fake *FakeRepository) UploadPackageCallCount() int {
+	fake.uploadPackageMutex.RLock()
+	defer fake.uploadPackageMutex.RUnlock()
+	return len(fake.uploadPackageArgsForCall)
+}
+
+func (fake *FakeRepository) UploadPackageArgsForCall(i int) (string, *os.File) {
+	fake.uploadPackageMutex.RLock()
+	defer fake.uploadPackageMutex.RUnlock()
+	return fake.uploadPackageArgsForCall[i].packageGUID, fake.uploadPackageArgsForCall[i].zipFile
+}
+
+func (fake *FakeRepository) UploadPackageReturns(result1 error) {
+	fake.UploadPackageStub = nil
+	fake.uploadPackageReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) GetPackage(packageGUID string) (models.V3Package, error) {
+	fake.getPackageMutex.Lock()
+	fake.getPackageArgsForCall = append(fake.getPackageArgsForCall, struct {
+		packageGUID string
+	}{packageGUID})
+	fake.getPackageMutex.Unlock()
+	if fake.GetPackageStub != nil {
+		return fake.GetPackageStub(packageGUID)
+	} else {
+		return fake.getPackageReturns.result1, fake.getPackageReturns.result2
+	}
+}
+
+func (fake *FakeRepository) GetPackageCallCount() int {
+	fake.getPackageMutex.RLock()
+	defer fake.getPackageMutex.RUnlock()
+	return len(fake.getPackageArgsForCall)
+}
+
+func (fake *FakeRepository) GetPackageArgsForCall(i int) string {
+	fake.getPackageMutex.RLock()
+	defer fake.getPackageMutex.RUnlock()
+	return fake.getPackageArgsForCall[i].packageGUID
+}
+
+func (fake *FakeRepository) GetPackageReturns(result1 models.V3Package, result2 error) {
+	fake.GetPackageStub = nil
+	fake.getPackageReturns = struct {
+		result1 models.V3Package
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) CreateBuild(packageGUID string) (models.V3Build, error) {
+	fake.createBuildMutex.Lock()
+	fake.createBuildArgsForCall = append(fake.createBuildArgsForCall, struct {
+		packageGUID string
+	}{packageGUID})
+	fake.createBuildMutex.Unlock()
+	if fake.CreateBuildStub != nil {
+		return fake.CreateBuildStub(packageGUID)
+	} else {
+		return fake.createBuildReturns.result1, fake.createBuildReturns.result2
+	}
+}
+
+func (fake *FakeRepository) CreateBuildCallCount() int {
+	fake.createBuildMutex.RLock()
+	defer fake.createBuildMutex.RUnlock()
+	return len(fake.createBuildArgsForCall)
+}
+
+func (fake *FakeRepository) CreateBuildArgsForCall(i int) string {
+	fake.createBuildMutex.RLock()
+	defer fake.createBuildMutex.RUnlock()
+	return fake.createBuildArgsForCall[i].packageGUID
+}
+
+func (fake *FakeRepository) CreateBuildReturns(result1 models.V3Build, result2 error) {
+	fake.CreateBuildStub = nil
+	fake.createBuildReturns = struct {
+		result1 models.V3Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetBuild(buildGUID string) (models.V3Build, error) {
+	fake.getBuildMutex.Lock()
+	fake.getBuildArgsForCall = append(fake.getBuildArgsForCall, struct {
+		buildGUID string
+	}{buildGUID})
+	fake.getBuildMutex.Unlock()
+	if fake.GetBuildStub != nil {
+		return fake.GetBuildStub(buildGUID)
+	} else {
+		return fake.getBuildReturns.result1, fake.getBuildReturns.result2
+	}
+}
+
+func (fake *FakeRepository) GetBuildCallCount() int {
+	fake.getBuildMutex.RLock()
+	defer fake.getBuildMutex.RUnlock()
+	return len(fake.getBuildArgsForCall)
+}
+
+func (fake *FakeRepository) GetBuildArgsForCall(i int) string {
+	fake.getBuildMutex.RLock()
+	defer fake.getBuildMutex.RUnlock()
+	return fake.getBuildArgsForCall[i].buildGUID
+}
+
+func (fake *FakeRepository) GetBuildReturns(result1 models.V3Build, result2 error) {
+	fake.GetBuildStub = nil
+	fake.getBuildReturns = struct {
+		result1 models.V3Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetDroplets(appGUID string) ([]models.V3Droplet, error) {
+	fake.getDropletsMutex.Lock()
+	fake.getDropletsArgsForCall = append(fake.getDropletsArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.getDropletsMutex.Unlock()
+	if fake.GetDropletsStub != nil {
+		return fake.GetDropletsStub(appGUID)
+	} else {
+		return fake.getDropletsReturns.result1, fake.getDropletsReturns.result2
+	}
+}
+
+func (fake *FakeRepository) GetDropletsCallCount() int {
+	fake.getDropletsMutex.RLock()
+	defer fake.getDropletsMutex.RUnlock()
+	return len(fake.getDropletsArgsForCall)
+}
+
+func (fake *FakeRepository) GetDropletsArgsForCall(i int) string {
+	fake.getDropletsMutex.RLock()
+	defer fake.getDropletsMutex.RUnlock()
+	return fake.getDropletsArgsForCall[i].appGUID
+}
+
+func (fake *FakeRepository) GetDropletsReturns(result1 []models.V3Droplet, result2 error) {
+	fake.GetDropletsStub = nil
+	fake.getDropletsReturns = struct {
+		result1 []models.V3Droplet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetCurrentDroplet(appGUID string) (models.V3Droplet, error) {
+	fake.getCurrentDropletMutex.Lock()
+	fake.getCurrentDropletArgsForCall = append(fake.getCurrentDropletArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.getCurrentDropletMutex.Unlock()
+	if fake.GetCurrentDropletStub != nil {
+		return fake.GetCurrentDropletStub(appGUID)
+	} else {
+		return fake.getCurrentDropletReturns.result1, fake.getCurrentDropletReturns.result2
+	}
+}
+
+func (fake *FakeRepository) GetCurrentDropletCallCount() int {
+	fake.getCurrentDropletMutex.RLock()
+	defer fake.getCurrentDropletMutex.RUnlock()
+	return len(fake.getCurrentDropletArgsForCall)
+}
+
+func (fake *FakeRepository) GetCurrentDropletArgsForCall(i int) string {
+	fake.getCurrentDropletMutex.RLock()
+	defer fake.getCurrentDropletMutex.RUnlock()
+	return fake.getCurrentDropletArgsForCall[i].appGUID
+}
+
+func (fake *FakeRepository) GetCurrentDropletReturns(result1 models.V3Droplet, result2 error) {
+	fake.GetCurrentDropletStub = nil
+	fake.getCurrentDropletReturns = struct {
+		result1 models.V3Droplet
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) SetCurrentDroplet(appGUID string, dropletGUID string) error {
+	fake.setCurrentDropletMutex.Lock()
+	fake.setCurrentDropletArgsForCall = append(fake.setCurrentDropletArgsForCall, struct {
+		appGUID     string
+		dropletGUID string
+	}{appGUID, dropletGUID})
+	fake.setCurrentDropletMutex.Unlock()
+	if fake.SetCurrentDropletStub != nil {
+		return fake.SetCurrentDropletStub(appGUID, dropletGUID)
+	} else {
+		return fake.setCurrentDropletReturns.result1
+	}
+}
+
+func (fake *FakeRepository) SetCurrentDropletCallCount() int {
+	fake.setCurrentDropletMutex.RLock()
+	defer fake.setCurrentDropletMutex.RUnlock()
+	return len(fake.setCurrentDropletArgsForCall)
+}
+
+func (fake *FakeRepository) SetCurrentDropletArgsForCall(i int) (string, string) {
+	fake.setCurrentDropletMutex.RLock()
+	defer fake.setCurrentDropletMutex.RUnlock()
+	return fake.setCurrentDropletArgsForCall[i].appGUID, fake.setCurrentDropletArgsForCall[i].dropletGUID
+}
+
+func (fake *FakeRepository) SetCurrentDropletReturns(result1 error) {
+	fake.SetCurrentDropletStub = nil
+	fake.setCurrentDropletReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRepository) CreateTask(appGUID string, params models.V3TaskParams) (models.V3Task, error) {
+	fake.createTaskMutex.Lock()
+	fake.createTaskArgsForCall = append(fake.createTaskArgsForCall, struct {
+		appGUID string
+		params  models.V3TaskParams
+	}{appGUID, params})
+	fake.createTaskMutex.Unlock()
+	if fake.CreateTaskStub != nil {
+		return fake.CreateTaskStub(appGUID, params)
+	} else {
+		return fake.createTaskReturns.result1, fake.createTaskReturns.result2
+	}
+}
+
+func (fake *FakeRepository) CreateTaskCallCount() int {
+	fake.createTaskMutex.RLock()
+	defer fake.createTaskMutex.RUnlock()
+	return len(fake.createTaskArgsForCall)
+}
+
+func (fake *FakeRepository) CreateTaskArgsForCall(i int) (string, models.V3TaskParams) {
+	fake.createTaskMutex.RLock()
+	defer fake.createTaskMutex.RUnlock()
+	return fake.createTaskArgsForCall[i].appGUID, fake.createTaskArgsForCall[i].params
+}
+
+func (fake *FakeRepository) CreateTaskReturns(result1 models.V3Task, result2 error) {
+	fake.CreateTaskStub = nil
+	fake.createTaskReturns = struct {
+		result1 models.V3Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) GetTasks(appGUID string) ([]models.V3Task, error) {
+	fake.getTasksMutex.Lock()
+	fake.getTasksArgsForCall = append(fake.getTasksArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.getTasksMutex.Unlock()
+	if fake.GetTasksStub != nil {
+		return fake.GetTasksStub(appGUID)
+	} else {
+		return fake.getTasksReturns.result1, fake.getTasksReturns.result2
+	}
+}
+
+func (fake *FakeRepository) GetTasksCallCount() int {
+	fake.getTasksMutex.RLock()
+	defer fake.getTasksMutex.RUnlock()
+	return len(fake.getTasksArgsForCall)
+}
+
+func (fake *FakeRepository) GetTasksArgsForCall(i int) string {
+	fake.getTasksMutex.RLock()
+	defer fake.getTasksMutex.RUnlock()
+	return fake.getTasksArgsForCall[i].appGUID
+}
+
+func (fake *FakeRepository) GetTasksReturns(result1 []models.V3Task, result2 error) {
+	fake.GetTasksStub = nil
+	fake.getTasksReturns = struct {
+		result1 []models.V3Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRepository) CancelTask(taskGUID string) (models.V3Task, error) {
+	fake.cancelTaskMutex.Lock()
+	fake.cancelTaskArgsForCall = append(fake.cancelTaskArgsForCall, struct {
+		taskGUID string
+	}{taskGUID})
+	fake.cancelTaskMutex.Unlock()
+	if fake.CancelTaskStub != nil {
+		return fake.CancelTaskStub(taskGUID)
+	} else {
+		return fake.cancelTaskReturns.result1, fake.cancelTaskReturns.result2
+	}
+}
+
+func (fake *FakeRepository) CancelTaskCallCount() int {
+	fake.cancelTaskMutex.RLock()
+	defer fake.cancelTaskMutex.RUnlock()
+	return len(fake.cancelTaskArgsForCall)
+}
+
+func (fake *FakeRepository) CancelTaskArgsForCall(i int) string {
+	fake.cancelTaskMutex.RLock()
+	defer fake.cancelTaskMutex.RUnlock()
+	return fake.cancelTaskArgsForCall[i].taskGUID
+}
+
+func (fake *FakeRepository) CancelTaskReturns(result1 models.V3Task, result2 error) {
+	fake.CancelTaskStub = nil
+	fake.cancelTaskReturns = struct {
+		result1 models.V3Task
+		result2 error
+	}{result1, result2}
+}
+
 var _ repository.Repository = new(FakeRepository)
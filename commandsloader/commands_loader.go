@@ -22,6 +22,7 @@ import (
 	"github.com/cloudfoundry/cli/cf/commands/space"
 	"github.com/cloudfoundry/cli/cf/commands/spacequota"
 	"github.com/cloudfoundry/cli/cf/commands/user"
+	"github.com/cloudfoundry/cli/cf/commands/v3"
 )
 
 /*******************
@@ -54,4 +55,5 @@ func Load() {
 	_ = space.CreateSpace{}
 	_ = spacequota.SpaceQuota{}
 	_ = user.CreateUser{}
+	_ = v3.V3Push{}
 }
@@ -0,0 +1,67 @@
+/**
+	* Plugin that exits in the ways the CLI has to pass on: with an exit status,
+	* after writing to stderr, when a signal kills it, and after handling a signal.
+**/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"github.com/cloudfoundry/cli/plugin"
+)
+
+type ExitStatus struct {
+}
+
+func (c *ExitStatus) Run(cliConnection plugin.CliConnection, args []string) {
+	switch args[0] {
+	case "exit-status":
+		status, _ := strconv.Atoi(args[1])
+		fmt.Fprintf(os.Stderr, "exiting with status %d\n", status)
+		os.Exit(status)
+	case "kill-self":
+		self, _ := os.FindProcess(os.Getpid())
+		_ = self.Kill()
+		select {}
+	case "wait-for-signal":
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		fmt.Println("waiting for a signal")
+
+		sig := <-signals
+		fmt.Printf("received %s\n", sig)
+		if sig == syscall.SIGTERM {
+			os.Exit(3)
+		}
+		os.Exit(4)
+	}
+}
+
+func (c *ExitStatus) GetMetadata() plugin.PluginMetadata {
+	return plugin.PluginMetadata{
+		Name: "ExitStatus",
+		Commands: []plugin.Command{
+			{
+				Name:     "exit-status",
+				HelpText: "exits with the status given",
+			},
+			{
+				Name:     "kill-self",
+				HelpText: "kills itself",
+			},
+			{
+				Name:     "wait-for-signal",
+				HelpText: "waits for a signal",
+			},
+		},
+	}
+}
+
+func main() {
+	plugin.Start(new(ExitStatus))
+}
@@ -1,15 +1,18 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
 
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/confighelpers"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/configuration/pluginconfig"
+	"github.com/cloudfoundry/cli/cf/formatters"
 	. "github.com/cloudfoundry/cli/cf/i18n"
 	"github.com/cloudfoundry/cli/cf/net"
 	"github.com/cloudfoundry/cli/cf/panicprinter"
@@ -25,6 +28,7 @@ var cmdRegistry = commandregistry.Commands
 
 func main() {
 	traceEnv := os.Getenv("CF_TRACE")
+	traceEnvErr := handleTraceEnv()
 	traceLogger := trace.NewLogger(Writer, false, traceEnv, "")
 
 	//handle `cf -v` for cf version
@@ -51,6 +55,23 @@ func main() {
 			ui.Failed(fmt.Sprintf("Config error: %s", err))
 		}
 	}
+	// errFunc fails before deps exist, e.g. when the --profile is unknown
+	defer handlePanics(terminal.NewTeePrinter(Writer), traceLogger)
+
+	if traceEnvErr != nil {
+		errFunc(traceEnvErr)
+	}
+
+	//handles `cf --profile NAME ...`
+	//the profile is picked up by confighelpers through CF_PROFILE
+	newArgs, profile, err := handleProfile(os.Args)
+	if err != nil {
+		errFunc(err)
+	}
+	os.Args = newArgs
+	if profile != "" {
+		os.Setenv("CF_PROFILE", profile)
+	}
 
 	// Only used to get Trace, so our errorHandler doesn't matter, since it's not used
 	configPath, err := confighelpers.DefaultFilePath()
@@ -96,6 +117,19 @@ func main() {
 		flagContext.SkipFlagParsing(meta.SkipFlagParsing)
 
 		cmdArgs := os.Args[2:]
+		if _, ok := meta.Flags["output"]; !ok {
+			var outputFormat terminal.OutputFormat
+			cmdArgs, outputFormat, err = handleOutputFormat(cmdArgs)
+			if err != nil {
+				deps.UI.Failed(err.Error())
+			}
+			if outputFormat.IsStructured() && !meta.Records {
+				deps.UI.Failed(T("Command {{.Command}} does not support --output {{.Format}}",
+					map[string]interface{}{"Command": meta.Name, "Format": string(outputFormat)}))
+			}
+			deps.UI.SetOutputFormat(outputFormat)
+		}
+
 		err = flagContext.Parse(cmdArgs...)
 		if err != nil {
 			usage := cmdRegistry.CommandUsage(cmdName)
@@ -151,6 +185,9 @@ func handlePanics(printer terminal.Printer, logger trace.Printer) {
 	err := recover()
 	panicprinter.DisplayCrashDialog(err, commandArgs, stackTrace)
 
+	if code, ok := err.(terminal.QuietExit); ok {
+		os.Exit(int(code))
+	}
 	if err != nil {
 		os.Exit(1)
 	}
@@ -209,3 +246,91 @@ func handleVerbose(args []string) ([]string, bool) {
 
 	return args, verbose
 }
+
+// handleTraceEnv sets up the rotation of trace files and the redaction of
+// traces from CF_TRACE_MAX_SIZE, CF_TRACE_MAX_FILES and CF_TRACE_REDACT.
+func handleTraceEnv() error {
+	if size := os.Getenv("CF_TRACE_MAX_SIZE"); size == "0" {
+		trace.MaxFileSize = 0
+	} else if size != "" {
+		megabytes, err := formatters.ToMegabytes(size)
+		if err != nil || megabytes < 0 {
+			return errors.New(T("Invalid CF_TRACE_MAX_SIZE {{.Size}}: use a size like 10M, or 0 to disable rotation", map[string]interface{}{"Size": size}))
+		}
+		trace.MaxFileSize = megabytes * formatters.MEGABYTE
+	}
+
+	if files := os.Getenv("CF_TRACE_MAX_FILES"); files != "" {
+		count, err := strconv.Atoi(files)
+		if err != nil || count < 0 {
+			return errors.New(T("Invalid CF_TRACE_MAX_FILES {{.Files}}: use a number of files", map[string]interface{}{"Files": files}))
+		}
+		trace.MaxFileBackups = count
+	}
+
+	for _, field := range strings.Split(os.Getenv("CF_TRACE_REDACT"), ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			trace.RedactedFields = append(trace.RedactedFields, field)
+		}
+	}
+
+	return nil
+}
+
+// handleProfile takes the --profile option off the arguments. Like --build,
+// it is only accepted before the command name, so that it never takes an
+// option meant for a command or a plugin.
+func handleProfile(args []string) ([]string, string, error) {
+	if len(args) < 2 {
+		return args, "", nil
+	}
+
+	var value string
+	var rest []string
+
+	switch {
+	case args[1] == "--profile":
+		if len(args) < 3 {
+			return args, "", errors.New(T("--profile requires a profile name"))
+		}
+		value, rest = args[2], args[3:]
+	case strings.HasPrefix(args[1], "--profile="):
+		value, rest = strings.TrimPrefix(args[1], "--profile="), args[2:]
+	default:
+		return args, "", nil
+	}
+
+	if len(rest) == 0 {
+		rest = []string{"help"}
+	}
+
+	return append([]string{args[0]}, rest...), value, nil
+}
+
+func handleOutputFormat(args []string) ([]string, terminal.OutputFormat, error) {
+	for i, arg := range args {
+		var value string
+		var end int
+
+		switch {
+		case arg == "--output":
+			if i+1 >= len(args) {
+				return args, terminal.TableOutput, errors.New(T("--output requires a format: json, yaml or table"))
+			}
+			value, end = args[i+1], i+2
+		case strings.HasPrefix(arg, "--output="):
+			value, end = strings.TrimPrefix(arg, "--output="), i+1
+		default:
+			continue
+		}
+
+		format, err := terminal.ParseOutputFormat(value)
+		if err != nil {
+			return args, terminal.TableOutput, err
+		}
+
+		return append(append([]string{}, args[:i]...), args[end:]...), format, nil
+	}
+
+	return args, terminal.TableOutput, nil
+}
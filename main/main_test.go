@@ -5,6 +5,8 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
+	"syscall"
 	"time"
 
 	. "github.com/onsi/ginkgo"
@@ -128,6 +130,20 @@ var _ = Describe("main", func() {
 		})
 	})
 
+	Describe("Target profiles with --profile", func() {
+		It("rejects profile names that leave the profiles directory", func() {
+			session := Cf("--profile", "../../x", "target").Wait(5 * time.Second)
+			Eventually(session).Should(Exit(1))
+			Eventually(session.Out).Should(Say("Invalid profile name '../../x'"))
+		})
+
+		It("leaves a --profile after the command name to the command", func() {
+			session := Cf("my-say", "--profile=work").Wait(5 * time.Second)
+			Eventually(session.Out).Should(Say("--profile=work"))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+
 	Describe("Commands /w new command structure", func() {
 		It("prints usage help for all commands by providing `help` flag", func() {
 			output := Cf("api", "-h").Wait(1 * time.Second)
@@ -169,6 +185,14 @@ var _ = Describe("main", func() {
 		})
 	})
 
+	Describe("Structured output with --output", func() {
+		It("rejects json and yaml for commands that do not print records", func() {
+			session := Cf("target", "--output", "json").Wait(5 * time.Second)
+			Eventually(session).Should(Exit(1))
+			Eventually(session.Out).Should(Say("Command target does not support --output json"))
+		})
+	})
+
 	It("can print help menu by executing only the command `cf`", func() {
 		output := Cf().Wait(3 * time.Second)
 		Eventually(output.Out.Contents).Should(ContainSubstring("A command line tool to interact with Cloud Foundry"))
@@ -256,6 +280,52 @@ var _ = Describe("main", func() {
 			session := Cf("exit1").Wait(5 * time.Second)
 			Eventually(session).Should(Exit(1))
 		})
+
+		It("passes the plugin's stderr through and exits with its exit status", func() {
+			session := Cf("exit-status", "3").Wait(5 * time.Second)
+			Eventually(session).Should(Exit(3))
+			Expect(session.Err).To(Say("exiting with status 3"))
+		})
+
+		It("says why a plugin could not be run", func() {
+			session := Cf("missing-binary").Wait(5 * time.Second)
+			Eventually(session).Should(Exit(1))
+			Expect(session.Err).To(Say("Error running plugin .*missing_binary.exe"))
+		})
+
+		It("exits with 128 plus the signal number when a signal kills the plugin", func() {
+			if runtime.GOOS == "windows" {
+				Skip("plugins are not killed by signals on windows")
+			}
+
+			session := Cf("kill-self").Wait(5 * time.Second)
+			Eventually(session).Should(Exit(128 + int(syscall.SIGKILL)))
+		})
+
+		Context("when the CLI receives a signal while a plugin runs", func() {
+			var session *Session
+
+			BeforeEach(func() {
+				if runtime.GOOS == "windows" {
+					Skip("signals cannot be sent to processes on windows")
+				}
+
+				session = Cf("wait-for-signal")
+				Eventually(session.Out, 5*time.Second).Should(Say("waiting for a signal"))
+			})
+
+			It("forwards SIGTERM to the plugin and exits with its exit status", func() {
+				session.Terminate()
+				Eventually(session.Out, 5*time.Second).Should(Say("received terminated"))
+				Eventually(session, 5*time.Second).Should(Exit(3))
+			})
+
+			It("forwards SIGINT to the plugin and exits with its exit status", func() {
+				session.Interrupt()
+				Eventually(session.Out, 5*time.Second).Should(Say("received interrupt"))
+				Eventually(session, 5*time.Second).Should(Exit(4))
+			})
+		})
 	})
 
 })
@@ -381,3 +381,108 @@ func (c *cliConnection) GetService(serviceInstance string) (plugin_models.GetSer
 
 	return result, err
 }
+
+func (c *cliConnection) GetRoutes() ([]plugin_models.GetRoutes_Model, error) {
+	var result []plugin_models.GetRoutes_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetRoutes", "", &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetDomains() ([]plugin_models.GetDomains_Model, error) {
+	var result []plugin_models.GetDomains_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetDomains", "", &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetServiceKeys(serviceInstance string) ([]plugin_models.GetServiceKeys_Model, error) {
+	var result []plugin_models.GetServiceKeys_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetServiceKeys", serviceInstance, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetServiceBindings(serviceInstance string) ([]plugin_models.GetServiceBindings_Model, error) {
+	var result []plugin_models.GetServiceBindings_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetServiceBindings", serviceInstance, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetSecurityGroups() ([]plugin_models.GetSecurityGroups_Model, error) {
+	var result []plugin_models.GetSecurityGroups_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetSecurityGroups", "", &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetQuotas() ([]plugin_models.GetQuotas_Model, error) {
+	var result []plugin_models.GetQuotas_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetQuotas", "", &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetBuildpacks() ([]plugin_models.GetBuildpacks_Model, error) {
+	var result []plugin_models.GetBuildpacks_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetBuildpacks", "", &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetStacks() ([]plugin_models.GetStacks_Model, error) {
+	var result []plugin_models.GetStacks_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetStacks", "", &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) GetAppEvents(appName string) ([]plugin_models.GetAppEvents_Model, error) {
+	var result []plugin_models.GetAppEvents_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.GetAppEvents", appName, &result)
+	})
+
+	return result, err
+}
+
+func (c *cliConnection) Curl(method string, path string, headers map[string][]string, body string) (plugin_models.Curl_Model, error) {
+	var result plugin_models.Curl_Model
+
+	err := c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcCmd.Curl", plugin_models.Curl_Request{
+			Method:  method,
+			Path:    path,
+			Headers: headers,
+			Body:    body,
+		}, &result)
+	})
+
+	return result, err
+}
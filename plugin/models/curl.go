@@ -0,0 +1,14 @@
+package plugin_models
+
+type Curl_Request struct {
+	Method  string
+	Path    string
+	Headers map[string][]string
+	Body    string
+}
+
+type Curl_Model struct {
+	StatusCode int
+	Headers    map[string][]string
+	Body       string
+}
@@ -0,0 +1,10 @@
+package plugin_models
+
+type GetBuildpacks_Model struct {
+	Guid     string
+	Name     string
+	Position int
+	Enabled  bool
+	Locked   bool
+	Filename string
+}
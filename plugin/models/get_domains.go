@@ -0,0 +1,10 @@
+package plugin_models
+
+type GetDomains_Model struct {
+	Guid                   string
+	Name                   string
+	OwningOrganizationGuid string
+	RouterGroupGuid        string
+	RouterGroupType        string
+	Shared                 bool
+}
@@ -0,0 +1,13 @@
+package plugin_models
+
+type GetQuotas_Model struct {
+	Guid                    string
+	Name                    string
+	MemoryLimit             int64 // in Megabytes
+	InstanceMemoryLimit     int64 // in Megabytes
+	RoutesLimit             int
+	ServicesLimit           int
+	NonBasicServicesAllowed bool
+	AppInstanceLimit        int
+	ReservedRoutePorts      string
+}
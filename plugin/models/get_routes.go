@@ -0,0 +1,32 @@
+package plugin_models
+
+type GetRoutes_Model struct {
+	Guid            string
+	Host            string
+	Domain          GetRoutes_Domain
+	Path            string
+	Port            int
+	Space           GetRoutes_Space
+	Apps            []GetRoutes_App
+	ServiceInstance GetRoutes_ServiceInstance
+}
+
+type GetRoutes_Domain struct {
+	Guid string
+	Name string
+}
+
+type GetRoutes_Space struct {
+	Guid string
+	Name string
+}
+
+type GetRoutes_App struct {
+	Guid string
+	Name string
+}
+
+type GetRoutes_ServiceInstance struct {
+	Guid string
+	Name string
+}
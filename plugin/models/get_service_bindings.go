@@ -0,0 +1,7 @@
+package plugin_models
+
+type GetServiceBindings_Model struct {
+	Guid    string
+	AppGuid string
+	AppName string
+}
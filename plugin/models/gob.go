@@ -0,0 +1,11 @@
+package plugin_models
+
+import "encoding/gob"
+
+func init() {
+	//values decoded from JSON, such as environment variables, service key
+	//credentials and security group rules, nest maps and slices in interfaces,
+	//which gob can only send once their types are registered
+	gob.Register(map[string]interface{}{})
+	gob.Register([]interface{}{})
+}
@@ -42,6 +42,16 @@ type CliConnection interface {
 	GetService(string) (plugin_models.GetService_Model, error)
 	GetOrg(string) (plugin_models.GetOrg_Model, error)
 	GetSpace(string) (plugin_models.GetSpace_Model, error)
+	GetRoutes() ([]plugin_models.GetRoutes_Model, error)
+	GetDomains() ([]plugin_models.GetDomains_Model, error)
+	GetServiceKeys(string) ([]plugin_models.GetServiceKeys_Model, error)
+	GetServiceBindings(string) ([]plugin_models.GetServiceBindings_Model, error)
+	GetSecurityGroups() ([]plugin_models.GetSecurityGroups_Model, error)
+	GetQuotas() ([]plugin_models.GetQuotas_Model, error)
+	GetBuildpacks() ([]plugin_models.GetBuildpacks_Model, error)
+	GetStacks() ([]plugin_models.GetStacks_Model, error)
+	GetAppEvents(string) ([]plugin_models.GetAppEvents_Model, error)
+	Curl(string, string, map[string][]string, string) (plugin_models.Curl_Model, error)
 }
 
 type VersionType struct {
@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"errors"
 	"os"
 
 	"github.com/blang/semver"
@@ -8,6 +9,7 @@ import (
 	"github.com/cloudfoundry/cli/cf/api"
 	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/cloudfoundry/cli/plugin"
 	"github.com/cloudfoundry/cli/plugin/models"
@@ -23,6 +25,9 @@ import (
 	"github.com/cloudfoundry/cli/cf/trace"
 )
 
+// recentAppEventsLimit matches the number of events cf events shows by default
+const recentAppEventsLimit = 50
+
 type CliRpcService struct {
 	listener net.Listener
 	stopCh   chan struct{}
@@ -176,7 +181,7 @@ func (cmd *CliRpcCmd) CallCoreCommand(args []string, retVal *bool) error {
 		deps.RepoLocator = cmd.repoLocator
 
 		//set command ui's TeePrinter to be the one used by RpcService, for output to be captured
-		deps.UI = terminal.NewUI(os.Stdin, cmd.stdout, cmd.outputCapture.(*terminal.TeePrinter), cmd.logger)
+		deps.UI = terminal.NewUI(os.Stdin, cmd.stdout, cmd.outputCapture.(*terminal.TeePrinter), capturedTracePrinter{cmd.logger})
 
 		err = cmd.newCmdRunner.Command(args, deps, false)
 	} else {
@@ -193,6 +198,17 @@ func (cmd *CliRpcCmd) CallCoreCommand(args []string, retVal *bool) error {
 	return nil
 }
 
+// capturedTracePrinter keeps tracing to the console for plugin-initiated
+// commands, but reports that it does not, so the UI still writes failures
+// to the output captured for the plugin.
+type capturedTracePrinter struct {
+	trace.Printer
+}
+
+func (p capturedTracePrinter) WritesToConsole() bool {
+	return false
+}
+
 func (cmd *CliRpcCmd) GetOutputAndReset(args bool, retVal *[]string) error {
 	v := &[]string{cmd.outputBucket.String()}
 	*retVal = *v
@@ -475,3 +491,275 @@ func (cmd *CliRpcCmd) GetService(serviceInstance string, retVal *plugin_models.G
 
 	return cmd.newCmdRunner.Command([]string{"service", serviceInstance}, deps, true)
 }
+
+func (cmd *CliRpcCmd) GetRoutes(_ string, retVal *[]plugin_models.GetRoutes_Model) error {
+	if !cmd.cliConfig.HasSpace() {
+		return errors.New("No space targeted")
+	}
+
+	routes := []plugin_models.GetRoutes_Model{}
+	err := cmd.repoLocator.GetRouteRepository().ListRoutes(func(route models.Route) bool {
+		r := plugin_models.GetRoutes_Model{
+			Guid: route.GUID,
+			Host: route.Host,
+			Path: route.Path,
+			Port: route.Port,
+		}
+		r.Domain.Guid = route.Domain.GUID
+		r.Domain.Name = route.Domain.Name
+		r.Space.Guid = route.Space.GUID
+		r.Space.Name = route.Space.Name
+		r.ServiceInstance.Guid = route.ServiceInstance.GUID
+		r.ServiceInstance.Name = route.ServiceInstance.Name
+		for _, app := range route.Apps {
+			r.Apps = append(r.Apps, plugin_models.GetRoutes_App{Guid: app.GUID, Name: app.Name})
+		}
+
+		routes = append(routes, r)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	*retVal = routes
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetDomains(_ string, retVal *[]plugin_models.GetDomains_Model) error {
+	if !cmd.cliConfig.HasOrganization() {
+		return errors.New("No org targeted")
+	}
+
+	domains := []plugin_models.GetDomains_Model{}
+	err := cmd.repoLocator.GetDomainRepository().ListDomainsForOrg(cmd.cliConfig.OrganizationFields().GUID, func(domain models.DomainFields) bool {
+		domains = append(domains, plugin_models.GetDomains_Model{
+			Guid:                   domain.GUID,
+			Name:                   domain.Name,
+			OwningOrganizationGuid: domain.OwningOrganizationGUID,
+			RouterGroupGuid:        domain.RouterGroupGUID,
+			RouterGroupType:        domain.RouterGroupType,
+			Shared:                 domain.Shared,
+		})
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	*retVal = domains
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetServiceKeys(serviceInstance string, retVal *[]plugin_models.GetServiceKeys_Model) error {
+	instance, err := cmd.findServiceInstance(serviceInstance)
+	if err != nil {
+		return err
+	}
+
+	serviceKeys, err := cmd.repoLocator.GetServiceKeyRepository().ListServiceKeys(instance.GUID)
+	if err != nil {
+		return err
+	}
+
+	keys := []plugin_models.GetServiceKeys_Model{}
+	for _, serviceKey := range serviceKeys {
+		keys = append(keys, plugin_models.GetServiceKeys_Model{
+			Guid:                serviceKey.Fields.GUID,
+			Name:                serviceKey.Fields.Name,
+			ServiceInstanceGuid: serviceKey.Fields.ServiceInstanceGUID,
+			Credentials:         serviceKey.Credentials,
+		})
+	}
+
+	*retVal = keys
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetServiceBindings(serviceInstance string, retVal *[]plugin_models.GetServiceBindings_Model) error {
+	instance, err := cmd.findServiceInstance(serviceInstance)
+	if err != nil {
+		return err
+	}
+
+	serviceBindings, err := cmd.repoLocator.GetServiceBindingRepository().ListAllForService(instance.GUID)
+	if err != nil {
+		return err
+	}
+
+	//service instances are bound to apps in their space only
+	apps, err := cmd.repoLocator.GetAppSummaryRepository().GetSummariesInCurrentSpace()
+	if err != nil {
+		return err
+	}
+
+	appNames := map[string]string{}
+	for _, app := range apps {
+		appNames[app.GUID] = app.Name
+	}
+
+	bindings := []plugin_models.GetServiceBindings_Model{}
+	for _, serviceBinding := range serviceBindings {
+		bindings = append(bindings, plugin_models.GetServiceBindings_Model{
+			Guid:    serviceBinding.GUID,
+			AppGuid: serviceBinding.AppGUID,
+			AppName: appNames[serviceBinding.AppGUID],
+		})
+	}
+
+	*retVal = bindings
+	return nil
+}
+
+func (cmd *CliRpcCmd) findServiceInstance(name string) (models.ServiceInstance, error) {
+	if !cmd.cliConfig.HasSpace() {
+		return models.ServiceInstance{}, errors.New("No space targeted")
+	}
+
+	return cmd.repoLocator.GetServiceRepository().FindInstanceByName(name)
+}
+
+func (cmd *CliRpcCmd) GetSecurityGroups(_ string, retVal *[]plugin_models.GetSecurityGroups_Model) error {
+	securityGroups, err := cmd.repoLocator.GetSecurityGroupRepository().FindAll()
+	if err != nil {
+		return err
+	}
+
+	groups := []plugin_models.GetSecurityGroups_Model{}
+	for _, securityGroup := range securityGroups {
+		group := plugin_models.GetSecurityGroups_Model{
+			Guid:  securityGroup.GUID,
+			Name:  securityGroup.Name,
+			Rules: securityGroup.Rules,
+		}
+		for _, space := range securityGroup.Spaces {
+			group.Spaces = append(group.Spaces, plugin_models.GetSecurityGroups_Space{
+				Guid:             space.GUID,
+				Name:             space.Name,
+				OrganizationGuid: space.Organization.GUID,
+				OrganizationName: space.Organization.Name,
+			})
+		}
+
+		groups = append(groups, group)
+	}
+
+	*retVal = groups
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetQuotas(_ string, retVal *[]plugin_models.GetQuotas_Model) error {
+	quotas, err := cmd.repoLocator.GetQuotaRepository().FindAll()
+	if err != nil {
+		return err
+	}
+
+	result := []plugin_models.GetQuotas_Model{}
+	for _, quota := range quotas {
+		result = append(result, plugin_models.GetQuotas_Model{
+			Guid:                    quota.GUID,
+			Name:                    quota.Name,
+			MemoryLimit:             quota.MemoryLimit,
+			InstanceMemoryLimit:     quota.InstanceMemoryLimit,
+			RoutesLimit:             quota.RoutesLimit,
+			ServicesLimit:           quota.ServicesLimit,
+			NonBasicServicesAllowed: quota.NonBasicServicesAllowed,
+			AppInstanceLimit:        quota.AppInstanceLimit,
+			ReservedRoutePorts:      string(quota.ReservedRoutePorts),
+		})
+	}
+
+	*retVal = result
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetBuildpacks(_ string, retVal *[]plugin_models.GetBuildpacks_Model) error {
+	buildpacks := []plugin_models.GetBuildpacks_Model{}
+	err := cmd.repoLocator.GetBuildpackRepository().ListBuildpacks(func(buildpack models.Buildpack) bool {
+		b := plugin_models.GetBuildpacks_Model{
+			Guid:     buildpack.GUID,
+			Name:     buildpack.Name,
+			Filename: buildpack.Filename,
+		}
+		if buildpack.Position != nil {
+			b.Position = *buildpack.Position
+		}
+		if buildpack.Enabled != nil {
+			b.Enabled = *buildpack.Enabled
+		}
+		if buildpack.Locked != nil {
+			b.Locked = *buildpack.Locked
+		}
+
+		buildpacks = append(buildpacks, b)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	*retVal = buildpacks
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetStacks(_ string, retVal *[]plugin_models.GetStacks_Model) error {
+	stacks, err := cmd.repoLocator.GetStackRepository().FindAll()
+	if err != nil {
+		return err
+	}
+
+	result := []plugin_models.GetStacks_Model{}
+	for _, stack := range stacks {
+		result = append(result, plugin_models.GetStacks_Model{
+			Guid:        stack.GUID,
+			Name:        stack.Name,
+			Description: stack.Description,
+		})
+	}
+
+	*retVal = result
+	return nil
+}
+
+func (cmd *CliRpcCmd) GetAppEvents(appName string, retVal *[]plugin_models.GetAppEvents_Model) error {
+	if !cmd.cliConfig.HasSpace() {
+		return errors.New("No space targeted")
+	}
+
+	app, err := cmd.repoLocator.GetApplicationRepository().Read(appName)
+	if err != nil {
+		return err
+	}
+
+	appEvents, err := cmd.repoLocator.GetAppEventsRepository().RecentEvents(app.GUID, recentAppEventsLimit)
+	if err != nil {
+		return err
+	}
+
+	events := []plugin_models.GetAppEvents_Model{}
+	for _, event := range appEvents {
+		events = append(events, plugin_models.GetAppEvents_Model{
+			Guid:        event.GUID,
+			Name:        event.Name,
+			Timestamp:   event.Timestamp,
+			Description: event.Description,
+			Actor:       event.Actor,
+			ActorName:   event.ActorName,
+		})
+	}
+
+	*retVal = events
+	return nil
+}
+
+func (cmd *CliRpcCmd) Curl(args plugin_models.Curl_Request, retVal *plugin_models.Curl_Model) error {
+	statusCode, header, body, err := cmd.repoLocator.GetCurlRepository().RequestForResponse(args.Method, args.Path, args.Headers, args.Body)
+	if err != nil {
+		return err
+	}
+
+	retVal.StatusCode = statusCode
+	retVal.Headers = header
+	retVal.Body = body
+	return nil
+}
@@ -1,18 +1,29 @@
 package rpc_test
 
 import (
+	"bytes"
 	"errors"
+	"io/ioutil"
 	"net"
+	"net/http"
 	"net/rpc"
 	"os"
 	"time"
 
 	"github.com/cloudfoundry/cli/cf"
 	"github.com/cloudfoundry/cli/cf/api"
+	"github.com/cloudfoundry/cli/cf/api/apifakes"
+	"github.com/cloudfoundry/cli/cf/api/appevents/appeventsfakes"
+	"github.com/cloudfoundry/cli/cf/api/applications/applicationsfakes"
 	"github.com/cloudfoundry/cli/cf/api/authentication/authenticationfakes"
+	"github.com/cloudfoundry/cli/cf/api/quotas/quotasfakes"
+	"github.com/cloudfoundry/cli/cf/api/securitygroups/securitygroupsfakes"
+	"github.com/cloudfoundry/cli/cf/api/stacks/stacksfakes"
+	"github.com/cloudfoundry/cli/cf/commandregistry"
 	"github.com/cloudfoundry/cli/cf/configuration/coreconfig"
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/cf/trace"
 	"github.com/cloudfoundry/cli/plugin"
 	"github.com/cloudfoundry/cli/plugin/models"
 	. "github.com/cloudfoundry/cli/plugin/rpc"
@@ -421,6 +432,330 @@ var _ = Describe("Server", func() {
 
 	})
 
+	Describe("Plugin API backed by repositories", func() {
+		var (
+			config  coreconfig.Repository
+			locator api.RepositoryLocator
+		)
+
+		BeforeEach(func() {
+			config = testconfig.NewRepositoryWithDefaults()
+			locator = api.RepositoryLocator{}
+		})
+
+		JustBeforeEach(func() {
+			rpcService, err = NewRpcService(nil, nil, config, locator, nil, nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			err := rpcService.Start()
+			Expect(err).ToNot(HaveOccurred())
+
+			pingCli(rpcService.Port())
+
+			client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			rpcService.Stop()
+
+			//give time for server to stop
+			time.Sleep(50 * time.Millisecond)
+		})
+
+		Context(".GetRoutes", func() {
+			var routeRepo *apifakes.FakeRouteRepository
+
+			BeforeEach(func() {
+				routeRepo = new(apifakes.FakeRouteRepository)
+				routeRepo.ListRoutesStub = func(cb func(models.Route) bool) error {
+					cb(models.Route{
+						GUID:   "route-guid",
+						Host:   "my-host",
+						Domain: models.DomainFields{GUID: "domain-guid", Name: "example.com"},
+						Path:   "/path",
+						Space:  models.SpaceFields{GUID: "space-guid", Name: "my-space"},
+						Apps:   []models.ApplicationFields{{GUID: "app-guid", Name: "my-app"}},
+					})
+					return nil
+				}
+				locator = locator.SetRouteRepository(routeRepo)
+			})
+
+			It("returns the routes in the targeted space", func() {
+				var result []plugin_models.GetRoutes_Model
+				err = client.Call("CliRpcCmd.GetRoutes", "", &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result).To(HaveLen(1))
+				Expect(result[0].Guid).To(Equal("route-guid"))
+				Expect(result[0].Host).To(Equal("my-host"))
+				Expect(result[0].Domain).To(Equal(plugin_models.GetRoutes_Domain{Guid: "domain-guid", Name: "example.com"}))
+				Expect(result[0].Path).To(Equal("/path"))
+				Expect(result[0].Space.Name).To(Equal("my-space"))
+				Expect(result[0].Apps).To(Equal([]plugin_models.GetRoutes_App{{Guid: "app-guid", Name: "my-app"}}))
+			})
+
+			Context("when no space is targeted", func() {
+				BeforeEach(func() {
+					config.SetSpaceFields(models.SpaceFields{})
+				})
+
+				It("returns an error", func() {
+					var result []plugin_models.GetRoutes_Model
+					err = client.Call("CliRpcCmd.GetRoutes", "", &result)
+					Expect(err).To(MatchError("No space targeted"))
+					Expect(routeRepo.ListRoutesCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context(".GetDomains", func() {
+			var domainRepo *apifakes.FakeDomainRepository
+
+			BeforeEach(func() {
+				domainRepo = new(apifakes.FakeDomainRepository)
+				domainRepo.ListDomainsForOrgStub = func(orgGUID string, cb func(models.DomainFields) bool) error {
+					cb(models.DomainFields{GUID: "domain-guid", Name: "example.com", Shared: true})
+					return nil
+				}
+				locator = locator.SetDomainRepository(domainRepo)
+			})
+
+			It("returns the domains of the targeted org", func() {
+				var result []plugin_models.GetDomains_Model
+				err = client.Call("CliRpcCmd.GetDomains", "", &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				orgGUID, _ := domainRepo.ListDomainsForOrgArgsForCall(0)
+				Expect(orgGUID).To(Equal(config.OrganizationFields().GUID))
+				Expect(result).To(Equal([]plugin_models.GetDomains_Model{{Guid: "domain-guid", Name: "example.com", Shared: true}}))
+			})
+		})
+
+		Context(".GetServiceKeys and .GetServiceBindings", func() {
+			var (
+				serviceRepo        *apifakes.FakeServiceRepository
+				serviceKeyRepo     *apifakes.FakeServiceKeyRepository
+				serviceBindingRepo *apifakes.FakeServiceBindingRepository
+				appSummaryRepo     *apifakes.FakeAppSummaryRepository
+			)
+
+			BeforeEach(func() {
+				serviceRepo = new(apifakes.FakeServiceRepository)
+				serviceRepo.FindInstanceByNameReturns(models.ServiceInstance{ServiceInstanceFields: models.ServiceInstanceFields{GUID: "instance-guid"}}, nil)
+				serviceKeyRepo = new(apifakes.FakeServiceKeyRepository)
+				serviceKeyRepo.ListServiceKeysReturns([]models.ServiceKey{
+					{
+						Fields: models.ServiceKeyFields{GUID: "key-guid", Name: "my-key", ServiceInstanceGUID: "instance-guid"},
+						Credentials: map[string]interface{}{
+							"uri":   "mysql://example.com",
+							"hosts": []interface{}{"a", "b"},
+							"admin": map[string]interface{}{"password": "secret"},
+						},
+					},
+				}, nil)
+				serviceBindingRepo = new(apifakes.FakeServiceBindingRepository)
+				serviceBindingRepo.ListAllForServiceReturns([]models.ServiceBindingFields{{GUID: "binding-guid", AppGUID: "app-guid"}}, nil)
+				appSummaryRepo = new(apifakes.FakeAppSummaryRepository)
+				appSummaryRepo.GetSummariesInCurrentSpaceReturns([]models.Application{
+					{ApplicationFields: models.ApplicationFields{GUID: "app-guid", Name: "my-app"}},
+				}, nil)
+
+				locator = locator.SetServiceRepository(serviceRepo).
+					SetServiceKeyRepository(serviceKeyRepo).
+					SetServiceBindingRepository(serviceBindingRepo).
+					SetAppSummaryRepository(appSummaryRepo)
+			})
+
+			It("returns the keys of the service instance with their credentials", func() {
+				var result []plugin_models.GetServiceKeys_Model
+				err = client.Call("CliRpcCmd.GetServiceKeys", "my-service", &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(serviceRepo.FindInstanceByNameArgsForCall(0)).To(Equal("my-service"))
+				Expect(serviceKeyRepo.ListServiceKeysArgsForCall(0)).To(Equal("instance-guid"))
+				Expect(result).To(HaveLen(1))
+				Expect(result[0].Name).To(Equal("my-key"))
+				Expect(result[0].Credentials).To(Equal(map[string]interface{}{
+					"uri":   "mysql://example.com",
+					"hosts": []interface{}{"a", "b"},
+					"admin": map[string]interface{}{"password": "secret"},
+				}))
+			})
+
+			It("returns the bindings of the service instance with the names of their apps", func() {
+				var result []plugin_models.GetServiceBindings_Model
+				err = client.Call("CliRpcCmd.GetServiceBindings", "my-service", &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(serviceBindingRepo.ListAllForServiceArgsForCall(0)).To(Equal("instance-guid"))
+				Expect(result).To(Equal([]plugin_models.GetServiceBindings_Model{{Guid: "binding-guid", AppGuid: "app-guid", AppName: "my-app"}}))
+			})
+
+			It("returns the error from finding the service instance", func() {
+				serviceRepo.FindInstanceByNameReturns(models.ServiceInstance{}, errors.New("not found"))
+
+				var result []plugin_models.GetServiceKeys_Model
+				err = client.Call("CliRpcCmd.GetServiceKeys", "my-service", &result)
+				Expect(err).To(MatchError("not found"))
+				Expect(serviceKeyRepo.ListServiceKeysCallCount()).To(Equal(0))
+			})
+		})
+
+		Context(".GetSecurityGroups", func() {
+			BeforeEach(func() {
+				securityGroupRepo := new(securitygroupsfakes.FakeSecurityGroupRepo)
+				securityGroupRepo.FindAllReturns([]models.SecurityGroup{
+					{
+						SecurityGroupFields: models.SecurityGroupFields{
+							GUID:  "group-guid",
+							Name:  "my-group",
+							Rules: []map[string]interface{}{{"protocol": "tcp", "ports": "443"}},
+						},
+						Spaces: []models.Space{
+							{
+								SpaceFields:  models.SpaceFields{GUID: "space-guid", Name: "my-space"},
+								Organization: models.OrganizationFields{GUID: "org-guid", Name: "my-org"},
+							},
+						},
+					},
+				}, nil)
+				locator = locator.SetSecurityGroupRepository(securityGroupRepo)
+			})
+
+			It("returns the security groups with their rules and spaces", func() {
+				var result []plugin_models.GetSecurityGroups_Model
+				err = client.Call("CliRpcCmd.GetSecurityGroups", "", &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(result).To(Equal([]plugin_models.GetSecurityGroups_Model{
+					{
+						Guid:  "group-guid",
+						Name:  "my-group",
+						Rules: []map[string]interface{}{{"protocol": "tcp", "ports": "443"}},
+						Spaces: []plugin_models.GetSecurityGroups_Space{
+							{Guid: "space-guid", Name: "my-space", OrganizationGuid: "org-guid", OrganizationName: "my-org"},
+						},
+					},
+				}))
+			})
+		})
+
+		Context(".GetQuotas", func() {
+			BeforeEach(func() {
+				quotaRepo := new(quotasfakes.FakeQuotaRepository)
+				quotaRepo.FindAllReturns([]models.QuotaFields{{GUID: "quota-guid", Name: "default", MemoryLimit: 1024, AppInstanceLimit: -1}}, nil)
+				locator = locator.SetQuotaRepository(quotaRepo)
+			})
+
+			It("returns the org quotas", func() {
+				var result []plugin_models.GetQuotas_Model
+				err = client.Call("CliRpcCmd.GetQuotas", "", &result)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal([]plugin_models.GetQuotas_Model{{Guid: "quota-guid", Name: "default", MemoryLimit: 1024, AppInstanceLimit: -1}}))
+			})
+		})
+
+		Context(".GetBuildpacks", func() {
+			BeforeEach(func() {
+				position, enabled := 2, true
+				buildpackRepo := new(apifakes.FakeBuildpackRepository)
+				buildpackRepo.ListBuildpacksStub = func(cb func(models.Buildpack) bool) error {
+					cb(models.Buildpack{GUID: "buildpack-guid", Name: "go_buildpack", Position: &position, Enabled: &enabled, Filename: "go.zip"})
+					return nil
+				}
+				locator = locator.SetBuildpackRepository(buildpackRepo)
+			})
+
+			It("returns the buildpacks", func() {
+				var result []plugin_models.GetBuildpacks_Model
+				err = client.Call("CliRpcCmd.GetBuildpacks", "", &result)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal([]plugin_models.GetBuildpacks_Model{{Guid: "buildpack-guid", Name: "go_buildpack", Position: 2, Enabled: true, Filename: "go.zip"}}))
+			})
+		})
+
+		Context(".GetStacks", func() {
+			BeforeEach(func() {
+				stackRepo := new(stacksfakes.FakeStackRepository)
+				stackRepo.FindAllReturns([]models.Stack{{GUID: "stack-guid", Name: "cflinuxfs2", Description: "Cloud Foundry Linux"}}, nil)
+				locator = locator.SetStackRepository(stackRepo)
+			})
+
+			It("returns the stacks", func() {
+				var result []plugin_models.GetStacks_Model
+				err = client.Call("CliRpcCmd.GetStacks", "", &result)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal([]plugin_models.GetStacks_Model{{Guid: "stack-guid", Name: "cflinuxfs2", Description: "Cloud Foundry Linux"}}))
+			})
+		})
+
+		Context(".GetAppEvents", func() {
+			var appEventsRepo *appeventsfakes.FakeAppEventsRepository
+
+			BeforeEach(func() {
+				appRepo := new(applicationsfakes.FakeApplicationRepository)
+				appRepo.ReadReturns(models.Application{ApplicationFields: models.ApplicationFields{GUID: "app-guid", Name: "my-app"}}, nil)
+				appEventsRepo = new(appeventsfakes.FakeAppEventsRepository)
+				appEventsRepo.RecentEventsReturns([]models.EventFields{{GUID: "event-guid", Name: "audit.app.update", Timestamp: time.Unix(1000, 0).UTC(), Actor: "user-guid"}}, nil)
+				locator = locator.SetApplicationRepository(appRepo).SetAppEventsRepository(appEventsRepo)
+			})
+
+			It("returns the recent events of the app", func() {
+				var result []plugin_models.GetAppEvents_Model
+				err = client.Call("CliRpcCmd.GetAppEvents", "my-app", &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				appGUID, limit := appEventsRepo.RecentEventsArgsForCall(0)
+				Expect(appGUID).To(Equal("app-guid"))
+				Expect(limit).To(Equal(int64(50)))
+				Expect(result).To(Equal([]plugin_models.GetAppEvents_Model{{Guid: "event-guid", Name: "audit.app.update", Timestamp: time.Unix(1000, 0).UTC(), Actor: "user-guid"}}))
+			})
+		})
+
+		Context(".Curl", func() {
+			var curlRepo *apifakes.FakeCurlRepository
+
+			BeforeEach(func() {
+				curlRepo = new(apifakes.FakeCurlRepository)
+				curlRepo.RequestForResponseReturns(http.StatusOK, http.Header{"Content-Type": []string{"application/json"}}, `{"total_results":1}`, nil)
+				locator = locator.SetCurlRepository(curlRepo)
+			})
+
+			It("performs the request through the curl repository", func() {
+				var result plugin_models.Curl_Model
+				err = client.Call("CliRpcCmd.Curl", plugin_models.Curl_Request{
+					Method:  "POST",
+					Path:    "/v2/apps",
+					Headers: map[string][]string{"Accept": {"application/json"}},
+					Body:    `{"name":"my-app"}`,
+				}, &result)
+				Expect(err).ToNot(HaveOccurred())
+
+				method, path, header, body := curlRepo.RequestForResponseArgsForCall(0)
+				Expect(method).To(Equal("POST"))
+				Expect(path).To(Equal("/v2/apps"))
+				Expect(header).To(Equal(http.Header{"Accept": []string{"application/json"}}))
+				Expect(body).To(Equal(`{"name":"my-app"}`))
+
+				Expect(result).To(Equal(plugin_models.Curl_Model{
+					StatusCode: http.StatusOK,
+					Headers:    map[string][]string{"Content-Type": {"application/json"}},
+					Body:       `{"total_results":1}`,
+				}))
+			})
+
+			It("returns the error from performing the request", func() {
+				curlRepo.RequestForResponseReturns(0, nil, "", errors.New("connection refused"))
+
+				var result plugin_models.Curl_Model
+				err = client.Call("CliRpcCmd.Curl", plugin_models.Curl_Request{Path: "/v2/apps"}, &result)
+				Expect(err).To(MatchError("connection refused"))
+			})
+		})
+	})
+
 	Describe(".CallCoreCommand", func() {
 		var runner *rpcfakes.FakeCommandRunner
 
@@ -461,6 +796,54 @@ var _ = Describe("Server", func() {
 			})
 		})
 
+		Context("when tracing to the console", func() {
+			var traceOutput *bytes.Buffer
+
+			BeforeEach(func() {
+				traceOutput = &bytes.Buffer{}
+				logger := trace.NewWriterPrinter(traceOutput, true)
+
+				outputCapture := terminal.NewTeePrinter(ioutil.Discard)
+				runner = new(rpcfakes.FakeCommandRunner)
+				runner.CommandStub = func(_ []string, deps commandregistry.Dependency, _ bool) error {
+					deps.UI.Failed("fake-command failed")
+					return nil
+				}
+
+				rpcService, err = NewRpcService(outputCapture, nil, nil, api.RepositoryLocator{}, runner, logger, ioutil.Discard)
+				Expect(err).ToNot(HaveOccurred())
+
+				err := rpcService.Start()
+				Expect(err).ToNot(HaveOccurred())
+
+				pingCli(rpcService.Port())
+			})
+
+			AfterEach(func() {
+				rpcService.Stop()
+
+				//give time for server to stop
+				time.Sleep(50 * time.Millisecond)
+			})
+
+			It("captures failures for the plugin as well as tracing them", func() {
+				client, err = rpc.Dial("tcp", "127.0.0.1:"+rpcService.Port())
+				Expect(err).ToNot(HaveOccurred())
+
+				var success bool
+				err = client.Call("CliRpcCmd.CallCoreCommand", []string{"fake-command3"}, &success)
+				Expect(err).ToNot(HaveOccurred())
+
+				var output []string
+				err = client.Call("CliRpcCmd.GetOutputAndReset", false, &output)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(output[0]).To(ContainSubstring("FAILED"))
+				Expect(output[0]).To(ContainSubstring("fake-command failed"))
+				Expect(traceOutput.String()).To(ContainSubstring("fake-command failed"))
+			})
+		})
+
 		Describe("CLI Config object methods", func() {
 			var (
 				config coreconfig.Repository
@@ -1,8 +1,11 @@
 package rpc
 
 import (
+	"fmt"
 	"os"
 	"os/exec"
+	"os/signal"
+	"syscall"
 
 	"github.com/cloudfoundry/cli/cf/configuration/pluginconfig"
 )
@@ -14,18 +17,14 @@ func RunMethodIfExists(rpcService *CliRpcService, args []string, pluginList map[
 				args[0] = command.Name
 
 				rpcService.Start()
-				defer rpcService.Stop()
 
 				pluginArgs := append([]string{rpcService.Port()}, args...)
+				exitStatus := runPlugin(metadata.Location, pluginArgs)
 
-				cmd := exec.Command(metadata.Location, pluginArgs...)
-				cmd.Stdout = os.Stdout
-				cmd.Stdin = os.Stdin
+				rpcService.Stop()
 
-				defer stopPlugin(cmd)
-				err := cmd.Run()
-				if err != nil {
-					os.Exit(1)
+				if exitStatus != 0 {
+					os.Exit(exitStatus)
 				}
 				return true
 			}
@@ -34,7 +33,53 @@ func RunMethodIfExists(rpcService *CliRpcService, args []string, pluginList map[
 	return false
 }
 
-func stopPlugin(plugin *exec.Cmd) {
-	plugin.Process.Kill()
-	plugin.Wait()
+// runPlugin runs the plugin binary attached to the CLI's stdin, stdout and
+// stderr and returns the exit status the plugin finished with, which is 128
+// plus the signal number when a signal killed it. SIGINT and SIGTERM are
+// forwarded to the plugin, which decides when to exit, since they may be sent
+// to the CLI alone, such as by a process supervisor.
+func runPlugin(location string, args []string) int {
+	cmd := exec.Command(location, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Stdin = os.Stdin
+
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	err := cmd.Start()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error running plugin %s: %s\n", location, err)
+		return 1
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		for {
+			select {
+			case sig := <-signals:
+				_ = cmd.Process.Signal(sig)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err = cmd.Wait()
+	if err == nil {
+		return 0
+	}
+
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+			return 128 + int(status.Signal())
+		}
+		if exitErr.ExitCode() > 0 {
+			return exitErr.ExitCode()
+		}
+	}
+	return 1
 }
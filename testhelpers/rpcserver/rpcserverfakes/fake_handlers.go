@@ -280,6 +280,96 @@ type FakeHandlers struct {
 	getServiceReturns struct {
 		result1 error
 	}
+	GetRoutesStub        func(args string, retVal *[]plugin_models.GetRoutes_Model) error
+	getRoutesMutex       sync.RWMutex
+	getRoutesArgsForCall []struct {
+		args   string
+		retVal *[]plugin_models.GetRoutes_Model
+	}
+	getRoutesReturns struct {
+		result1 error
+	}
+	GetDomainsStub        func(args string, retVal *[]plugin_models.GetDomains_Model) error
+	getDomainsMutex       sync.RWMutex
+	getDomainsArgsForCall []struct {
+		args   string
+		retVal *[]plugin_models.GetDomains_Model
+	}
+	getDomainsReturns struct {
+		result1 error
+	}
+	GetServiceKeysStub        func(serviceInstance string, retVal *[]plugin_models.GetServiceKeys_Model) error
+	getServiceKeysMutex       sync.RWMutex
+	getServiceKeysArgsForCall []struct {
+		serviceInstance string
+		retVal          *[]plugin_models.GetServiceKeys_Model
+	}
+	getServiceKeysReturns struct {
+		result1 error
+	}
+	GetServiceBindingsStub        func(serviceInstance string, retVal *[]plugin_models.GetServiceBindings_Model) error
+	getServiceBindingsMutex       sync.RWMutex
+	getServiceBindingsArgsForCall []struct {
+		serviceInstance string
+		retVal          *[]plugin_models.GetServiceBindings_Model
+	}
+	getServiceBindingsReturns struct {
+		result1 error
+	}
+	GetSecurityGroupsStub        func(args string, retVal *[]plugin_models.GetSecurityGroups_Model) error
+	getSecurityGroupsMutex       sync.RWMutex
+	getSecurityGroupsArgsForCall []struct {
+		args   string
+		retVal *[]plugin_models.GetSecurityGroups_Model
+	}
+	getSecurityGroupsReturns struct {
+		result1 error
+	}
+	GetQuotasStub        func(args string, retVal *[]plugin_models.GetQuotas_Model) error
+	getQuotasMutex       sync.RWMutex
+	getQuotasArgsForCall []struct {
+		args   string
+		retVal *[]plugin_models.GetQuotas_Model
+	}
+	getQuotasReturns struct {
+		result1 error
+	}
+	GetBuildpacksStub        func(args string, retVal *[]plugin_models.GetBuildpacks_Model) error
+	getBuildpacksMutex       sync.RWMutex
+	getBuildpacksArgsForCall []struct {
+		args   string
+		retVal *[]plugin_models.GetBuildpacks_Model
+	}
+	getBuildpacksReturns struct {
+		result1 error
+	}
+	GetStacksStub        func(args string, retVal *[]plugin_models.GetStacks_Model) error
+	getStacksMutex       sync.RWMutex
+	getStacksArgsForCall []struct {
+		args   string
+		retVal *[]plugin_models.GetStacks_Model
+	}
+	getStacksReturns struct {
+		result1 error
+	}
+	GetAppEventsStub        func(appName string, retVal *[]plugin_models.GetAppEvents_Model) error
+	getAppEventsMutex       sync.RWMutex
+	getAppEventsArgsForCall []struct {
+		appName string
+		retVal  *[]plugin_models.GetAppEvents_Model
+	}
+	getAppEventsReturns struct {
+		result1 error
+	}
+	CurlStub        func(args plugin_models.Curl_Request, retVal *plugin_models.Curl_Model) error
+	curlMutex       sync.RWMutex
+	curlArgsForCall []struct {
+		args   plugin_models.Curl_Request
+		retVal *plugin_models.Curl_Model
+	}
+	curlReturns struct {
+		result1 error
+	}
 }
 
 func (fake *FakeHandlers) IsMinCliVersion(args string, retVal *bool) error {
@@ -1272,4 +1362,334 @@ func (fake *FakeHandlers) GetServiceReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeHandlers) GetRoutes(args string, retVal *[]plugin_models.GetRoutes_Model) error {
+	fake.getRoutesMutex.Lock()
+	fake.getRoutesArgsForCall = append(fake.getRoutesArgsForCall, struct {
+		args   string
+		retVal *[]plugin_models.GetRoutes_Model
+	}{args, retVal})
+	fake.getRoutesMutex.Unlock()
+	if fake.GetRoutesStub != nil {
+		return fake.GetRoutesStub(args, retVal)
+	} else {
+		return fake.getRoutesReturns.result1
+	}
+}
+
+func (fake *FakeHandlers) GetRoutesCallCount() int {
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
+	return len(fake.getRoutesArgsForCall)
+}
+
+func (fake *FakeHandlers) GetRoutesArgsForCall(i int) (string, *[]plugin_models.GetRoutes_Model) {
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
+	return fake.getRoutesArgsForCall[i].args, fake.getRoutesArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetRoutesReturns(result1 error) {
+	fake.GetRoutesStub = nil
+	fake.getRoutesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetDomains(args string, retVal *[]plugin_models.GetDomains_Model) error {
+	fake.getDomainsMutex.Lock()
+	fake.getDomainsArgsForCall = append(fake.getDomainsArgsForCall, struct {
+		args   string
+		retVal *[]plugin_models.GetDomains_Model
+	}{args, retVal})
+	fake.getDomainsMutex.Unlock()
+	if fake.GetDomainsStub != nil {
+		return fake.GetDomainsStub(args, retVal)
+	} else {
+		return fake.getDomainsReturns.result1
+	}
+}
+
+func (fake *FakeHandlers) GetDomainsCallCount() int {
+	fake.getDomainsMutex.RLock()
+	defer fake.getDomainsMutex.RUnlock()
+	return len(fake.getDomainsArgsForCall)
+}
+
+func (fake *FakeHandlers) GetDomainsArgsForCall(i int) (string, *[]plugin_models.GetDomains_Model) {
+	fake.getDomainsMutex.RLock()
+	defer fake.getDomainsMutex.RUnlock()
+	return fake.getDomainsArgsForCall[i].args, fake.getDomainsArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetDomainsReturns(result1 error) {
+	fake.GetDomainsStub = nil
+	fake.getDomainsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetServiceKeys(serviceInstance string, retVal *[]plugin_models.GetServiceKeys_Model) error {
+	fake.getServiceKeysMutex.Lock()
+	fake.getServiceKeysArgsForCall = append(fake.getServiceKeysArgsForCall, struct {
+		serviceInstance string
+		retVal          *[]plugin_models.GetServiceKeys_Model
+	}{serviceInstance, retVal})
+	fake.getServiceKeysMutex.Unlock()
+	if fake.GetServiceKeysStub != nil {
+		return fake.GetServiceKeysStub(serviceInstance, retVal)
+	} else {
+		return fake.getServiceKeysReturns.result1
+	}
+}
+
+func (fake *FakeHandlers) GetServiceKeysCallCount() int {
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	return len(fake.getServiceKeysArgsForCall)
+}
+
+func (fake *FakeHandlers) GetServiceKeysArgsForCall(i int) (string, *[]plugin_models.GetServiceKeys_Model) {
+	fake.getServiceKeysMutex.RLock()
+	defer fake.getServiceKeysMutex.RUnlock()
+	return fake.getServiceKeysArgsForCall[i].serviceInstance, fake.getServiceKeysArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetServiceKeysReturns(result1 error) {
+	fake.GetServiceKeysStub = nil
+	fake.getServiceKeysReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetServiceBindings(serviceInstance string, retVal *[]plugin_models.GetServiceBindings_Model) error {
+	fake.getServiceBindingsMutex.Lock()
+	fake.getServiceBindingsArgsForCall = append(fake.getServiceBindingsArgsForCall, struct {
+		serviceInstance string
+		retVal          *[]plugin_models.GetServiceBindings_Model
+	}{serviceInstance, retVal})
+	fake.getServiceBindingsMutex.Unlock()
+	if fake.GetServiceBindingsStub != nil {
+		return fake.GetServiceBindingsStub(serviceInstance, retVal)
+	} else {
+		return fake.getServiceBindingsReturns.result1
+	}
+}
+
+func (fake *FakeHandlers) GetServiceBindingsCallCount() int {
+	fake.getServiceBindingsMutex.RLock()
+	defer fake.getServiceBindingsMutex.RUnlock()
+	return len(fake.getServiceBindingsArgsForCall)
+}
+
+func (fake *FakeHandlers) GetServiceBindingsArgsForCall(i int) (string, *[]plugin_models.GetServiceBindings_Model) {
+	fake.getServiceBindingsMutex.RLock()
+	defer fake.getServiceBindingsMutex.RUnlock()
+	return fake.getServiceBindingsArgsForCall[i].serviceInstance, fake.getServiceBindingsArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetServiceBindingsReturns(result1 error) {
+	fake.GetServiceBindingsStub = nil
+	fake.getServiceBindingsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetSecurityGroups(args string, retVal *[]plugin_models.GetSecurityGroups_Model) error {
+	fake.getSecurityGroupsMutex.Lock()
+	fake.getSecurityGroupsArgsForCall = append(fake.getSecurityGroupsArgsForCall, struct {
+		args   string
+		retVal *[]plugin_models.GetSecurityGroups_Model
+	}{args, retVal})
+	fake.getSecurityGroupsMutex.Unlock()
+	if fake.GetSecurityGroupsStub != nil {
+		return fake.GetSecurityGroupsStub(args, retVal)
+	} else {
+		return fake.getSecurityGroupsReturns.result1
+	}
+}
+
+func (fake *FakeHandlers) GetSecurityGroupsCallCount() int {
+	fake.getSecurityGroupsMutex.RLock()
+	defer fake.getSecurityGroupsMutex.RUnlock()
+	return len(fake.getSecurityGroupsArgsForCall)
+}
+
+func (fake *FakeHandlers) GetSecurityGroupsArgsForCall(i int) (string, *[]plugin_models.GetSecurityGroups_Model) {
+	fake.getSecurityGroupsMutex.RLock()
+	defer fake.getSecurityGroupsMutex.RUnlock()
+	return fake.getSecurityGroupsArgsForCall[i].args, fake.getSecurityGroupsArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetSecurityGroupsReturns(result1 error) {
+	fake.GetSecurityGroupsStub = nil
+	fake.getSecurityGroupsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetQuotas(args string, retVal *[]plugin_models.GetQuotas_Model) error {
+	fake.getQuotasMutex.Lock()
+	fake.getQuotasArgsForCall = append(fake.getQuotasArgsForCall, struct {
+		args   string
+		retVal *[]plugin_models.GetQuotas_Model
+	}{args, retVal})
+	fake.getQuotasMutex.Unlock()
+	if fake.GetQuotasStub != nil {
+		return fake.GetQuotasStub(args, retVal)
+	} else {
+		return fake.getQuotasReturns.result1
+	}
+}
+
+func (fake *FakeHandlers) GetQuotasCallCount() int {
+	fake.getQuotasMutex.RLock()
+	defer fake.getQuotasMutex.RUnlock()
+	return len(fake.getQuotasArgsForCall)
+}
+
+func (fake *FakeHandlers) GetQuotasArgsForCall(i int) (string, *[]plugin_models.GetQuotas_Model) {
+	fake.getQuotasMutex.RLock()
+	defer fake.getQuotasMutex.RUnlock()
+	return fake.getQuotasArgsForCall[i].args, fake.getQuotasArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetQuotasReturns(result1 error) {
+	fake.GetQuotasStub = nil
+	fake.getQuotasReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetBuildpacks(args string, retVal *[]plugin_models.GetBuildpacks_Model) error {
+	fake.getBuildpacksMutex.Lock()
+	fake.getBuildpacksArgsForCall = append(fake.getBuildpacksArgsForCall, struct {
+		args   string
+		retVal *[]plugin_models.GetBuildpacks_Model
+	}{args, retVal})
+	fake.getBuildpacksMutex.Unlock()
+	if fake.GetBuildpacksStub != nil {
+		return fake.GetBuildpacksStub(args, retVal)
+	} else {
+		return fake.getBuildpacksReturns.result1
+	}
+}
+
+func (fake *FakeHandlers) GetBuildpacksCallCount() int {
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	return len(fake.getBuildpacksArgsForCall)
+}
+
+func (fake *FakeHandlers) GetBuildpacksArgsForCall(i int) (string, *[]plugin_models.GetBuildpacks_Model) {
+	fake.getBuildpacksMutex.RLock()
+	defer fake.getBuildpacksMutex.RUnlock()
+	return fake.getBuildpacksArgsForCall[i].args, fake.getBuildpacksArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetBuildpacksReturns(result1 error) {
+	fake.GetBuildpacksStub = nil
+	fake.getBuildpacksReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetStacks(args string, retVal *[]plugin_models.GetStacks_Model) error {
+	fake.getStacksMutex.Lock()
+	fake.getStacksArgsForCall = append(fake.getStacksArgsForCall, struct {
+		args   string
+		retVal *[]plugin_models.GetStacks_Model
+	}{args, retVal})
+	fake.getStacksMutex.Unlock()
+	if fake.GetStacksStub != nil {
+		return fake.GetStacksStub(args, retVal)
+	} else {
+		return fake.getStacksReturns.result1
+	}
+}
+
+func (fake *FakeHandlers) GetStacksCallCount() int {
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
+	return len(fake.getStacksArgsForCall)
+}
+
+func (fake *FakeHandlers) GetStacksArgsForCall(i int) (string, *[]plugin_models.GetStacks_Model) {
+	fake.getStacksMutex.RLock()
+	defer fake.getStacksMutex.RUnlock()
+	return fake.getStacksArgsForCall[i].args, fake.getStacksArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetStacksReturns(result1 error) {
+	fake.GetStacksStub = nil
+	fake.getStacksReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) GetAppEvents(appName string, retVal *[]plugin_models.GetAppEvents_Model) error {
+	fake.getAppEventsMutex.Lock()
+	fake.getAppEventsArgsForCall = append(fake.getAppEventsArgsForCall, struct {
+		appName string
+		retVal  *[]plugin_models.GetAppEvents_Model
+	}{appName, retVal})
+	fake.getAppEventsMutex.Unlock()
+	if fake.GetAppEventsStub != nil {
+		return fake.GetAppEventsStub(appName, retVal)
+	} else {
+		return fake.getAppEventsReturns.result1
+	}
+}
+
+func (fake *FakeHandlers) GetAppEventsCallCount() int {
+	fake.getAppEventsMutex.RLock()
+	defer fake.getAppEventsMutex.RUnlock()
+	return len(fake.getAppEventsArgsForCall)
+}
+
+func (fake *FakeHandlers) GetAppEventsArgsForCall(i int) (string, *[]plugin_models.GetAppEvents_Model) {
+	fake.getAppEventsMutex.RLock()
+	defer fake.getAppEventsMutex.RUnlock()
+	return fake.getAppEventsArgsForCall[i].appName, fake.getAppEventsArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) GetAppEventsReturns(result1 error) {
+	fake.GetAppEventsStub = nil
+	fake.getAppEventsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHandlers) Curl(args plugin_models.Curl_Request, retVal *plugin_models.Curl_Model) error {
+	fake.curlMutex.Lock()
+	fake.curlArgsForCall = append(fake.curlArgsForCall, struct {
+		args   plugin_models.Curl_Request
+		retVal *plugin_models.Curl_Model
+	}{args, retVal})
+	fake.curlMutex.Unlock()
+	if fake.CurlStub != nil {
+		return fake.CurlStub(args, retVal)
+	} else {
+		return fake.curlReturns.result1
+	}
+}
+
+func (fake *FakeHandlers) CurlCallCount() int {
+	fake.curlMutex.RLock()
+	defer fake.curlMutex.RUnlock()
+	return len(fake.curlArgsForCall)
+}
+
+func (fake *FakeHandlers) CurlArgsForCall(i int) (plugin_models.Curl_Request, *plugin_models.Curl_Model) {
+	fake.curlMutex.RLock()
+	defer fake.curlMutex.RUnlock()
+	return fake.curlArgsForCall[i].args, fake.curlArgsForCall[i].retVal
+}
+
+func (fake *FakeHandlers) CurlReturns(result1 error) {
+	fake.CurlStub = nil
+	fake.curlReturns = struct {
+		result1 error
+	}{result1}
+}
+
 var _ rpcserver.Handlers = new(FakeHandlers)
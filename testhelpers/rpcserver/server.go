@@ -46,6 +46,16 @@ type Handlers interface {
 	GetOrg(orgName string, retVal *plugin_models.GetOrg_Model) error
 	GetSpace(spaceName string, retVal *plugin_models.GetSpace_Model) error
 	GetService(serviceInstance string, retVal *plugin_models.GetService_Model) error
+	GetRoutes(args string, retVal *[]plugin_models.GetRoutes_Model) error
+	GetDomains(args string, retVal *[]plugin_models.GetDomains_Model) error
+	GetServiceKeys(serviceInstance string, retVal *[]plugin_models.GetServiceKeys_Model) error
+	GetServiceBindings(serviceInstance string, retVal *[]plugin_models.GetServiceBindings_Model) error
+	GetSecurityGroups(args string, retVal *[]plugin_models.GetSecurityGroups_Model) error
+	GetQuotas(args string, retVal *[]plugin_models.GetQuotas_Model) error
+	GetBuildpacks(args string, retVal *[]plugin_models.GetBuildpacks_Model) error
+	GetStacks(args string, retVal *[]plugin_models.GetStacks_Model) error
+	GetAppEvents(appName string, retVal *[]plugin_models.GetAppEvents_Model) error
+	Curl(args plugin_models.Curl_Request, retVal *plugin_models.Curl_Model) error
 }
 
 type TestServer struct {
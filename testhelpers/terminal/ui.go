@@ -27,6 +27,8 @@ type FakeUI struct {
 	FailedWithUsageCommandName string
 	PanickedQuietly            bool
 	ShowConfigurationCalled    bool
+	Format                     term.OutputFormat
+	StructuredOutputs          []interface{}
 
 	sayMutex sync.Mutex
 }
@@ -164,6 +166,22 @@ func (ui *FakeUI) Table(headers []string) *term.UITable {
 	}
 }
 
+func (ui *FakeUI) SetOutputFormat(format term.OutputFormat) {
+	ui.Format = format
+}
+
+func (ui *FakeUI) OutputFormat() term.OutputFormat {
+	return ui.Format
+}
+
+func (ui *FakeUI) PrintStructured(v interface{}) {
+	if !ui.Format.IsStructured() {
+		return
+	}
+
+	ui.StructuredOutputs = append(ui.StructuredOutputs, v)
+}
+
 func (ui *FakeUI) NotifyUpdateIfNeeded(config coreconfig.Reader) {
 	if !config.IsMinCLIVersion(cf.Version) {
 		ui.Say("Cloud Foundry API version {{.APIVer}} requires CLI version " + config.MinCLIVersion() + "  You are currently on version {{.CLIVer}}. To upgrade your CLI, please visit: https://github.com/cloudfoundry/cli#downloads")